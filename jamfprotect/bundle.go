@@ -0,0 +1,644 @@
+package jamfprotect
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+)
+
+// BundleVersion is the bundle format version written by ExportTenant. ReadBundleDir rejects
+// bundles with a newer version.
+const BundleVersion = 1
+
+// BundleFormat selects the encoding of the files a bundle is written as
+type BundleFormat string
+
+const (
+	BundleFormatJSON BundleFormat = "json"
+	BundleFormatYAML BundleFormat = "yaml"
+)
+
+// bundleManifest is the name of the file, without extension, that records the bundle version
+const bundleManifest = "bundle"
+
+// Bundle is a portable copy of a tenant's configuration. Objects reference each other by
+// name rather than ID, and carry none of the fields the server sets, so a bundle exported
+// twice from an unchanged tenant is identical and can be restored into another tenant.
+// Each list is sorted by name.
+type Bundle struct {
+	Version int
+
+	// Redacted records that ExportTenant replaced secrets with client.RedactedValue.
+	// ImportBundle refuses a redacted bundle; fill in the secrets and clear the flag in
+	// the manifest first.
+	Redacted bool
+
+	ActionConfigs         []ActionConfigDocument
+	Analytics             []AnalyticDocument
+	AnalyticSets          []AnalyticSetDocument
+	ExceptionSets         []ExceptionSetDocument
+	PreventLists          []PreventListDocument
+	USBControlSets        []USBControlSetDocument
+	Telemetries           []TelemetryDocument
+	TelemetriesV2         []TelemetryV2Document
+	UnifiedLoggingFilters []UnifiedLoggingFilterDocument
+	Plans                 []PlanDocument
+}
+
+// ActionConfigDocument is an action configuration in a bundle. Secrets in report client
+// parameters, such as HTTP header values and passwords, are replaced by
+// client.RedactedValue unless the bundle is exported with ExportIncludeSecrets, and the
+// bundle is marked Redacted.
+type ActionConfigDocument struct {
+	Name        string           `json:"name" yaml:"name"`
+	Description string           `json:"description,omitempty" yaml:"description,omitempty"`
+	AlertConfig map[string]any   `json:"alertConfig" yaml:"alertConfig"`
+	Clients     []map[string]any `json:"clients,omitempty" yaml:"clients,omitempty"`
+}
+
+// AnalyticDocument is an analytic in a bundle. Managed marks a built-in Jamf analytic,
+// which is only exported with ExportIncludeManaged.
+type AnalyticDocument struct {
+	Name            string                    `json:"name" yaml:"name"`
+	Managed         bool                      `json:"managed,omitempty" yaml:"managed,omitempty"`
+	InputType       string                    `json:"inputType" yaml:"inputType"`
+	Description     string                    `json:"description,omitempty" yaml:"description,omitempty"`
+	Filter          string                    `json:"filter" yaml:"filter"`
+	Severity        string                    `json:"severity" yaml:"severity"`
+	Level           int                       `json:"level,omitempty" yaml:"level,omitempty"`
+	Actions         []string                  `json:"actions,omitempty" yaml:"actions,omitempty"`
+	AnalyticActions []AnalyticActionDocument  `json:"analyticActions,omitempty" yaml:"analyticActions,omitempty"`
+	Tags            []string                  `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Categories      []string                  `json:"categories,omitempty" yaml:"categories,omitempty"`
+	Context         []AnalyticContextDocument `json:"context,omitempty" yaml:"context,omitempty"`
+	SnapshotFiles   []string                  `json:"snapshotFiles,omitempty" yaml:"snapshotFiles,omitempty"`
+}
+
+// AnalyticActionDocument is an action an analytic takes when it matches
+type AnalyticActionDocument struct {
+	Name       string   `json:"name" yaml:"name"`
+	Parameters []string `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+}
+
+// AnalyticContextDocument is a context expression of an analytic
+type AnalyticContextDocument struct {
+	Name  string   `json:"name" yaml:"name"`
+	Type  string   `json:"type" yaml:"type"`
+	Exprs []string `json:"exprs,omitempty" yaml:"exprs,omitempty"`
+}
+
+// AnalyticSetDocument is an analytic set in a bundle. Analytics are analytic names.
+type AnalyticSetDocument struct {
+	Name        string   `json:"name" yaml:"name"`
+	Managed     bool     `json:"managed,omitempty" yaml:"managed,omitempty"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Types       []string `json:"types,omitempty" yaml:"types,omitempty"`
+	Analytics   []string `json:"analytics" yaml:"analytics"`
+}
+
+// ExceptionSetDocument is an exception set in a bundle
+type ExceptionSetDocument struct {
+	Name         string                `json:"name" yaml:"name"`
+	Managed      bool                  `json:"managed,omitempty" yaml:"managed,omitempty"`
+	Description  string                `json:"description,omitempty" yaml:"description,omitempty"`
+	Exceptions   []ExceptionDocument   `json:"exceptions,omitempty" yaml:"exceptions,omitempty"`
+	EsExceptions []EsExceptionDocument `json:"esExceptions,omitempty" yaml:"esExceptions,omitempty"`
+}
+
+// ExceptionDocument is an exception of an exception set. Analytic is the name of the
+// analytic the exception applies to, if any.
+type ExceptionDocument struct {
+	Type           string                  `json:"type" yaml:"type"`
+	Value          string                  `json:"value" yaml:"value"`
+	AppSigningInfo *AppSigningInfoDocument `json:"appSigningInfo,omitempty" yaml:"appSigningInfo,omitempty"`
+	IgnoreActivity string                  `json:"ignoreActivity" yaml:"ignoreActivity"`
+	AnalyticTypes  []string                `json:"analyticTypes,omitempty" yaml:"analyticTypes,omitempty"`
+	Analytic       string                  `json:"analytic,omitempty" yaml:"analytic,omitempty"`
+}
+
+// EsExceptionDocument is an endpoint security exception of an exception set
+type EsExceptionDocument struct {
+	Type              string                  `json:"type" yaml:"type"`
+	Value             string                  `json:"value" yaml:"value"`
+	AppSigningInfo    *AppSigningInfoDocument `json:"appSigningInfo,omitempty" yaml:"appSigningInfo,omitempty"`
+	IgnoreActivity    string                  `json:"ignoreActivity" yaml:"ignoreActivity"`
+	IgnoreListType    string                  `json:"ignoreListType,omitempty" yaml:"ignoreListType,omitempty"`
+	IgnoreListSubType string                  `json:"ignoreListSubType,omitempty" yaml:"ignoreListSubType,omitempty"`
+	EventType         string                  `json:"eventType,omitempty" yaml:"eventType,omitempty"`
+}
+
+// AppSigningInfoDocument identifies a signed application
+type AppSigningInfoDocument struct {
+	AppID  string `json:"appId" yaml:"appId"`
+	TeamID string `json:"teamId" yaml:"teamId"`
+}
+
+// PreventListDocument is a custom prevent list in a bundle
+type PreventListDocument struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Type        string   `json:"type" yaml:"type"`
+	Tags        []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	List        []string `json:"list" yaml:"list"`
+}
+
+// USBControlSetDocument is a USB control set in a bundle
+type USBControlSetDocument struct {
+	Name                 string                   `json:"name" yaml:"name"`
+	Description          string                   `json:"description,omitempty" yaml:"description,omitempty"`
+	DefaultMountAction   string                   `json:"defaultMountAction" yaml:"defaultMountAction"`
+	DefaultMessageAction string                   `json:"defaultMessageAction,omitempty" yaml:"defaultMessageAction,omitempty"`
+	Rules                []USBControlRuleDocument `json:"rules" yaml:"rules"`
+}
+
+// USBControlRuleDocument is a rule of a USB control set
+type USBControlRuleDocument struct {
+	Type          string                      `json:"type" yaml:"type"`
+	MountAction   string                      `json:"mountAction" yaml:"mountAction"`
+	MessageAction string                      `json:"messageAction,omitempty" yaml:"messageAction,omitempty"`
+	ApplyTo       string                      `json:"applyTo,omitempty" yaml:"applyTo,omitempty"`
+	Vendors       []string                    `json:"vendors,omitempty" yaml:"vendors,omitempty"`
+	Serials       []string                    `json:"serials,omitempty" yaml:"serials,omitempty"`
+	Products      []USBControlProductDocument `json:"products,omitempty" yaml:"products,omitempty"`
+}
+
+// USBControlProductDocument is a vendor and product ID pair of a USB product rule
+type USBControlProductDocument struct {
+	Vendor  string `json:"vendor" yaml:"vendor"`
+	Product string `json:"product" yaml:"product"`
+}
+
+// TelemetryDocument is a legacy (v1) telemetry configuration in a bundle. The API cannot
+// create v1 configurations, so they are exported for reference and for plans that use them.
+type TelemetryDocument struct {
+	Name               string   `json:"name" yaml:"name"`
+	Description        string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Verbose            bool     `json:"verbose,omitempty" yaml:"verbose,omitempty"`
+	Level              int      `json:"level,omitempty" yaml:"level,omitempty"`
+	LogFiles           []string `json:"logFiles,omitempty" yaml:"logFiles,omitempty"`
+	LogFileCollection  bool     `json:"logFileCollection,omitempty" yaml:"logFileCollection,omitempty"`
+	PerformanceMetrics bool     `json:"performanceMetrics,omitempty" yaml:"performanceMetrics,omitempty"`
+}
+
+// TelemetryV2Document is a telemetry v2 configuration in a bundle
+type TelemetryV2Document struct {
+	Name               string   `json:"name" yaml:"name"`
+	Description        string   `json:"description,omitempty" yaml:"description,omitempty"`
+	LogFiles           []string `json:"logFiles" yaml:"logFiles"`
+	LogFileCollection  bool     `json:"logFileCollection,omitempty" yaml:"logFileCollection,omitempty"`
+	PerformanceMetrics bool     `json:"performanceMetrics,omitempty" yaml:"performanceMetrics,omitempty"`
+	Events             []string `json:"events,omitempty" yaml:"events,omitempty"`
+	FileHashing        bool     `json:"fileHashing,omitempty" yaml:"fileHashing,omitempty"`
+}
+
+// UnifiedLoggingFilterDocument is a unified logging filter in a bundle
+type UnifiedLoggingFilterDocument struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Filter      string   `json:"filter" yaml:"filter"`
+	Tags        []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Enabled     bool     `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+}
+
+// PlanDocument is a plan in a bundle. Every reference is the name of the referenced object.
+type PlanDocument struct {
+	Name                 string                        `json:"name" yaml:"name"`
+	Description          string                        `json:"description,omitempty" yaml:"description,omitempty"`
+	LogLevel             string                        `json:"logLevel,omitempty" yaml:"logLevel,omitempty"`
+	AutoUpdate           bool                          `json:"autoUpdate,omitempty" yaml:"autoUpdate,omitempty"`
+	ActionConfig         string                        `json:"actionConfig" yaml:"actionConfig"`
+	ExceptionSets        []string                      `json:"exceptionSets,omitempty" yaml:"exceptionSets,omitempty"`
+	AnalyticSets         []PlanAnalyticSetDocument     `json:"analyticSets,omitempty" yaml:"analyticSets,omitempty"`
+	USBControlSet        string                        `json:"usbControlSet,omitempty" yaml:"usbControlSet,omitempty"`
+	Telemetry            string                        `json:"telemetry,omitempty" yaml:"telemetry,omitempty"`
+	TelemetryV2          string                        `json:"telemetryV2,omitempty" yaml:"telemetryV2,omitempty"`
+	CommsConfig          *CommsConfigDocument          `json:"commsConfig,omitempty" yaml:"commsConfig,omitempty"`
+	InfoSync             *InfoSyncDocument             `json:"infoSync,omitempty" yaml:"infoSync,omitempty"`
+	SignaturesFeedConfig *SignaturesFeedConfigDocument `json:"signaturesFeedConfig,omitempty" yaml:"signaturesFeedConfig,omitempty"`
+}
+
+// PlanAnalyticSetDocument is an analytic set assigned to a plan, by name
+type PlanAnalyticSetDocument struct {
+	Name string `json:"name" yaml:"name"`
+	Type string `json:"type" yaml:"type"`
+}
+
+// CommsConfigDocument is a plan's communication settings
+type CommsConfigDocument struct {
+	FQDN     string `json:"fqdn" yaml:"fqdn"`
+	Protocol string `json:"protocol" yaml:"protocol"`
+}
+
+// InfoSyncDocument is a plan's information sync settings
+type InfoSyncDocument struct {
+	Attrs                []string `json:"attrs,omitempty" yaml:"attrs,omitempty"`
+	InsightsSyncInterval int64    `json:"insightsSyncInterval,omitempty" yaml:"insightsSyncInterval,omitempty"`
+}
+
+// SignaturesFeedConfigDocument is a plan's signatures feed settings
+type SignaturesFeedConfigDocument struct {
+	Mode string `json:"mode" yaml:"mode"`
+}
+
+// bundleSection describes one kind of object in a bundle: the directory its files are
+// written to and accessors for its documents
+type bundleSection struct {
+	kind  ResourceKind
+	dir   string
+	names func(b *Bundle) []string
+	get   func(b *Bundle, i int) any
+	add   func(b *Bundle, doc any)
+	read  func(b *Bundle, decode func(v any) error) error
+}
+
+// section builds the bundleSection for the documents selected by list
+func section[T any](kind ResourceKind, dir string, list func(b *Bundle) *[]T, name func(T) string) bundleSection {
+	return bundleSection{
+		kind: kind,
+		dir:  dir,
+		names: func(b *Bundle) []string {
+			docs := *list(b)
+			names := make([]string, len(docs))
+			for i, doc := range docs {
+				names[i] = name(doc)
+			}
+			return names
+		},
+		get: func(b *Bundle, i int) any { return (*list(b))[i] },
+		add: func(b *Bundle, doc any) { *list(b) = append(*list(b), doc.(T)) },
+		read: func(b *Bundle, decode func(v any) error) error {
+			var doc T
+			if err := decode(&doc); err != nil {
+				return err
+			}
+			*list(b) = append(*list(b), doc)
+			return nil
+		},
+	}
+}
+
+// bundleSections lists the kinds of object in a bundle, in dependency order
+var bundleSections = []bundleSection{
+	section(ResourceAnalytic, "analytics", func(b *Bundle) *[]AnalyticDocument { return &b.Analytics }, func(d AnalyticDocument) string { return d.Name }),
+	section(ResourceAnalyticSet, "analytic-sets", func(b *Bundle) *[]AnalyticSetDocument { return &b.AnalyticSets }, func(d AnalyticSetDocument) string { return d.Name }),
+	section(ResourceExceptionSet, "exception-sets", func(b *Bundle) *[]ExceptionSetDocument { return &b.ExceptionSets }, func(d ExceptionSetDocument) string { return d.Name }),
+	section(ResourceActionConfig, "action-configs", func(b *Bundle) *[]ActionConfigDocument { return &b.ActionConfigs }, func(d ActionConfigDocument) string { return d.Name }),
+	section(ResourceTelemetry, "telemetries", func(b *Bundle) *[]TelemetryDocument { return &b.Telemetries }, func(d TelemetryDocument) string { return d.Name }),
+	section(ResourceTelemetryV2, "telemetries-v2", func(b *Bundle) *[]TelemetryV2Document { return &b.TelemetriesV2 }, func(d TelemetryV2Document) string { return d.Name }),
+	section(ResourceUSBControlSet, "usb-control-sets", func(b *Bundle) *[]USBControlSetDocument { return &b.USBControlSets }, func(d USBControlSetDocument) string { return d.Name }),
+	section(ResourcePreventList, "prevent-lists", func(b *Bundle) *[]PreventListDocument { return &b.PreventLists }, func(d PreventListDocument) string { return d.Name }),
+	section(ResourceUnifiedLoggingFilter, "unified-logging-filters", func(b *Bundle) *[]UnifiedLoggingFilterDocument { return &b.UnifiedLoggingFilters }, func(d UnifiedLoggingFilterDocument) string { return d.Name }),
+	section(ResourcePlan, "plans", func(b *Bundle) *[]PlanDocument { return &b.Plans }, func(d PlanDocument) string { return d.Name }),
+}
+
+// bundleManifestFile is the content of the bundle manifest
+type bundleManifestFile struct {
+	Version  int  `json:"version" yaml:"version"`
+	Redacted bool `json:"redacted,omitempty" yaml:"redacted,omitempty"`
+}
+
+// Files encodes the bundle as a set of files keyed by slash-separated path: a manifest
+// recording the bundle version, and one file per object in a directory per kind, named
+// after the object. The output depends only on the bundle's contents.
+func (b *Bundle) Files(format BundleFormat) (map[string][]byte, error) {
+	ext, err := format.extension()
+	if err != nil {
+		return nil, err
+	}
+	version := b.Version
+	if version == 0 {
+		version = BundleVersion
+	}
+
+	files := make(map[string][]byte)
+	manifest, err := encodeBundleFile(format, bundleManifestFile{Version: version, Redacted: b.Redacted})
+	if err != nil {
+		return nil, err
+	}
+	files[bundleManifest+ext] = manifest
+
+	for _, s := range bundleSections {
+		used := make(map[string]bool)
+		for i, name := range s.names(b) {
+			file := fileName(name, used)
+			data, err := encodeBundleFile(format, s.get(b, i))
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode %s %q: %w", s.dir, name, err)
+			}
+			files[s.dir+"/"+file+ext] = data
+		}
+	}
+	return files, nil
+}
+
+// WriteDir writes the bundle's Files into dir, creating it if needed. The directory of each
+// kind is replaced, so objects removed from the tenant since an earlier export do not
+// linger in the directory; other files in dir are left alone. Files and directories are
+// only accessible to the owner, as a bundle may hold secrets.
+func (b *Bundle) WriteDir(dir string, format BundleFormat) error {
+	files, err := b.Files(format)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create bundle directory: %w", err)
+	}
+	for _, s := range bundleSections {
+		if err := os.RemoveAll(filepath.Join(dir, s.dir)); err != nil {
+			return fmt.Errorf("failed to clear %s: %w", s.dir, err)
+		}
+	}
+	for _, ext := range []string{".json", ".yaml", ".yml"} {
+		if err := os.Remove(filepath.Join(dir, bundleManifest+ext)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove old manifest: %w", err)
+		}
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		full := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(full), 0o700); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", path, err)
+		}
+		if err := os.WriteFile(full, files[path], 0o600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// ReadBundleDir reads a bundle written by WriteDir. Files may be JSON or YAML, told apart
+// by extension, and unknown fields are rejected so that misspelled keys in hand-edited
+// files are not silently dropped.
+func ReadBundleDir(dir string) (*Bundle, error) {
+	var manifest bundleManifestFile
+	found := false
+	for _, ext := range []string{".json", ".yaml", ".yml"} {
+		path := filepath.Join(dir, bundleManifest+ext)
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		if err := decodeBundleFile(path, &manifest); err != nil {
+			return nil, err
+		}
+		found = true
+		break
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s contains no bundle manifest", client.ErrInvalidInput, dir)
+	}
+	if manifest.Version < 1 || manifest.Version > BundleVersion {
+		return nil, fmt.Errorf("%w: unsupported bundle version %d (supported: 1 to %d)",
+			client.ErrInvalidInput, manifest.Version, BundleVersion)
+	}
+
+	b := &Bundle{Version: manifest.Version, Redacted: manifest.Redacted}
+	for _, s := range bundleSections {
+		entries, err := os.ReadDir(filepath.Join(dir, s.dir))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", s.dir, err)
+		}
+		for _, entry := range entries {
+			ext := strings.ToLower(filepath.Ext(entry.Name()))
+			if entry.IsDir() || (ext != ".json" && ext != ".yaml" && ext != ".yml") {
+				continue
+			}
+			path := filepath.Join(dir, s.dir, entry.Name())
+			if err := s.read(b, func(v any) error { return decodeBundleFile(path, v) }); err != nil {
+				return nil, err
+			}
+		}
+	}
+	b.normalize()
+	return b, nil
+}
+
+// normalize sorts every list by name and gives numbers in free-form maps one representation
+// regardless of the encoding they were read from
+func (b *Bundle) normalize() {
+	sortDocs(b.ActionConfigs, func(d ActionConfigDocument) string { return d.Name })
+	sortDocs(b.Analytics, func(d AnalyticDocument) string { return d.Name })
+	sortDocs(b.AnalyticSets, func(d AnalyticSetDocument) string { return d.Name })
+	sortDocs(b.ExceptionSets, func(d ExceptionSetDocument) string { return d.Name })
+	sortDocs(b.PreventLists, func(d PreventListDocument) string { return d.Name })
+	sortDocs(b.USBControlSets, func(d USBControlSetDocument) string { return d.Name })
+	sortDocs(b.Telemetries, func(d TelemetryDocument) string { return d.Name })
+	sortDocs(b.TelemetriesV2, func(d TelemetryV2Document) string { return d.Name })
+	sortDocs(b.UnifiedLoggingFilters, func(d UnifiedLoggingFilterDocument) string { return d.Name })
+	sortDocs(b.Plans, func(d PlanDocument) string { return d.Name })
+
+	for i := range b.ActionConfigs {
+		b.ActionConfigs[i].normalize()
+	}
+}
+
+// normalize gives the numbers in an action configuration's free-form maps one type, so
+// that documents read from JSON, YAML or the API compare equal
+func (d *ActionConfigDocument) normalize() {
+	if m, ok := normalizeNumbers(d.AlertConfig).(map[string]any); ok {
+		d.AlertConfig = m
+	}
+	for i, c := range d.Clients {
+		if m, ok := normalizeNumbers(c).(map[string]any); ok {
+			d.Clients[i] = m
+		}
+	}
+}
+
+// sortDocs sorts documents by name
+func sortDocs[T any](docs []T, name func(T) string) {
+	slices.SortStableFunc(docs, func(a, b T) int { return cmp.Compare(name(a), name(b)) })
+}
+
+// normalizeNumbers returns v with every number replaced by an int64 when it is integral
+// and a float64 otherwise, and every nested map given string keys
+func normalizeNumbers(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, e := range t {
+			t[k] = normalizeNumbers(e)
+		}
+		return t
+	case map[any]any:
+		m := make(map[string]any, len(t))
+		for k, e := range t {
+			m[fmt.Sprint(k)] = normalizeNumbers(e)
+		}
+		return m
+	case []any:
+		for i, e := range t {
+			t[i] = normalizeNumbers(e)
+		}
+		return t
+	case []map[string]any:
+		for i, e := range t {
+			t[i] = normalizeNumbers(e).(map[string]any)
+		}
+		return t
+	case float64:
+		if t == math.Trunc(t) && math.Abs(t) < 1<<53 {
+			return int64(t)
+		}
+		return t
+	case float32:
+		return normalizeNumbers(float64(t))
+	case int:
+		return int64(t)
+	case int32:
+		return int64(t)
+	case uint64:
+		return int64(t)
+	case json.Number:
+		if n, err := t.Int64(); err == nil {
+			return n
+		}
+		if f, err := t.Float64(); err == nil {
+			return f
+		}
+		return t.String()
+	}
+	return v
+}
+
+// extension returns the file extension for the format
+func (f BundleFormat) extension() (string, error) {
+	switch f {
+	case BundleFormatJSON:
+		return ".json", nil
+	case BundleFormatYAML, "":
+		return ".yaml", nil
+	}
+	return "", fmt.Errorf("%w: unknown bundle format %q", client.ErrInvalidInput, f)
+}
+
+// encodeBundleFile encodes one bundle file. Both encoders sort map keys.
+func encodeBundleFile(format BundleFormat, v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if format == BundleFormatJSON {
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(v); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeBundleFile decodes one bundle file according to its extension
+func decodeBundleFile(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(v)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(v)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: parsing %s: %v", client.ErrInvalidInput, path, err)
+	}
+	return nil
+}
+
+// fileName returns a file name for an object, without extension, made of the lowercased
+// letters and digits of its name separated by dashes. Names that map to a name already in
+// used get a numeric suffix.
+func fileName(name string, used map[string]bool) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	base := sb.String()
+	if base == "" {
+		base = "unnamed"
+	}
+	file := base
+	for n := 2; used[file]; n++ {
+		file = base + "-" + strconv.Itoa(n)
+	}
+	used[file] = true
+	return file
+}
+
+// redactedFields returns the paths of the fields of a document that hold
+// client.RedactedValue, such as "clients[0].params.headers[0].value"
+func redactedFields(doc any) []string {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+	var fields []string
+	var walk func(path string, v any)
+	walk = func(path string, v any) {
+		switch t := v.(type) {
+		case map[string]any:
+			for _, k := range slices.Sorted(maps.Keys(t)) {
+				field := k
+				if path != "" {
+					field = path + "." + k
+				}
+				walk(field, t[k])
+			}
+		case []any:
+			for i, e := range t {
+				walk(fmt.Sprintf("%s[%d]", path, i), e)
+			}
+		case string:
+			if strings.Contains(t, client.RedactedValue) {
+				fields = append(fields, path)
+			}
+		}
+	}
+	walk("", v)
+	return fields
+}
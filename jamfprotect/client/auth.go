@@ -1,11 +1,9 @@
 package client
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"sync"
 	"time"
@@ -25,6 +23,10 @@ type TokenResponse struct {
 	AccessToken string `json:"access_token"`
 	ExpiresIn   int64  `json:"expires_in,omitempty"`
 	TokenType   string `json:"token_type,omitempty"`
+
+	// ExpiresAt is the absolute expiry time. It is not sent by the API; token sources
+	// set it so that cached tokens can be reused across processes.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
 }
 
 // AuthConfig holds OAuth2 client credentials configuration
@@ -52,18 +54,34 @@ func (a *AuthConfig) Validate() error {
 type TokenManager struct {
 	authConfig    *AuthConfig
 	httpClient    *http.Client
+	source        TokenSource
 	logger        *zap.Logger
 	currentToken  *TokenResponse
 	tokenExpiry   time.Time
+	tokenIssued   time.Time
 	mu            sync.RWMutex
+	refreshMu     sync.Mutex
 	refreshBuffer time.Duration
+	hooks         TokenHooks
+	telemetry     *telemetry
+	refresher     *backgroundRefresher
 }
 
-// NewTokenManager creates a new token manager
+// NewTokenManager creates a new token manager that fetches tokens with the client credentials
 func NewTokenManager(authConfig *AuthConfig, httpClient *http.Client, logger *zap.Logger) *TokenManager {
 	return &TokenManager{
 		authConfig:    authConfig,
 		httpClient:    httpClient,
+		source:        NewClientCredentialsTokenSource(authConfig, httpClient, logger),
+		logger:        logger,
+		refreshBuffer: time.Duration(TokenExpirySkew) * time.Second,
+	}
+}
+
+// NewTokenManagerWithSource creates a new token manager that obtains tokens from the given source
+func NewTokenManagerWithSource(source TokenSource, logger *zap.Logger) *TokenManager {
+	return &TokenManager{
+		source:        source,
 		logger:        logger,
 		refreshBuffer: time.Duration(TokenExpirySkew) * time.Second,
 	}
@@ -82,115 +100,144 @@ func (tm *TokenManager) GetToken(ctx context.Context) (string, error) {
 	return tm.RefreshToken(ctx)
 }
 
-// RefreshToken requests a new access token from the OAuth2 endpoint (thread-safe)
+// RefreshToken requests a new access token from the token source (thread-safe).
+// Only one refresh runs at a time; readers holding a still-valid token are not blocked
+// while the token source is called.
 func (tm *TokenManager) RefreshToken(ctx context.Context) (string, error) {
-	tm.mu.Lock()
-	defer tm.mu.Unlock()
+	tm.refreshMu.Lock()
+	defer tm.refreshMu.Unlock()
 
 	// Double-check in case another goroutine just refreshed
+	tm.mu.RLock()
 	if tm.currentToken != nil && time.Now().Add(tm.refreshBuffer).Before(tm.tokenExpiry) {
-		return tm.currentToken.AccessToken, nil
+		token := tm.currentToken.AccessToken
+		tm.mu.RUnlock()
+		return token, nil
 	}
+	tm.mu.RUnlock()
+
+	return tm.fetchLocked(ctx, false, 0)
+}
 
+// fetchLocked obtains a token from the source and stores it. Callers must hold refreshMu.
+func (tm *TokenManager) fetchLocked(ctx context.Context, background bool, attempt int) (string, error) {
 	if tm.logger != nil {
-		tm.logger.Info("Requesting new OAuth2 access token",
-			zap.String("token_url", tm.authConfig.TokenURL))
+		fields := []zap.Field{zap.Bool("background", background)}
+		if tm.authConfig != nil {
+			fields = append(fields, zap.String("token_url", tm.authConfig.TokenURL))
+		}
+		tm.logger.Info("Requesting new OAuth2 access token", fields...)
 	}
 
-	tokenResp, err := tm.fetchToken(ctx)
+	started := time.Now()
+	event := TokenRefreshEvent{Background: background, Attempt: attempt}
+
+	var tokenResp *TokenResponse
+	var err error
+	tm.mu.RLock()
+	current := tm.currentToken
+	tm.mu.RUnlock()
+	if renewer, ok := tm.source.(TokenRenewer); ok && background && current != nil {
+		// a still-valid cached token would only hand the current token back
+		tokenResp, err = renewer.RenewToken(ctx, current.AccessToken)
+	} else {
+		tokenResp, err = tm.source.Token(ctx)
+	}
+	if err == nil && (tokenResp == nil || tokenResp.AccessToken == "") {
+		err = fmt.Errorf("%w: token source returned no access token", ErrAuthentication)
+	}
+	event.Duration = time.Since(started)
+	if tm.telemetry != nil {
+		tm.telemetry.recordTokenRefresh(background, err)
+	}
 	if err != nil {
+		if tm.hooks.OnTokenRefreshFailed != nil {
+			tm.hooks.OnTokenRefreshFailed(event, err)
+		}
 		return "", err
 	}
 
+	expiry := tokenExpiresAt(tokenResp)
+
+	tm.mu.Lock()
 	tm.currentToken = tokenResp
-	tm.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	tm.tokenExpiry = expiry
+	tm.tokenIssued = tokenIssuedAt(tokenResp, expiry)
+	tm.mu.Unlock()
 
 	if tm.logger != nil {
 		tm.logger.Info("Successfully obtained access token",
 			zap.String("token_type", tokenResp.TokenType),
 			zap.Int64("expires_in", tokenResp.ExpiresIn),
-			zap.Time("expires_at", tm.tokenExpiry))
+			zap.Time("expires_at", expiry))
+	}
+
+	event.ExpiresAt = expiry
+	if tm.hooks.OnTokenRefreshed != nil {
+		tm.hooks.OnTokenRefreshed(event)
 	}
 
 	return tokenResp.AccessToken, nil
 }
 
-// InvalidateToken clears the current token, forcing a refresh on next use
+// InvalidateToken clears the current token, forcing a refresh on next use.
+// Token sources that cache tokens (see TokenInvalidator) also drop the cleared token.
 func (tm *TokenManager) InvalidateToken() {
 	tm.mu.Lock()
-	defer tm.mu.Unlock()
-
+	var rejected string
+	if tm.currentToken != nil {
+		rejected = tm.currentToken.AccessToken
+	}
 	tm.currentToken = nil
 	tm.tokenExpiry = time.Time{}
+	tm.mu.Unlock()
 
-	if tm.logger != nil {
-		tm.logger.Info("Access token invalidated")
+	if inv, ok := tm.source.(TokenInvalidator); ok && rejected != "" {
+		inv.InvalidateToken(rejected)
 	}
-}
-
-// fetchToken performs the actual HTTP request to obtain a new access token
-func (tm *TokenManager) fetchToken(ctx context.Context) (*TokenResponse, error) {
-	body, err := json.Marshal(TokenRequest{
-		ClientID: tm.authConfig.ClientID,
-		Password: tm.authConfig.ClientSecret,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("marshalling token request: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
-		tm.authConfig.TokenURL, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("creating token request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", DefaultUserAgent)
 
 	if tm.logger != nil {
-		tm.logger.Debug("OAuth2 token request",
-			zap.String("method", http.MethodPost),
-			zap.String("url", req.URL.String()),
-			zap.ByteString("body", redactTokenRequestBody(tm.authConfig.ClientID)))
-	}
-
-	resp, err := tm.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("requesting token: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading token response: %w", err)
+		tm.logger.Info("Access token invalidated")
 	}
+}
 
-	if tm.logger != nil {
-		tm.logger.Debug("OAuth2 token response",
-			zap.Int("status_code", resp.StatusCode),
-			zap.ByteString("body", redactTokenResponseBody(respBody)))
+// InvalidateTokenIfCurrent invalidates the current token only if it is still the given token.
+// Concurrent callers that were all rejected with the same token therefore trigger a single
+// invalidation, and a token refreshed in the meantime is left untouched.
+// Returns true if the token was invalidated.
+func (tm *TokenManager) InvalidateTokenIfCurrent(token string) bool {
+	tm.mu.RLock()
+	current := tm.currentToken != nil && tm.currentToken.AccessToken == token
+	tm.mu.RUnlock()
+	if !current {
+		return false
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("token request returned %d: %s", resp.StatusCode, string(respBody))
-	}
+	tm.refreshMu.Lock()
+	defer tm.refreshMu.Unlock()
 
-	var tokenResp TokenResponse
-	if err := json.Unmarshal(respBody, &tokenResp); err != nil {
-		return nil, fmt.Errorf("decoding token response: %w", err)
+	// Re-check under the refresh lock in case another goroutine got here first
+	tm.mu.RLock()
+	current = tm.currentToken != nil && tm.currentToken.AccessToken == token
+	tm.mu.RUnlock()
+	if !current {
+		return false
 	}
 
-	if tokenResp.AccessToken == "" {
-		return nil, fmt.Errorf("%w: token response missing access_token", ErrAuthentication)
-	}
+	tm.InvalidateToken()
+	return true
+}
 
-	if tokenResp.ExpiresIn <= 0 {
-		return nil, fmt.Errorf("%w: token response missing expires_in", ErrAuthentication)
+// setLogger updates the logger used by the token manager and its default token source
+func (tm *TokenManager) setLogger(logger *zap.Logger) {
+	tm.logger = logger
+	if s, ok := tm.source.(interface{ setLogger(*zap.Logger) }); ok {
+		s.setLogger(logger)
 	}
-
-	return &tokenResp, nil
 }
 
-// SetupAuthentication configures the resty client with OAuth2 bearer token authentication
+// SetupAuthentication configures the resty client with OAuth2 bearer token authentication.
+// No token is requested here; the first token is fetched lazily on the first API request.
 func SetupAuthentication(client *resty.Client, authConfig *AuthConfig, logger *zap.Logger) (*TokenManager, error) {
 	if err := authConfig.Validate(); err != nil {
 		if logger != nil {
@@ -201,16 +248,14 @@ func SetupAuthentication(client *resty.Client, authConfig *AuthConfig, logger *z
 
 	// Use the underlying *http.Client for token requests so they bypass resty middleware
 	tokenManager := NewTokenManager(authConfig, client.Client(), logger)
+	SetupTokenAuthentication(client, tokenManager, logger)
 
-	// Fetch initial token
-	token, err := tokenManager.RefreshToken(context.Background())
-	if err != nil {
-		return nil, fmt.Errorf("failed to obtain initial access token: %w", err)
-	}
-
-	client.SetAuthToken(token)
+	return tokenManager, nil
+}
 
-	// Add request middleware to ensure token is valid before each request
+// SetupTokenAuthentication adds request middleware that attaches a valid bearer token
+// from the token manager to every request. It performs no network access.
+func SetupTokenAuthentication(client *resty.Client, tokenManager *TokenManager, logger *zap.Logger) {
 	client.AddRequestMiddleware(func(c *resty.Client, req *resty.Request) error {
 		token, err := tokenManager.GetToken(req.Context())
 		if err != nil {
@@ -224,11 +269,8 @@ func SetupAuthentication(client *resty.Client, authConfig *AuthConfig, logger *z
 	})
 
 	if logger != nil {
-		logger.Info("OAuth2 authentication configured successfully",
-			zap.String("token_url", authConfig.TokenURL))
+		logger.Info("OAuth2 authentication configured successfully")
 	}
-
-	return tokenManager, nil
 }
 
 // redactTokenRequestBody creates a redacted version of the token request body for logging
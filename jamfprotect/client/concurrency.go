@@ -0,0 +1,66 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ConcurrencyLimitTransport is an http.RoundTripper that bounds the number of in-flight
+// requests. A single instance can be shared by several clients (e.g. one per tenant) to
+// enforce a global limit. A request holds its slot until its response body is closed, so
+// the limit also covers responses still being read. Requests waiting for a slot honour
+// their context.
+type ConcurrencyLimitTransport struct {
+	base  http.RoundTripper
+	slots chan struct{}
+}
+
+// NewConcurrencyLimitTransport wraps base so that at most max requests run concurrently.
+// A nil base uses http.DefaultTransport.
+func NewConcurrencyLimitTransport(base http.RoundTripper, max int) (*ConcurrencyLimitTransport, error) {
+	if max <= 0 {
+		return nil, fmt.Errorf("max concurrent requests must be greater than 0, got %d", max)
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &ConcurrencyLimitTransport{
+		base:  base,
+		slots: make(chan struct{}, max),
+	}, nil
+}
+
+// RoundTrip waits for a free slot, then delegates to the wrapped transport. The slot is
+// released when the response body is closed, or immediately when there is no body.
+func (c *ConcurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case c.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	release := func() { <-c.slots }
+
+	resp, err := c.base.RoundTrip(req)
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &slotReleasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// slotReleasingBody frees its request's concurrency slot the first time it is closed
+type slotReleasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+// Close closes the underlying body and releases the slot
+func (b *slotReleasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrencyLimitTransport_HoldsSlotUntilBodyClosed(t *testing.T) {
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}, nil
+	})
+	limiter, err := NewConcurrencyLimitTransport(base, 1)
+	require.NoError(t, err)
+
+	first, err := limiter.RoundTrip(newLimitTestRequest(t, context.Background()))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = limiter.RoundTrip(newLimitTestRequest(t, ctx))
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "the slot is held while the first body is unread")
+
+	require.NoError(t, first.Body.Close())
+	require.NoError(t, first.Body.Close()) // a second close must not free another slot
+
+	second, err := limiter.RoundTrip(newLimitTestRequest(t, context.Background()))
+	require.NoError(t, err)
+	defer second.Body.Close()
+	assert.Len(t, limiter.slots, 1)
+}
+
+func TestConcurrencyLimitTransport_ReleasesSlotOnError(t *testing.T) {
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+	limiter, err := NewConcurrencyLimitTransport(base, 1)
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err := limiter.RoundTrip(newLimitTestRequest(t, context.Background()))
+		require.EqualError(t, err, "connection refused")
+	}
+	assert.Empty(t, limiter.slots)
+}
+
+func newLimitTestRequest(t *testing.T, ctx context.Context) *http.Request {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.invalid/app", nil)
+	require.NoError(t, err)
+	return req
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// DefaultModifyAttempts is the number of read-modify-write attempts made by Modify methods
+// when no attempt count is given
+const DefaultModifyAttempts = 3
+
+// ConflictError is returned by conditional updates when the resource's hash no longer
+// matches the hash the caller expected, meaning someone else changed it since it was read.
+// Current holds the resource as it is now, so the caller can merge and retry. It wraps
+// ErrConflict, so errors.Is(err, ErrConflict) and IsConflict(err) report true.
+type ConflictError[T any] struct {
+	Kind         string
+	ID           string
+	ExpectedHash string
+	CurrentHash  string
+	Current      T
+}
+
+// Error implements the error interface
+func (e *ConflictError[T]) Error() string {
+	return fmt.Sprintf("%s: %s %q has hash %q, expected %q", ErrConflict, e.Kind, e.ID, e.CurrentHash, e.ExpectedHash)
+}
+
+// Unwrap returns ErrConflict
+func (e *ConflictError[T]) Unwrap() error {
+	return ErrConflict
+}
+
+// CheckHash returns a *ConflictError carrying current when currentHash differs from expected
+func CheckHash[T any](kind, id, expected, currentHash string, current T) error {
+	if currentHash == expected {
+		return nil
+	}
+	return &ConflictError[T]{Kind: kind, ID: id, ExpectedHash: expected, CurrentHash: currentHash, Current: current}
+}
+
+// ReadModifyWrite reads a resource, applies the caller's changes and writes it back with
+// a conditional update, trying again while the write fails with ErrConflict. The resource
+// is read once; when the write fails with a *ConflictError[T], the next attempt starts from
+// its Current instead of reading the resource again, and from a fresh read for any other
+// conflict. It makes at most attempts attempts (DefaultModifyAttempts when attempts < 1)
+// and returns the last conflict when all of them fail.
+func ReadModifyWrite[T any](ctx context.Context, attempts int, read func(context.Context) (T, error), write func(context.Context, T) (T, error)) (T, error) {
+	if attempts < 1 {
+		attempts = DefaultModifyAttempts
+	}
+
+	var zero T
+	current, err := read(ctx)
+	if err != nil {
+		return zero, err
+	}
+	for attempt := 1; ; attempt++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return zero, ctxErr
+		}
+
+		var written T
+		written, err = write(ctx, current)
+		if err == nil {
+			return written, nil
+		}
+		if !errors.Is(err, ErrConflict) || attempt == attempts {
+			return zero, err
+		}
+
+		var conflict *ConflictError[T]
+		if errors.As(err, &conflict) {
+			current = conflict.Current
+		} else if current, err = read(ctx); err != nil {
+			return zero, err
+		}
+	}
+}
+
+// Modifier supplies the service calls Modify makes for one kind of resource. R is the
+// resource's update request type.
+type Modifier[T, R any] struct {
+	// Kind names the resource in errors, e.g. "plan"
+	Kind string
+
+	// Get reads the resource
+	Get func(ctx context.Context, id string) (*T, *interfaces.Response, error)
+
+	// Hash returns the resource's hash
+	Hash func(current *T) string
+
+	// Request converts the resource to an update request that leaves it unchanged
+	Request func(current *T) R
+
+	// UpdateIfMatch writes req when the resource's hash still equals expectedHash, and
+	// otherwise fails with a *ConflictError[*T] carrying the resource as it is now
+	UpdateIfMatch func(ctx context.Context, id, expectedHash string, req R) (*T, *interfaces.Response, error)
+}
+
+// Modify performs the read-modify-write of the services' Modify methods: it reads the
+// resource, lets merge change an update request built from it and writes the request with
+// UpdateIfMatch, merging again into the newer resource after a conflict (see
+// ReadModifyWrite). After the first read, each attempt reads the resource once, in
+// UpdateIfMatch.
+//
+// The hash check is best-effort and client-side: the API has no conditional update, so a
+// change made between UpdateIfMatch's read and its write is not detected.
+func Modify[T, R any](ctx context.Context, id string, m Modifier[T, R], merge func(current *T, req R) error, attempts int) (*T, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", ErrInvalidInput)
+	}
+	if merge == nil {
+		return nil, nil, fmt.Errorf("%w: merge function is required", ErrInvalidInput)
+	}
+
+	var resp *interfaces.Response
+	read := func(ctx context.Context) (*T, error) {
+		var current *T
+		var err error
+		current, resp, err = m.Get(ctx, id)
+		if err == nil && current == nil {
+			err = fmt.Errorf("%w: %s %q", ErrNotFound, m.Kind, id)
+		}
+		return current, err
+	}
+	write := func(ctx context.Context, current *T) (*T, error) {
+		req := m.Request(current)
+		if err := merge(current, req); err != nil {
+			return nil, err
+		}
+		var updated *T
+		var err error
+		updated, resp, err = m.UpdateIfMatch(ctx, id, m.Hash(current), req)
+		return updated, err
+	}
+
+	updated, err := ReadModifyWrite(ctx, attempts, read, write)
+	return updated, resp, err
+}
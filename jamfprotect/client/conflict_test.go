@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConflictError(t *testing.T) {
+	assert.NoError(t, CheckHash("plan", "1", "abc", "abc", "current"))
+
+	err := CheckHash("plan", "1", "abc", "def", "current")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.True(t, IsConflict(err))
+
+	var conflict *ConflictError[string]
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, "current", conflict.Current)
+	assert.Equal(t, "def", conflict.CurrentHash)
+	assert.Contains(t, err.Error(), `plan "1"`)
+}
+
+func TestReadModifyWrite(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retries from the conflict's current value", func(t *testing.T) {
+		reads, writes := 0, 0
+		got, err := ReadModifyWrite(ctx, 3,
+			func(context.Context) (int, error) { reads++; return 1, nil },
+			func(_ context.Context, v int) (int, error) {
+				writes++
+				if v < 2 {
+					return 0, CheckHash("plan", "1", "old", "new", v+1)
+				}
+				return v * 10, nil
+			})
+		require.NoError(t, err)
+		assert.Equal(t, 20, got)
+		assert.Equal(t, 1, reads, "the conflict carries the current value")
+		assert.Equal(t, 2, writes)
+	})
+
+	t.Run("re-reads after other conflicts", func(t *testing.T) {
+		reads := 0
+		got, err := ReadModifyWrite(ctx, 3,
+			func(context.Context) (int, error) { reads++; return reads, nil },
+			func(_ context.Context, v int) (int, error) {
+				if v < 2 {
+					return 0, fmt.Errorf("%w: changed", ErrConflict)
+				}
+				return v * 10, nil
+			})
+		require.NoError(t, err)
+		assert.Equal(t, 20, got)
+		assert.Equal(t, 2, reads)
+	})
+
+	t.Run("returns last conflict", func(t *testing.T) {
+		writes := 0
+		_, err := ReadModifyWrite(ctx, 0,
+			func(context.Context) (int, error) { return 1, nil },
+			func(_ context.Context, v int) (int, error) {
+				writes++
+				return 0, CheckHash("plan", "1", "old", "new", v)
+			})
+		assert.ErrorIs(t, err, ErrConflict)
+		assert.Equal(t, DefaultModifyAttempts, writes)
+	})
+
+	t.Run("stops on other errors", func(t *testing.T) {
+		boom := errors.New("boom")
+		writes := 0
+		_, err := ReadModifyWrite(ctx, 3,
+			func(context.Context) (int, error) { return 1, nil },
+			func(context.Context, int) (int, error) { writes++; return 0, boom })
+		assert.ErrorIs(t, err, boom)
+		assert.Equal(t, 1, writes)
+	})
+}
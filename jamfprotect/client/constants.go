@@ -6,6 +6,21 @@ const (
 	DefaultBaseURL = "https://apis.jamfprotect.cloud"
 )
 
+// Tenant regions accepted by WithTenant
+const (
+	// RegionUS is the default Jamf Protect region
+	RegionUS = "us"
+
+	// RegionEU is the European Jamf Protect region
+	RegionEU = "eu"
+)
+
+// tenantURLFormats maps each region to its tenant base URL format
+var tenantURLFormats = map[string]string{
+	RegionUS: "https://%s.protect.jamfcloud.com",
+	RegionEU: "https://%s.eu.protect.jamfcloud.com",
+}
+
 // API Endpoints
 const (
 	// EndpointApp is the main GraphQL endpoint for full API access
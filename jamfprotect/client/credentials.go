@@ -0,0 +1,289 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default environment variables read by EnvCredentials
+const (
+	DefaultClientIDEnv     = "JAMFPROTECT_CLIENT_ID"
+	DefaultClientSecretEnv = "JAMFPROTECT_CLIENT_SECRET"
+)
+
+// credentialFileMode is the most permissive mode accepted for credential files
+const credentialFileMode = 0o600
+
+// Credentials are the API client credentials exchanged for access tokens
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// validate reports missing credentials
+func (c *Credentials) validate() error {
+	if c == nil {
+		return fmt.Errorf("credential provider returned no credentials")
+	}
+	return ValidateTransportConfig(c.ClientID, c.ClientSecret)
+}
+
+// CredentialProvider supplies client credentials. The token source asks the provider every
+// time it requests an access token, so a provider that re-reads its backing store picks up
+// rotated secrets without rebuilding the client.
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+// CredentialInvalidator is implemented by providers that cache credentials. The token
+// source calls InvalidateCredentials when the token endpoint rejects the credentials, then
+// asks the provider once more.
+type CredentialInvalidator interface {
+	InvalidateCredentials()
+}
+
+// CredentialProviderFunc adapts an ordinary function to the CredentialProvider interface
+type CredentialProviderFunc func(ctx context.Context) (*Credentials, error)
+
+// Credentials calls f(ctx)
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (*Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials returns a provider that always supplies the given credentials
+func StaticCredentials(clientID, clientSecret string) CredentialProvider {
+	creds := Credentials{ClientID: clientID, ClientSecret: clientSecret}
+	return CredentialProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		c := creds
+		return &c, nil
+	})
+}
+
+// EnvCredentials returns a provider that reads the client ID and secret from environment
+// variables on every call. Empty names default to DefaultClientIDEnv and DefaultClientSecretEnv.
+func EnvCredentials(clientIDEnv, clientSecretEnv string) CredentialProvider {
+	if clientIDEnv == "" {
+		clientIDEnv = DefaultClientIDEnv
+	}
+	if clientSecretEnv == "" {
+		clientSecretEnv = DefaultClientSecretEnv
+	}
+	return CredentialProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		creds := &Credentials{ClientID: os.Getenv(clientIDEnv), ClientSecret: os.Getenv(clientSecretEnv)}
+		if creds.ClientID == "" {
+			return nil, fmt.Errorf("%s environment variable is required", clientIDEnv)
+		}
+		if creds.ClientSecret == "" {
+			return nil, fmt.Errorf("%s environment variable is required", clientSecretEnv)
+		}
+		return creds, nil
+	})
+}
+
+// FileCredentials returns a provider that reads credentials from the file at path on every
+// call, so a rotated secret written to the file is used from the next token request.
+//
+// The file holds either a JSON object with client_secret and optionally client_id fields,
+// or the secret alone, in which case clientID is used. On Unix the file must not be
+// readable or writable by group or others.
+func FileCredentials(path, clientID string) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading credential file: %w", err)
+		}
+		if runtime.GOOS != "windows" && info.Mode().Perm()&^credentialFileMode != 0 {
+			return nil, fmt.Errorf("credential file %s has mode %s; it must not be accessible by group or others (chmod 600)",
+				path, info.Mode().Perm())
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading credential file: %w", err)
+		}
+		return parseCredentials(data, clientID), nil
+	})
+}
+
+// parseCredentials decodes a JSON credentials object, or treats data as the secret alone
+func parseCredentials(data []byte, clientID string) *Credentials {
+	var doc struct {
+		ClientID     string `json:"client_id"`
+		ClientSecret string `json:"client_secret"`
+	}
+	if err := json.Unmarshal(data, &doc); err == nil && doc.ClientSecret != "" {
+		if doc.ClientID != "" {
+			clientID = doc.ClientID
+		}
+		return &Credentials{ClientID: clientID, ClientSecret: doc.ClientSecret}
+	}
+	return &Credentials{ClientID: clientID, ClientSecret: strings.TrimSpace(string(data))}
+}
+
+// CommandCredentials returns a provider that runs command on every call and reads the
+// credentials from its standard output, in the same forms FileCredentials accepts: a JSON
+// object with client_secret and optionally client_id fields, or the secret alone, in which
+// case clientID is used.
+func CommandCredentials(clientID string, command ...string) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		if len(command) == 0 {
+			return nil, fmt.Errorf("credential command is empty")
+		}
+
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("credential command %s failed: %w: %s", command[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return parseCredentials(stdout.Bytes(), clientID), nil
+	})
+}
+
+// HelperCredentials runs an external credential helper in the style of git credential
+// helpers. The helper is invoked as "<command...> get" with a request on standard input:
+//
+//	protocol=https
+//	host=<host>
+//
+// and answers on standard output with key=value lines, of which username (the client ID)
+// and password (the client secret) are used:
+//
+//	username=0123456789abcdef
+//	password=s3cr3t
+//
+// Lines other than these, such as password_expiry_utc, are ignored. The helper is run for
+// every token request, so it can return a rotated secret at any time.
+type HelperCredentials struct {
+	// Command is the helper executable followed by its arguments
+	Command []string
+
+	// Host is sent to the helper to select the credentials, e.g. "acme.protect.jamfcloud.com"
+	Host string
+
+	// Timeout bounds a helper run. Defaults to 30 seconds.
+	Timeout time.Duration
+}
+
+// NewHelperCredentials returns a provider that runs the credential helper command for host
+func NewHelperCredentials(host string, command ...string) *HelperCredentials {
+	return &HelperCredentials{Command: command, Host: host}
+}
+
+// Credentials runs the helper and parses its answer
+func (h *HelperCredentials) Credentials(ctx context.Context) (*Credentials, error) {
+	if len(h.Command) == 0 {
+		return nil, fmt.Errorf("credential helper command is empty")
+	}
+
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var request bytes.Buffer
+	request.WriteString("protocol=https\n")
+	if h.Host != "" {
+		fmt.Fprintf(&request, "host=%s\n", h.Host)
+	}
+	request.WriteString("\n")
+
+	var stdout, stderr bytes.Buffer
+	args := append(append([]string(nil), h.Command[1:]...), "get")
+	cmd := exec.CommandContext(ctx, h.Command[0], args...)
+	cmd.Stdin = &request
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential helper %s failed: %w: %s", h.Command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	creds := &Credentials{}
+	scanner := bufio.NewScanner(&stdout)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "username":
+			creds.ClientID = value
+		case "password":
+			creds.ClientSecret = value
+		}
+	}
+	if creds.ClientSecret == "" {
+		return nil, fmt.Errorf("credential helper %s returned no password", h.Command[0])
+	}
+	return creds, nil
+}
+
+// CachedCredentials wraps a provider, reusing its credentials for ttl. Use it for providers
+// that are slow or rate limited, such as secrets manager APIs. The cache is discarded when
+// the token endpoint rejects the credentials, so a rotated secret is fetched immediately.
+type CachedCredentials struct {
+	provider CredentialProvider
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	creds   *Credentials
+	fetched time.Time
+}
+
+var _ CredentialInvalidator = (*CachedCredentials)(nil)
+
+// NewCachedCredentials wraps provider in a cache that holds credentials for ttl
+func NewCachedCredentials(provider CredentialProvider, ttl time.Duration) *CachedCredentials {
+	return &CachedCredentials{provider: provider, ttl: ttl}
+}
+
+// Credentials returns the cached credentials, asking the wrapped provider once they expire
+func (c *CachedCredentials) Credentials(ctx context.Context) (*Credentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.creds != nil && time.Since(c.fetched) < c.ttl {
+		creds := *c.creds
+		return &creds, nil
+	}
+
+	creds, err := c.provider.Credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cached := *creds
+	c.creds, c.fetched = &cached, time.Now()
+	return creds, nil
+}
+
+// InvalidateCredentials discards the cached credentials
+func (c *CachedCredentials) InvalidateCredentials() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.creds = nil
+	if inv, ok := c.provider.(CredentialInvalidator); ok {
+		inv.InvalidateCredentials()
+	}
+}
+
+// isCredentialRejection reports whether err is the token endpoint rejecting the credentials
+func isCredentialRejection(err error) bool {
+	var reqErr *TokenRequestError
+	if !errors.As(err, &reqErr) {
+		return false
+	}
+	return reqErr.StatusCode == StatusUnauthorized || reqErr.StatusCode == StatusBadRequest || reqErr.StatusCode == StatusForbidden
+}
@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// secretServer accepts token requests only with the current secret
+type secretServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	secret  string
+	seen    []string
+	tokenID atomic.Int32
+}
+
+func newSecretServer(t *testing.T, secret string) *secretServer {
+	t.Helper()
+	s := &secretServer{secret: secret}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case EndpointToken:
+			var req TokenRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			s.mu.Lock()
+			s.seen = append(s.seen, req.Password)
+			valid := req.Password == s.secret
+			s.mu.Unlock()
+			if !valid {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error":"invalid_client"}`))
+				return
+			}
+			n := s.tokenID.Add(1)
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: fmt.Sprintf("token-%d", n), ExpiresIn: 3600})
+		case EndpointApp:
+			w.Write([]byte(`{"data":{"ping":"pong"}}`))
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *secretServer) rotate(secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secret = secret
+}
+
+func (s *secretServer) secretsSeen() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.seen...)
+}
+
+func TestEnvCredentials(t *testing.T) {
+	t.Setenv("TEST_JP_ID", "env-id")
+	t.Setenv("TEST_JP_SECRET", "env-secret")
+
+	creds, err := EnvCredentials("TEST_JP_ID", "TEST_JP_SECRET").Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{ClientID: "env-id", ClientSecret: "env-secret"}, creds)
+
+	t.Setenv("TEST_JP_SECRET", "")
+	_, err = EnvCredentials("TEST_JP_ID", "TEST_JP_SECRET").Credentials(context.Background())
+	assert.ErrorContains(t, err, "TEST_JP_SECRET")
+}
+
+func TestFileCredentials(t *testing.T) {
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(plain, []byte("file-secret\n"), 0o600))
+
+	creds, err := FileCredentials(plain, "file-id").Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{ClientID: "file-id", ClientSecret: "file-secret"}, creds)
+
+	doc := filepath.Join(dir, "creds.json")
+	require.NoError(t, os.WriteFile(doc, []byte(`{"client_id":"json-id","client_secret":"json-secret"}`), 0o600))
+	creds, err = FileCredentials(doc, "").Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{ClientID: "json-id", ClientSecret: "json-secret"}, creds)
+
+	if runtime.GOOS != "windows" {
+		require.NoError(t, os.Chmod(plain, 0o644))
+		_, err = FileCredentials(plain, "file-id").Credentials(context.Background())
+		assert.ErrorContains(t, err, "must not be accessible by group or others")
+	}
+}
+
+func TestCommandCredentials(t *testing.T) {
+	creds, err := CommandCredentials("cmd-id", "echo", "plain-secret").Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{ClientID: "cmd-id", ClientSecret: "plain-secret"}, creds)
+
+	creds, err = CommandCredentials("cmd-id", "echo", `{"client_id":"json-id","client_secret":"json-secret"}`).Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{ClientID: "json-id", ClientSecret: "json-secret"}, creds)
+
+	_, err = CommandCredentials("cmd-id", "false").Credentials(context.Background())
+	assert.ErrorContains(t, err, "credential command false failed")
+	_, err = CommandCredentials("cmd-id").Credentials(context.Background())
+	assert.ErrorContains(t, err, "credential command is empty")
+}
+
+func TestHelperCredentials(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("helper script requires a POSIX shell")
+	}
+	dir := t.TempDir()
+	request := filepath.Join(dir, "request")
+	script := filepath.Join(dir, "helper.sh")
+	require.NoError(t, os.WriteFile(script, []byte(fmt.Sprintf(`#!/bin/sh
+[ "$1" = "get" ] || exit 1
+cat > %s
+echo "protocol=https"
+echo "username=helper-id"
+echo "password=helper-secret"
+`, request)), 0o700))
+
+	creds, err := NewHelperCredentials("acme.protect.jamfcloud.com", script).Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{ClientID: "helper-id", ClientSecret: "helper-secret"}, creds)
+
+	sent, err := os.ReadFile(request)
+	require.NoError(t, err)
+	assert.Equal(t, "protocol=https\nhost=acme.protect.jamfcloud.com\n\n", string(sent))
+
+	_, err = NewHelperCredentials("", "false").Credentials(context.Background())
+	assert.ErrorContains(t, err, "credential helper false failed")
+}
+
+func TestTransport_RereadsRotatedSecret(t *testing.T) {
+	server := newSecretServer(t, "secret-1")
+	path := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(path, []byte("secret-1"), 0o600))
+
+	transport, err := NewTransportWithCredentials(FileCredentials(path, "id"),
+		WithBaseURL(server.URL), WithLogger(zap.NewNop()))
+	require.NoError(t, err)
+
+	_, err = ping(transport)
+	require.NoError(t, err)
+
+	// Rotate the secret in the store and at the API, then force a token refresh
+	require.NoError(t, os.WriteFile(path, []byte("secret-2"), 0o600))
+	server.rotate("secret-2")
+	transport.InvalidateToken()
+
+	_, err = ping(transport)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"secret-1", "secret-2"}, server.secretsSeen())
+}
+
+func TestCachedCredentials_InvalidatedOnRejection(t *testing.T) {
+	server := newSecretServer(t, "secret-1")
+	var current atomic.Value
+	current.Store("secret-1")
+	var calls atomic.Int32
+	provider := NewCachedCredentials(CredentialProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		calls.Add(1)
+		return &Credentials{ClientID: "id", ClientSecret: current.Load().(string)}, nil
+	}), time.Hour)
+
+	transport, err := NewTransportWithCredentials(provider, WithBaseURL(server.URL), WithLogger(zap.NewNop()))
+	require.NoError(t, err)
+	_, err = ping(transport)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), calls.Load(), "construction and the first token request share the cached credentials")
+
+	current.Store("secret-2")
+	server.rotate("secret-2")
+	transport.InvalidateToken()
+
+	_, err = ping(transport)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"secret-1", "secret-1", "secret-2"}, server.secretsSeen())
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestNewTransportWithCredentials_Validates(t *testing.T) {
+	_, err := NewTransportWithCredentials(nil)
+	assert.Error(t, err)
+
+	_, err = NewTransportWithCredentials(StaticCredentials("id", ""))
+	assert.ErrorContains(t, err, "client secret cannot be empty")
+}
@@ -0,0 +1,268 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+	"go.uber.org/zap"
+)
+
+// HeaderDryRun is set on the synthetic response returned for a mutation planned in dry-run mode
+const HeaderDryRun = "X-Jamfprotect-Dry-Run"
+
+// DryRunIDPrefix prefixes the identifiers assigned to objects created in dry-run mode
+const DryRunIDPrefix = "dry-run-"
+
+// PlannedOperation is a mutation recorded instead of being sent in dry-run mode
+type PlannedOperation struct {
+	// Sequence is the 1-based position of the operation in its change set
+	Sequence int
+
+	// Service and Method identify the SDK call, e.g. "plan" and "CreatePlan"
+	Service string
+	Method  string
+
+	// Name is the GraphQL operation name, e.g. "createPlan"
+	Name string
+
+	// Path is the API endpoint path the mutation would be sent to
+	Path string
+
+	// Query is the GraphQL document
+	Query string
+
+	// Variables are the GraphQL variables built by the service
+	Variables map[string]any
+
+	// ID is the identifier of the object the mutation targets. For creates it is the
+	// synthetic identifier returned to the caller.
+	ID string
+
+	// PlannedAt is when the operation was recorded
+	PlannedAt time.Time
+}
+
+// ChangeSet collects the mutations planned in dry-run mode. It is safe for concurrent use.
+type ChangeSet struct {
+	mu         sync.Mutex
+	operations []PlannedOperation
+	createSeq  int
+}
+
+// NewChangeSet returns an empty change set
+func NewChangeSet() *ChangeSet {
+	return &ChangeSet{}
+}
+
+// Operations returns the planned operations in the order they were recorded
+func (cs *ChangeSet) Operations() []PlannedOperation {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return append([]PlannedOperation(nil), cs.operations...)
+}
+
+// Len returns the number of planned operations
+func (cs *ChangeSet) Len() int {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return len(cs.operations)
+}
+
+// Reset discards the planned operations
+func (cs *ChangeSet) Reset() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.operations = nil
+	cs.createSeq = 0
+}
+
+// record appends op and returns it with its sequence number set
+func (cs *ChangeSet) record(op PlannedOperation) PlannedOperation {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	op.Sequence = len(cs.operations) + 1
+	cs.operations = append(cs.operations, op)
+	return op
+}
+
+// nextID returns a synthetic identifier for an object created in dry-run mode
+func (cs *ChangeSet) nextID() string {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.createSeq++
+	return fmt.Sprintf("%s%d", DryRunIDPrefix, cs.createSeq)
+}
+
+type dryRunKey struct{}
+
+type changeSetKey struct{}
+
+// WithDryRunOverride returns a context whose mutations are planned (enabled) or sent
+// (disabled) regardless of whether the client was created with WithDryRun
+func WithDryRunOverride(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, enabled)
+}
+
+// WithChangeSet returns a context whose dry-run mutations are recorded in cs instead of
+// the client's change set
+func WithChangeSet(ctx context.Context, cs *ChangeSet) context.Context {
+	return context.WithValue(ctx, changeSetKey{}, cs)
+}
+
+// IsDryRun reports whether resp is the synthetic response of a mutation planned in dry-run mode
+func IsDryRun(resp *interfaces.Response) bool {
+	return resp != nil && resp.Headers.Get(HeaderDryRun) != ""
+}
+
+// dryRunEnabled reports whether mutations made with ctx are planned rather than sent
+func (t *Transport) dryRunEnabled(ctx context.Context) bool {
+	if enabled, ok := ctx.Value(dryRunKey{}).(bool); ok {
+		return enabled
+	}
+	return t.dryRun
+}
+
+// changeSetFor returns the change set that records mutations made with ctx
+func (t *Transport) changeSetFor(ctx context.Context) *ChangeSet {
+	if cs, ok := ctx.Value(changeSetKey{}).(*ChangeSet); ok && cs != nil {
+		return cs
+	}
+	return t.changeSet
+}
+
+// ChangeSet returns the change set recording the mutations planned in dry-run mode
+func (t *Transport) ChangeSet() *ChangeSet {
+	return t.changeSet
+}
+
+// wrapDryRun plans mutations instead of sending them when dry-run mode is enabled.
+// Queries always pass through, so services can still read current state.
+func (t *Transport) wrapDryRun(next GraphQLHandler) GraphQLHandler {
+	return func(ctx context.Context, op *GraphQLOperation) (*interfaces.Response, error) {
+		if !op.IsMutation() || !t.dryRunEnabled(ctx) {
+			return next(ctx, op)
+		}
+
+		cs := t.changeSetFor(ctx)
+		planned := PlannedOperation{
+			Service:   op.Service,
+			Method:    op.Method,
+			Name:      op.Name,
+			Path:      op.Path,
+			Query:     op.Query,
+			Variables: maps.Clone(op.Variables),
+			ID:        dryRunTargetID(op.Variables),
+			PlannedAt: time.Now(),
+		}
+		if planned.ID == "" {
+			planned.ID = cs.nextID()
+		}
+		planned = cs.record(planned)
+
+		t.logger.Info("Dry run: mutation planned",
+			zap.Int("sequence", planned.Sequence),
+			zap.String("operation", planned.Name),
+			zap.String("service", planned.Service),
+			zap.String("method", planned.Method),
+			zap.String("id", planned.ID))
+
+		return syntheticResult(op, planned.ID)
+	}
+}
+
+// dryRunTargetID returns the identifier variable of a mutation, or "" for creates
+func dryRunTargetID(vars map[string]any) string {
+	for _, key := range []string{"id", "uuid"} {
+		if id, ok := vars[key].(string); ok && id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// syntheticResult decodes a result built from the mutation variables into op.Target and
+// returns a synthetic 200 response. Variables whose shape differs from the result type
+// (for example an ID variable for an object field) are left at their zero value.
+func syntheticResult(op *GraphQLOperation, id string) (*interfaces.Response, error) {
+	object := maps.Clone(op.Variables)
+	if object == nil {
+		object = map[string]any{}
+	}
+	object["id"] = id
+	object["uuid"] = id
+
+	data, err := json.Marshal(map[string]any{rootField(op.Query, op.Name): object})
+	if err != nil {
+		return nil, fmt.Errorf("building dry-run result: %w", err)
+	}
+
+	if op.Target != nil {
+		var typeErr *json.UnmarshalTypeError
+		if err := json.Unmarshal(data, op.Target); err != nil && !errors.As(err, &typeErr) {
+			return nil, fmt.Errorf("building dry-run result: %w", err)
+		}
+	}
+
+	body, _ := json.Marshal(map[string]json.RawMessage{"data": data})
+	now := time.Now()
+	return &interfaces.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK (dry run)",
+		Headers: http.Header{
+			HeaderContentType: []string{ContentTypeJSON},
+			HeaderDryRun:      []string{"true"},
+		},
+		Body:       body,
+		ReceivedAt: now,
+		Size:       int64(len(body)),
+	}, nil
+}
+
+// rootField returns the response key of the first field selected by the operation, which is
+// the field's alias when it has one. It falls back to the operation name.
+func rootField(document, opName string) string {
+	loc := operationPattern.FindStringIndex(document)
+	if loc == nil {
+		return opName
+	}
+	rest := strings.TrimSpace(document[loc[1]:])
+
+	// Skip the variable definitions
+	if strings.HasPrefix(rest, "(") {
+		depth := 0
+		for i, r := range rest {
+			if r == '(' {
+				depth++
+			} else if r == ')' {
+				depth--
+				if depth == 0 {
+					rest = strings.TrimSpace(rest[i+1:])
+					break
+				}
+			}
+		}
+	}
+	if !strings.HasPrefix(rest, "{") {
+		return opName
+	}
+	rest = strings.TrimSpace(rest[1:])
+
+	end := strings.IndexFunc(rest, func(r rune) bool {
+		return !(r == '_' || r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z')
+	})
+	if end == -1 {
+		end = len(rest)
+	}
+	if end == 0 {
+		return opName
+	}
+	return rest[:end]
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCreateMutation = `
+mutation createPlan($name: String!, $actionConfigs: ID!) {
+	createPlan(input: { name: $name, actionConfigs: $actionConfigs }) {
+		id
+		name
+	}
+}`
+
+type testPlanResult struct {
+	CreatePlan *struct {
+		ID            string `json:"id"`
+		Name          string `json:"name"`
+		ActionConfigs struct {
+			ID string `json:"id"`
+		} `json:"actionConfigs"`
+	} `json:"createPlan"`
+}
+
+func TestDryRun_PlansMutations(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithDryRun())
+	require.NoError(t, err)
+
+	ctx := WithOperation(context.Background(), "plan", "CreatePlan")
+	var out testPlanResult
+	resp, err := transport.GraphQLPost(ctx, EndpointApp, testCreateMutation,
+		map[string]any{"name": "Workstations", "actionConfigs": "7"}, &out, nil)
+	require.NoError(t, err)
+	assert.True(t, IsDryRun(resp))
+	require.NotNil(t, out.CreatePlan)
+	assert.Equal(t, DryRunIDPrefix+"1", out.CreatePlan.ID)
+	assert.Equal(t, "Workstations", out.CreatePlan.Name)
+	assert.Empty(t, out.CreatePlan.ActionConfigs.ID)
+
+	_, err = transport.GraphQLPost(ctx, EndpointApp, "mutation deletePlan($id: ID!) { deletePlan(id: $id) { id } }",
+		map[string]any{"id": "42"}, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), server.appCalls.Load())
+
+	ops := transport.ChangeSet().Operations()
+	require.Len(t, ops, 2)
+	assert.Equal(t, 1, ops[0].Sequence)
+	assert.Equal(t, "createPlan", ops[0].Name)
+	assert.Equal(t, "plan", ops[0].Service)
+	assert.Equal(t, "CreatePlan", ops[0].Method)
+	assert.Equal(t, "Workstations", ops[0].Variables["name"])
+	assert.Equal(t, "deletePlan", ops[1].Name)
+	assert.Equal(t, "42", ops[1].ID)
+
+	// Queries are still sent
+	_, err = ping(transport)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), server.appCalls.Load())
+
+	transport.ChangeSet().Reset()
+	assert.Equal(t, 0, transport.ChangeSet().Len())
+}
+
+func TestDryRun_ContextOverride(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	cs := NewChangeSet()
+	ctx := WithChangeSet(WithDryRunOverride(context.Background(), true), cs)
+	_, err = transport.GraphQLPost(ctx, EndpointApp, testCreateMutation, map[string]any{"name": "A"}, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), server.appCalls.Load())
+	assert.Equal(t, 1, cs.Len())
+	assert.Equal(t, 0, transport.ChangeSet().Len())
+
+	dryRun, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithDryRun())
+	require.NoError(t, err)
+	resp, err := dryRun.GraphQLPost(WithDryRunOverride(context.Background(), false), EndpointApp,
+		"mutation deletePlan($id: ID!) { deletePlan(id: $id) { id } }", map[string]any{"id": "1"}, nil, nil)
+	require.NoError(t, err)
+	assert.False(t, IsDryRun(resp))
+	assert.Equal(t, int32(1), server.appCalls.Load())
+}
+
+func TestRootField(t *testing.T) {
+	assert.Equal(t, "createPlan", rootField(testCreateMutation, "createPlan"))
+	assert.Equal(t, "renamed", rootField("mutation m { renamed: deletePlan(id: 1) { id } }", "m"))
+	assert.Equal(t, "fallback", rootField("{ x }", "fallback"))
+}
@@ -14,6 +14,9 @@ var (
 	ErrAuthentication  = errors.New("authentication failed")
 	ErrGraphQL         = errors.New("graphql operation failed")
 	ErrNotFound        = errors.New("resource not found")
+	ErrAmbiguousName   = errors.New("ambiguous name")
+	ErrConflict        = errors.New("resource changed since it was read")
+	ErrInUse           = errors.New("resource is in use")
 	ErrInvalidInput    = errors.New("invalid input")
 	ErrRateLimited     = errors.New("rate limit exceeded")
 	ErrInvalidResponse = errors.New("invalid response format")
@@ -51,6 +54,12 @@ const (
 	ErrorCodeGraphQL          = "GraphQL"
 )
 
+// GraphQL errorType values returned by Jamf Protect
+const (
+	// GraphQLErrorTypeUnauthorized is returned when the access token is missing, expired or revoked
+	GraphQLErrorTypeUnauthorized = "UnauthorizedException"
+)
+
 // APIError represents an error response from the Jamf Protect API (HTTP or GraphQL layer).
 type APIError struct {
 	Code    string `json:"code"`
@@ -264,14 +273,20 @@ func IsForbidden(err error) bool {
 	return errors.As(err, &e) && e.StatusCode == StatusForbidden
 }
 
-// IsNotFound returns true if the error is 404 Not Found
+// IsNotFound returns true if the error is 404 Not Found or wraps ErrNotFound
 func IsNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
 	var e *APIError
 	return errors.As(err, &e) && e.StatusCode == StatusNotFound
 }
 
-// IsConflict returns true if the error is 409 Conflict
+// IsConflict returns true if the error is 409 Conflict or wraps ErrConflict
 func IsConflict(err error) bool {
+	if errors.Is(err, ErrConflict) {
+		return true
+	}
 	var e *APIError
 	return errors.As(err, &e) && e.StatusCode == StatusConflict
 }
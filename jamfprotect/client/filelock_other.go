@@ -0,0 +1,38 @@
+//go:build !unix
+
+package client
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+)
+
+// staleLockAge is the age after which an abandoned lock file is removed
+const staleLockAge = 30 * time.Second
+
+// lockFile acquires an exclusive lock by creating path exclusively, polling until the
+// lock is obtained or ctx is done. Lock files older than staleLockAge are assumed to
+// belong to a crashed process and are removed. The returned function releases the lock.
+func lockFile(ctx context.Context, path string) (func(), error) {
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_RDWR, tokenCacheFileMode)
+		if err == nil {
+			f.Close()
+			return func() { _ = os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		if info, statErr := os.Stat(path); statErr == nil && time.Since(info.ModTime()) > staleLockAge {
+			_ = os.Remove(path)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(tokenCacheLockPollInterval):
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
 )
@@ -17,6 +18,8 @@ type GraphQLRequest struct {
 
 // GraphQLPost sends a GraphQL query or mutation via HTTP POST.
 // Path is supplied by the caller (e.g. service CRUD). Headers are applied if provided (nil allowed).
+// The call passes through the middleware registered with WithMiddleware. In dry-run mode
+// (see WithDryRun) mutations are recorded in the change set instead of being sent.
 // Returns the HTTP response and any error; response is non-nil on error.
 func (t *Transport) GraphQLPost(ctx context.Context, path string, query string, variables map[string]any, target any, headers map[string]string) (*interfaces.Response, error) {
 	if path == "" {
@@ -27,10 +30,33 @@ func (t *Transport) GraphQLPost(ctx context.Context, path string, query string,
 		path = "/" + path
 	}
 
-	payload := GraphQLRequest{Query: query, Variables: variables}
+	op := newGraphQLOperation(ctx, path, query, variables, target, headers)
+	handler := GraphQLHandler(t.executeGraphQL)
+	if t.queryCache != nil {
+		handler = t.queryCache.WrapGraphQL(handler)
+	}
+	handler = t.wrapDryRun(handler)
+	handler = chainMiddleware(handler, t.middleware)
+
+	if t.telemetry == nil {
+		return handler(ctx, op)
+	}
+
+	started := time.Now()
+	ctx, span := t.telemetry.startOperation(ctx, op)
+	resp, err := handler(ctx, op)
+	t.telemetry.endOperation(ctx, span, op, started, resp, err)
+
+	return resp, err
+}
+
+// executeGraphQL performs the GraphQL request at the end of the middleware chain
+func (t *Transport) executeGraphQL(ctx context.Context, op *GraphQLOperation) (*interfaces.Response, error) {
+	payload := GraphQLRequest{Query: op.Query, Variables: op.Variables}
 	var gqlResp GraphQLResponse
 
-	clientResp, err := t.Post(ctx, path, payload, headers, &gqlResp)
+	clientResp, err := t.Post(ctx, op.Path, payload, op.Headers, &gqlResp)
+	op.Errors = gqlResp.Errors
 	if err != nil {
 		return clientResp, err
 	}
@@ -39,11 +65,11 @@ func (t *Transport) GraphQLPost(ctx context.Context, path string, query string,
 		return clientResp, err
 	}
 
-	if target == nil || len(gqlResp.Data) == 0 {
+	if op.Target == nil || len(gqlResp.Data) == 0 {
 		return clientResp, nil
 	}
 
-	if err := json.Unmarshal(gqlResp.Data, target); err != nil {
+	if err := json.Unmarshal(gqlResp.Data, op.Target); err != nil {
 		return clientResp, fmt.Errorf("decoding graphql response: %w", err)
 	}
 
@@ -0,0 +1,277 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// The transport logs through a *zap.Logger, but the logger need not write with zap:
+// NewSlogLogger and NewLogrLogger return loggers whose output goes to a slog.Handler or a
+// logr.Logger. Every logger the transport uses, including the one given to WithLogger and
+// the one receiving resty debug output, passes through the transport's redaction policy.
+
+// DefaultLogger returns the logger used when no logger option is given. It writes to the
+// handler of slog.Default(), resolved when each message is logged, so applications control
+// the SDK's output with slog.SetDefault.
+func DefaultLogger() *zap.Logger {
+	return NewSlogLogger(defaultSlogHandler{})
+}
+
+// NewSlogLogger returns a logger that writes to a log/slog handler. Zap levels map to the
+// nearest slog level; DPanic, Panic and Fatal are logged at slog.LevelError.
+func NewSlogLogger(handler slog.Handler) *zap.Logger {
+	return zap.New(&slogCore{handler: handler})
+}
+
+// NewLogrLogger returns a logger that writes to a logr.Logger
+func NewLogrLogger(logger logr.Logger) *zap.Logger {
+	return NewSlogLogger(logr.ToSlogHandler(logger))
+}
+
+// WithSlogHandler sends the client's log output to a log/slog handler
+func WithSlogHandler(handler slog.Handler) ClientOption {
+	return func(t *Transport) error {
+		if handler == nil {
+			return fmt.Errorf("slog handler cannot be nil")
+		}
+		return WithLogger(NewSlogLogger(handler))(t)
+	}
+}
+
+// WithSlogLogger sends the client's log output to a *slog.Logger
+func WithSlogLogger(logger *slog.Logger) ClientOption {
+	return func(t *Transport) error {
+		if logger == nil {
+			return fmt.Errorf("slog logger cannot be nil")
+		}
+		return WithSlogHandler(logger.Handler())(t)
+	}
+}
+
+// WithLogr sends the client's log output to a logr.Logger
+func WithLogr(logger logr.Logger) ClientOption {
+	return func(t *Transport) error {
+		if logger.GetSink() == nil {
+			return fmt.Errorf("logr logger has no sink")
+		}
+		return WithLogger(NewLogrLogger(logger))(t)
+	}
+}
+
+// WithRedactedKeys adds key name fragments (case-insensitive) whose values are redacted
+// from every log line, in addition to tokens, Authorization, client secrets, passwords,
+// API keys, report client header values and endpoint credentials
+func WithRedactedKeys(keys ...string) ClientOption {
+	return func(t *Transport) error {
+		t.logRedactor.addKeys(keys...)
+		return nil
+	}
+}
+
+// redactLogger wraps logger so that messages and fields pass through the redaction policy
+func (t *Transport) redactLogger(logger *zap.Logger) *zap.Logger {
+	return logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		if rc, ok := core.(*redactingCore); ok && rc.redactor == t.logRedactor {
+			return core
+		}
+		return &redactingCore{Core: core, redactor: t.logRedactor}
+	}))
+}
+
+// redactingCore applies a redactor to the message and fields of every entry
+type redactingCore struct {
+	zapcore.Core
+	redactor *redactor
+}
+
+// With implements zapcore.Core
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(c.redactFields(fields)), redactor: c.redactor}
+}
+
+// Check implements zapcore.Core
+func (c *redactingCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write implements zapcore.Core
+func (c *redactingCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	ent.Message = c.redactor.redactText(ent.Message)
+	return c.Core.Write(ent, c.redactFields(fields))
+}
+
+// redactFields returns fields with sensitive keys and values redacted
+func (c *redactingCore) redactFields(fields []zapcore.Field) []zapcore.Field {
+	out := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		out[i] = c.redactField(f)
+	}
+	return out
+}
+
+func (c *redactingCore) redactField(f zapcore.Field) zapcore.Field {
+	r := c.redactor
+	if f.Type != zapcore.SkipType && r.isRedacted(f.Key) {
+		return zap.String(f.Key, RedactedValue)
+	}
+
+	switch f.Type {
+	case zapcore.StringType:
+		return zap.String(f.Key, r.redactText(f.String))
+	case zapcore.ByteStringType:
+		return zap.ByteString(f.Key, []byte(r.redactText(string(f.Interface.([]byte)))))
+	case zapcore.ErrorType:
+		if err, ok := f.Interface.(error); ok && err != nil {
+			return zap.String(f.Key, r.redactText(err.Error()))
+		}
+	case zapcore.StringerType:
+		if s, ok := f.Interface.(fmt.Stringer); ok {
+			return zap.String(f.Key, r.redactText(s.String()))
+		}
+	case zapcore.ReflectType, zapcore.ObjectMarshalerType, zapcore.ArrayMarshalerType, zapcore.InlineMarshalerType:
+		enc := zapcore.NewMapObjectEncoder()
+		f.AddTo(enc)
+		if f.Type == zapcore.InlineMarshalerType {
+			return zap.Any(f.Key, r.redactValue(jsonValue(enc.Fields)))
+		}
+		return zap.Any(f.Key, r.redactValue(jsonValue(enc.Fields[f.Key])))
+	}
+	return f
+}
+
+// jsonValue converts v to the generic JSON form (maps, slices and scalars) redactValue walks
+func jsonValue(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return v
+	}
+	return out
+}
+
+// slogCore is a zapcore.Core that writes to a slog.Handler
+type slogCore struct {
+	handler slog.Handler
+}
+
+// Enabled implements zapcore.LevelEnabler
+func (c *slogCore) Enabled(level zapcore.Level) bool {
+	return c.handler.Enabled(context.Background(), SlogLevel(level))
+}
+
+// With implements zapcore.Core
+func (c *slogCore) With(fields []zapcore.Field) zapcore.Core {
+	return &slogCore{handler: c.handler.WithAttrs(slogAttrs(fields))}
+}
+
+// Check implements zapcore.Core
+func (c *slogCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write implements zapcore.Core
+func (c *slogCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	record := slog.NewRecord(ent.Time, SlogLevel(ent.Level), ent.Message, 0)
+	if ent.LoggerName != "" {
+		record.AddAttrs(slog.String("logger", ent.LoggerName))
+	}
+	record.AddAttrs(slogAttrs(fields)...)
+	return c.handler.Handle(context.Background(), record)
+}
+
+// Sync implements zapcore.Core
+func (c *slogCore) Sync() error {
+	return nil
+}
+
+// SlogLevel maps a zap level to the nearest slog level, e.g. to configure the handler
+// passed to NewSlogLogger at the level of a zap logger
+func SlogLevel(level zapcore.Level) slog.Level {
+	switch {
+	case level <= zapcore.DebugLevel:
+		return slog.LevelDebug
+	case level == zapcore.InfoLevel:
+		return slog.LevelInfo
+	case level == zapcore.WarnLevel:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
+
+// slogAttrs converts zap fields to slog attributes, preserving their order
+func slogAttrs(fields []zapcore.Field) []slog.Attr {
+	attrs := make([]slog.Attr, 0, len(fields))
+	for _, f := range fields {
+		enc := zapcore.NewMapObjectEncoder()
+		f.AddTo(enc)
+		if v, ok := enc.Fields[f.Key]; ok {
+			attrs = append(attrs, slog.Any(f.Key, v))
+			continue
+		}
+		for k, v := range enc.Fields {
+			attrs = append(attrs, slog.Any(k, v))
+		}
+	}
+	return attrs
+}
+
+// defaultSlogHandler delegates to the handler of slog.Default() at the time of each call
+type defaultSlogHandler struct{}
+
+// Enabled implements slog.Handler
+func (defaultSlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return slog.Default().Handler().Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler
+func (defaultSlogHandler) Handle(ctx context.Context, record slog.Record) error {
+	return slog.Default().Handler().Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler
+func (defaultSlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return slog.Default().Handler().WithAttrs(attrs)
+}
+
+// WithGroup implements slog.Handler
+func (defaultSlogHandler) WithGroup(name string) slog.Handler {
+	return slog.Default().Handler().WithGroup(name)
+}
+
+// restyLogger routes resty's own log output, including WithDebug request and response
+// dumps, to the transport's logger so that it is redacted like every other log line
+type restyLogger struct {
+	transport *Transport
+}
+
+// Errorf implements resty.Logger
+func (l *restyLogger) Errorf(format string, v ...any) {
+	l.transport.logger.Error(fmt.Sprintf(format, v...), zap.String("source", "resty"))
+}
+
+// Warnf implements resty.Logger
+func (l *restyLogger) Warnf(format string, v ...any) {
+	l.transport.logger.Warn(fmt.Sprintf(format, v...), zap.String("source", "resty"))
+}
+
+// Debugf implements resty.Logger. Resty emits debug dumps only when WithDebug is set, so
+// they are logged at info level to be visible with the default logger.
+func (l *restyLogger) Debugf(format string, v ...any) {
+	l.transport.logger.Info(fmt.Sprintf(format, v...), zap.String("source", "resty"))
+}
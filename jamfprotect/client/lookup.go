@@ -0,0 +1,31 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MatchName returns the only item whose name equals name. Names are compared
+// exactly. It returns an error wrapping ErrNotFound when no item matches, and one wrapping
+// ErrAmbiguousName, listing the matching IDs, when several do. kind names the resource in
+// error messages, e.g. "plan".
+func MatchName[T any](kind, name string, items []T, nameOf, idOf func(T) string) (T, error) {
+	var matches []T
+	var ids []string
+	for _, item := range items {
+		if nameOf(item) == name {
+			matches = append(matches, item)
+			ids = append(ids, idOf(item))
+		}
+	}
+
+	var zero T
+	switch len(matches) {
+	case 0:
+		return zero, fmt.Errorf("%w: no %s named %q", ErrNotFound, kind, name)
+	case 1:
+		return matches[0], nil
+	default:
+		return zero, fmt.Errorf("%w: %d %s items named %q (ids %s)", ErrAmbiguousName, len(ids), kind, name, strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// Operation types reported by ParseOperation
+const (
+	OperationTypeQuery        = "query"
+	OperationTypeMutation     = "mutation"
+	OperationTypeSubscription = "subscription"
+)
+
+// GraphQLOperation describes one GraphQLPost call as seen by middleware.
+// After the next handler returns, Target holds the decoded data and Errors the GraphQL
+// errors returned by the API.
+type GraphQLOperation struct {
+	// Name is the operation name parsed from the document, e.g. "updatePlan"
+	Name string
+
+	// Type is the operation type: OperationTypeQuery, OperationTypeMutation or OperationTypeSubscription
+	Type string
+
+	// Service, Method and Page identify the SDK call, when the service recorded it (see WithOperation)
+	Service string
+	Method  string
+	Page    int
+
+	// Path is the API endpoint path, e.g. EndpointApp
+	Path string
+
+	// Query is the GraphQL document
+	Query string
+
+	// Variables are the GraphQL variables. Middleware may modify them before calling next.
+	Variables map[string]any
+
+	// Headers are the per-request HTTP headers (may be nil)
+	Headers map[string]string
+
+	// Target is the pointer the response data is decoded into (may be nil)
+	Target any
+
+	// Errors are the GraphQL errors in the response, populated once the request completes
+	Errors []GraphQLError
+}
+
+// IsMutation reports whether the operation is a mutation
+func (op *GraphQLOperation) IsMutation() bool {
+	return op.Type == OperationTypeMutation
+}
+
+// GraphQLHandler executes a GraphQL operation
+type GraphQLHandler func(ctx context.Context, op *GraphQLOperation) (*interfaces.Response, error)
+
+// Middleware wraps the handling of every GraphQL operation. Implementations can inspect or
+// modify the operation, short-circuit it by returning without calling next (policy checks,
+// fault injection), or observe the response and error (audit logging, metrics).
+type Middleware interface {
+	WrapGraphQL(next GraphQLHandler) GraphQLHandler
+}
+
+// MiddlewareFunc adapts an ordinary function to the Middleware interface
+type MiddlewareFunc func(next GraphQLHandler) GraphQLHandler
+
+// WrapGraphQL calls f(next)
+func (f MiddlewareFunc) WrapGraphQL(next GraphQLHandler) GraphQLHandler {
+	return f(next)
+}
+
+// newGraphQLOperation builds the operation description for a GraphQLPost call
+func newGraphQLOperation(ctx context.Context, path, query string, variables map[string]any, target any, headers map[string]string) *GraphQLOperation {
+	opType, opName := ParseOperation(query)
+	info, _ := OperationFromContext(ctx)
+	return &GraphQLOperation{
+		Name:      opName,
+		Type:      opType,
+		Service:   info.Service,
+		Method:    info.Method,
+		Page:      info.Page,
+		Path:      path,
+		Query:     query,
+		Variables: variables,
+		Headers:   headers,
+		Target:    target,
+	}
+}
+
+// chainMiddleware wraps handler so that the first middleware is the outermost
+func chainMiddleware(handler GraphQLHandler, middleware []Middleware) GraphQLHandler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i].WrapGraphQL(handler)
+	}
+	return handler
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware_OrderAndResult(t *testing.T) {
+	server := newRevokingServer(t)
+	var calls []string
+	var seen *GraphQLOperation
+
+	record := func(name string) Middleware {
+		return MiddlewareFunc(func(next GraphQLHandler) GraphQLHandler {
+			return func(ctx context.Context, op *GraphQLOperation) (*interfaces.Response, error) {
+				calls = append(calls, name+":before")
+				resp, err := next(ctx, op)
+				calls = append(calls, name+":after")
+				seen = op
+				return resp, err
+			}
+		})
+	}
+
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL),
+		WithMiddleware(record("outer"), record("inner")))
+	require.NoError(t, err)
+
+	ctx := WithOperation(context.Background(), "plan", "GetPlan")
+	var out struct {
+		Ping string `json:"ping"`
+	}
+	_, err = transport.GraphQLPost(ctx, EndpointApp, "query ping($id: ID!) { ping }", map[string]any{"id": "1"}, &out, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"outer:before", "inner:before", "inner:after", "outer:after"}, calls)
+	require.NotNil(t, seen)
+	assert.Equal(t, "ping", seen.Name)
+	assert.Equal(t, OperationTypeQuery, seen.Type)
+	assert.Equal(t, "plan", seen.Service)
+	assert.Equal(t, "GetPlan", seen.Method)
+	assert.Equal(t, "1", seen.Variables["id"])
+	assert.Equal(t, "pong", seen.Target.(*struct {
+		Ping string `json:"ping"`
+	}).Ping)
+}
+
+func TestMiddleware_ShortCircuitsMutation(t *testing.T) {
+	server := newRevokingServer(t)
+	errOutsideChangeWindow := errors.New("deletes are blocked outside the change window")
+
+	policy := MiddlewareFunc(func(next GraphQLHandler) GraphQLHandler {
+		return func(ctx context.Context, op *GraphQLOperation) (*interfaces.Response, error) {
+			if op.IsMutation() && op.Name == "deletePlan" {
+				return nil, errOutsideChangeWindow
+			}
+			return next(ctx, op)
+		}
+	})
+
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithMiddleware(policy))
+	require.NoError(t, err)
+
+	_, err = transport.GraphQLPost(context.Background(), EndpointApp, "mutation deletePlan($id: ID!) { deletePlan(id: $id) { id } }", nil, nil, nil)
+	assert.ErrorIs(t, err, errOutsideChangeWindow)
+	assert.Equal(t, int32(0), server.appCalls.Load())
+
+	_, err = ping(transport)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), server.appCalls.Load())
+}
+
+func TestMiddleware_SeesGraphQLErrors(t *testing.T) {
+	server := newRevokingServer(t)
+	var errorCount atomic.Int32
+
+	audit := MiddlewareFunc(func(next GraphQLHandler) GraphQLHandler {
+		return func(ctx context.Context, op *GraphQLOperation) (*interfaces.Response, error) {
+			resp, err := next(ctx, op)
+			errorCount.Store(int32(len(op.Errors)))
+			return resp, err
+		}
+	})
+
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithMiddleware(audit), WithAuthRetryDisabled())
+	require.NoError(t, err)
+	server.graphQL401 = true
+	server.revoke("token-1")
+
+	_, err = ping(transport)
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), errorCount.Load())
+}
+
+func TestWithMiddleware_RejectsNil(t *testing.T) {
+	_, err := NewTransport("id", "secret", WithMiddleware(nil))
+	assert.Error(t, err)
+}
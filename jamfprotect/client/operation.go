@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"regexp"
+)
+
+// operationPattern matches the first operation definition in a GraphQL document.
+// Fragment definitions may precede it.
+var operationPattern = regexp.MustCompile(`(?m)^\s*(query|mutation|subscription)\b\s*([_A-Za-z][_0-9A-Za-z]*)?`)
+
+// OperationInfo describes the SDK call that issued a GraphQL request
+type OperationInfo struct {
+	// Service is the SDK service name, e.g. "plan"
+	Service string
+
+	// Method is the service method name, e.g. "ListPlans"
+	Method string
+
+	// Page is the 1-based page number for paginated list calls, or 0 otherwise
+	Page int
+}
+
+type operationContextKey struct{}
+
+// WithOperation returns a context that attributes requests to the given service method.
+// Services call this so that spans and metrics identify the SDK call, not just the endpoint.
+func WithOperation(ctx context.Context, service, method string) context.Context {
+	info, _ := OperationFromContext(ctx)
+	info.Service = service
+	info.Method = method
+	info.Page = 0
+	return context.WithValue(ctx, operationContextKey{}, info)
+}
+
+// WithPage returns a context that records the page number of a paginated list call
+func WithPage(ctx context.Context, page int) context.Context {
+	info, _ := OperationFromContext(ctx)
+	info.Page = page
+	return context.WithValue(ctx, operationContextKey{}, info)
+}
+
+// OperationFromContext returns the operation recorded by WithOperation and WithPage
+func OperationFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationContextKey{}).(OperationInfo)
+	return info, ok
+}
+
+// ParseOperation returns the operation type ("query", "mutation" or "subscription") and
+// operation name of the first operation in a GraphQL document. Anonymous shorthand
+// queries ("{ ... }") are reported as a query with an empty name.
+func ParseOperation(document string) (opType, opName string) {
+	m := operationPattern.FindStringSubmatch(document)
+	if m == nil {
+		return OperationTypeQuery, ""
+	}
+	return m[1], m[2]
+}
@@ -1,13 +1,43 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"time"
 
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// Span and metric attribute keys
+const (
+	AttrOperationName  = attribute.Key("graphql.operation.name")
+	AttrOperationType  = attribute.Key("graphql.operation.type")
+	AttrVariables      = attribute.Key("graphql.variables")
+	AttrErrorCount     = attribute.Key("graphql.error.count")
+	AttrService        = attribute.Key("jamfprotect.service")
+	AttrMethod         = attribute.Key("jamfprotect.method")
+	AttrPage           = attribute.Key("jamfprotect.page")
+	AttrOutcome        = attribute.Key("jamfprotect.outcome")
+	AttrBackground     = attribute.Key("jamfprotect.token.background")
+	AttrHTTPStatusCode = attribute.Key("http.response.status_code")
+)
+
+// Metric instrument names
+const (
+	MetricOperationDuration = "jamfprotect.client.operation.duration"
+	MetricRetries           = "jamfprotect.client.retries"
+	MetricTokenRefreshes    = "jamfprotect.client.token.refreshes"
+	MetricThrottled         = "jamfprotect.client.throttled"
 )
 
 // OTelConfig holds OpenTelemetry configuration options
@@ -16,42 +46,85 @@ type OTelConfig struct {
 	// If nil, the global tracer provider will be used.
 	TracerProvider trace.TracerProvider
 
+	// MeterProvider is the OpenTelemetry meter provider to use.
+	// If nil, the global meter provider will be used.
+	MeterProvider metric.MeterProvider
+
 	// Propagators is the propagator to use for context propagation.
 	// If nil, the global propagator will be used.
 	Propagators propagation.TextMapPropagator
 
-	// ServiceName is the name of the service for tracing spans.
+	// ServiceName names the tracer and meter that emit the client's spans and metrics.
 	// Defaults to "jamfprotect-client"
 	ServiceName string
 
-	// SpanNameFormatter allows customizing span names.
+	// SpanNameFormatter allows customizing HTTP span names.
 	// If nil, defaults to "HTTP {method}" format.
 	SpanNameFormatter func(operation string, req *http.Request) string
+
+	// RecordVariables attaches the GraphQL variables to operation spans as JSON.
+	// Values of variables whose names contain "password", "secret", "token",
+	// "authorization" or "apikey", or match RedactVariables, are replaced with RedactedValue.
+	RecordVariables bool
+
+	// RedactVariables lists additional variable names (case-insensitive, matched at any
+	// nesting depth) whose values are redacted when RecordVariables is set
+	RedactVariables []string
 }
 
 // DefaultOTelConfig returns a default OpenTelemetry configuration
 func DefaultOTelConfig() *OTelConfig {
 	return &OTelConfig{
-		TracerProvider: otel.GetTracerProvider(),
-		Propagators:    otel.GetTextMapPropagator(),
-		ServiceName:    "jamfprotect-client",
+		TracerProvider:  otel.GetTracerProvider(),
+		MeterProvider:   otel.GetMeterProvider(),
+		Propagators:     otel.GetTextMapPropagator(),
+		ServiceName:     "jamfprotect-client",
+		RecordVariables: true,
 	}
 }
 
-// EnableTracing wraps the HTTP client transport with OpenTelemetry instrumentation.
-// This provides automatic tracing for all HTTP requests made by the client.
+// telemetry holds the tracer and metric instruments used once tracing is enabled
+type telemetry struct {
+	tracer          trace.Tracer
+	recordVariables bool
+	redactor        *redactor
+
+	operationDuration metric.Float64Histogram
+	retries           metric.Int64Counter
+	tokenRefreshes    metric.Int64Counter
+	throttled         metric.Int64Counter
+}
+
+// EnableTracing instruments the client with OpenTelemetry.
 //
 // The instrumentation captures:
-// - HTTP method, URL, status code
-// - Request and response headers (configurable)
-// - Error details
-// - Request/response timing
+// - One span per GraphQL operation, named "{type} {operationName}", with the SDK service
+// and method, page number for paginated calls, redacted variables and GraphQL error count
+// - A child HTTP span per attempt from otelhttp (method, URL, status code, timing)
+// - Metrics for operation latency, retries, token refreshes and throttled (429) responses
 //
-// All spans follow OpenTelemetry semantic conventions for HTTP clients.
+// HTTP spans follow OpenTelemetry semantic conventions for HTTP clients.
 func (t *Transport) EnableTracing(config *OTelConfig) error {
 	if config == nil {
 		config = DefaultOTelConfig()
 	}
+	if config.TracerProvider == nil {
+		config.TracerProvider = otel.GetTracerProvider()
+	}
+	if config.MeterProvider == nil {
+		config.MeterProvider = otel.GetMeterProvider()
+	}
+	if config.Propagators == nil {
+		config.Propagators = otel.GetTextMapPropagator()
+	}
+	if config.ServiceName == "" {
+		config.ServiceName = "jamfprotect-client"
+	}
+
+	tel, err := newTelemetry(config)
+	if err != nil {
+		return err
+	}
 
 	// Get the underlying HTTP client from resty
 	httpClient := t.client.Client()
@@ -68,6 +141,7 @@ func (t *Transport) EnableTracing(config *OTelConfig) error {
 	// Configure otelhttp options
 	opts := []otelhttp.Option{
 		otelhttp.WithTracerProvider(config.TracerProvider),
+		otelhttp.WithMeterProvider(config.MeterProvider),
 		otelhttp.WithPropagators(config.Propagators),
 	}
 
@@ -77,11 +151,136 @@ func (t *Transport) EnableTracing(config *OTelConfig) error {
 	}
 
 	// Wrap transport with OpenTelemetry instrumentation
-	instrumentedTransport := otelhttp.NewTransport(transport, opts...)
+	instrumentedTransport := otelhttp.NewTransport(&throttleCountingTransport{base: transport, telemetry: tel}, opts...)
 	httpClient.Transport = instrumentedTransport
 
+	t.client.AddRetryHooks(func(resp *resty.Response, err error) {
+		ctx := context.Background()
+		var attrs []attribute.KeyValue
+		if resp != nil && resp.Request != nil {
+			ctx = resp.Request.Context()
+			attrs = append(attrs, AttrHTTPStatusCode.Int(resp.StatusCode()))
+		}
+		tel.retries.Add(ctx, 1, metric.WithAttributes(append(attrs, operationAttributes(ctx)...)...))
+	})
+
+	if t.tokenManager != nil {
+		t.tokenManager.setTelemetry(tel)
+	}
+	t.telemetry = tel
+
 	t.logger.Info("OpenTelemetry tracing enabled",
 		zap.String("service_name", config.ServiceName))
 
 	return nil
 }
+
+// newTelemetry creates the tracer and metric instruments
+func newTelemetry(config *OTelConfig) (*telemetry, error) {
+	meter := config.MeterProvider.Meter(config.ServiceName, metric.WithInstrumentationVersion(Version))
+
+	tel := &telemetry{
+		tracer:          config.TracerProvider.Tracer(config.ServiceName, trace.WithInstrumentationVersion(Version)),
+		recordVariables: config.RecordVariables,
+		redactor:        newRedactor(config.RedactVariables),
+	}
+
+	var err error
+	if tel.operationDuration, err = meter.Float64Histogram(MetricOperationDuration,
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of Jamf Protect GraphQL operations, including retries")); err != nil {
+		return nil, err
+	}
+	if tel.retries, err = meter.Int64Counter(MetricRetries,
+		metric.WithDescription("Number of HTTP request retries")); err != nil {
+		return nil, err
+	}
+	if tel.tokenRefreshes, err = meter.Int64Counter(MetricTokenRefreshes,
+		metric.WithDescription("Number of OAuth2 access token fetches")); err != nil {
+		return nil, err
+	}
+	if tel.throttled, err = meter.Int64Counter(MetricThrottled,
+		metric.WithDescription("Number of HTTP responses rejected with 429 Too Many Requests")); err != nil {
+		return nil, err
+	}
+
+	return tel, nil
+}
+
+// startOperation starts the span for a GraphQL operation
+func (tel *telemetry) startOperation(ctx context.Context, op *GraphQLOperation) (context.Context, trace.Span) {
+	spanName := op.Type
+	if op.Name != "" {
+		spanName = op.Type + " " + op.Name
+	}
+
+	attrs := []attribute.KeyValue{
+		AttrOperationType.String(op.Type),
+		AttrOperationName.String(op.Name),
+	}
+	attrs = append(attrs, operationAttributes(ctx)...)
+	if op.Page > 0 {
+		attrs = append(attrs, AttrPage.Int(op.Page))
+	}
+	if tel.recordVariables && len(op.Variables) > 0 {
+		if data, err := json.Marshal(tel.redactor.redactMap(op.Variables)); err == nil {
+			attrs = append(attrs, AttrVariables.String(string(data)))
+		}
+	}
+
+	return tel.tracer.Start(ctx, spanName, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// endOperation finishes the operation span and records its latency
+func (tel *telemetry) endOperation(ctx context.Context, span trace.Span, op *GraphQLOperation, started time.Time, resp *interfaces.Response, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.SetAttributes(AttrErrorCount.Int(len(op.Errors)))
+	if resp != nil && resp.StatusCode > 0 {
+		span.SetAttributes(AttrHTTPStatusCode.Int(resp.StatusCode))
+	}
+	span.End()
+
+	attrs := append([]attribute.KeyValue{AttrOperationName.String(op.Name), AttrOutcome.String(outcome)}, operationAttributes(ctx)...)
+	tel.operationDuration.Record(ctx, time.Since(started).Seconds(), metric.WithAttributes(attrs...))
+}
+
+// recordTokenRefresh counts a token fetch
+func (tel *telemetry) recordTokenRefresh(background bool, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	tel.tokenRefreshes.Add(context.Background(), 1, metric.WithAttributes(
+		AttrBackground.Bool(background),
+		AttrOutcome.String(outcome)))
+}
+
+// operationAttributes returns the service and method recorded on the context
+func operationAttributes(ctx context.Context) []attribute.KeyValue {
+	info, ok := OperationFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return []attribute.KeyValue{AttrService.String(info.Service), AttrMethod.String(info.Method)}
+}
+
+// throttleCountingTransport counts 429 responses on every attempt, including retried ones
+type throttleCountingTransport struct {
+	base      http.RoundTripper
+	telemetry *telemetry
+}
+
+// RoundTrip implements http.RoundTripper
+func (c *throttleCountingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := c.base.RoundTrip(req)
+	if err == nil && resp.StatusCode == StatusTooManyRequests {
+		c.telemetry.throttled.Add(req.Context(), 1, metric.WithAttributes(operationAttributes(req.Context())...))
+	}
+	return resp, err
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTracedTransport(t *testing.T, handler http.HandlerFunc, config *OTelConfig, options ...ClientOption) (*Transport, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	config.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	config.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	options = append([]ClientOption{WithBaseURL(server.URL), WithTracing(config)}, options...)
+	transport, err := NewTransport("id", "secret", options...)
+	require.NoError(t, err)
+	return transport, recorder, reader
+}
+
+func operationSpan(t *testing.T, recorder *tracetest.SpanRecorder, name string) sdktrace.ReadOnlySpan {
+	t.Helper()
+	for _, span := range recorder.Ended() {
+		if span.Name() == name {
+			return span
+		}
+	}
+	t.Fatalf("no span named %q", name)
+	return nil
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func counterTotal(t *testing.T, reader *sdkmetric.ManualReader, name string) int64 {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			var total int64
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				total += dp.Value
+			}
+			return total
+		}
+	}
+	return 0
+}
+
+func TestParseOperation(t *testing.T) {
+	tests := []struct {
+		document string
+		opType   string
+		opName   string
+	}{
+		{"query getPlan($id: ID!) { getPlan(id: $id) { id } }", "query", "getPlan"},
+		{"\nfragment PlanFields on Plan { id }\n\nmutation createPlan { createPlan { ...PlanFields } }", "mutation", "createPlan"},
+		{"{ __typename }", "query", ""},
+		{"query { __typename }", "query", ""},
+	}
+	for _, tt := range tests {
+		opType, opName := ParseOperation(tt.document)
+		assert.Equal(t, tt.opType, opType)
+		assert.Equal(t, tt.opName, opName)
+	}
+}
+
+func TestTracing_OperationSpan(t *testing.T) {
+	config := DefaultOTelConfig()
+	config.RedactVariables = []string{"description"}
+	transport, recorder, reader := newTracedTransport(t, healthyTenant, config)
+
+	ctx := WithPage(WithOperation(context.Background(), "plan", "ListPlans"), 3)
+	vars := map[string]any{
+		"name":        "Baseline",
+		"description": "internal",
+		"nextToken":   "opaque",
+		"config":      map[string]any{"password": "hunter2"},
+	}
+	_, err := transport.GraphQLPost(ctx, EndpointApp, "query listPlans { listPlans { items { id } } }", vars, nil, nil)
+	require.NoError(t, err)
+
+	span := operationSpan(t, recorder, "query listPlans")
+	service, _ := spanAttribute(span, AttrService)
+	method, _ := spanAttribute(span, AttrMethod)
+	page, _ := spanAttribute(span, AttrPage)
+	errorCount, _ := spanAttribute(span, AttrErrorCount)
+	assert.Equal(t, "plan", service.AsString())
+	assert.Equal(t, "ListPlans", method.AsString())
+	assert.Equal(t, int64(3), page.AsInt64())
+	assert.Equal(t, int64(0), errorCount.AsInt64())
+
+	raw, ok := spanAttribute(span, AttrVariables)
+	require.True(t, ok)
+	var recorded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(raw.AsString()), &recorded))
+	assert.Equal(t, "Baseline", recorded["name"])
+	assert.Equal(t, RedactedValue, recorded["description"])
+	assert.Equal(t, "opaque", recorded["nextToken"], "pagination cursors are not secrets")
+	assert.Equal(t, RedactedValue, recorded["config"].(map[string]any)["password"])
+
+	assert.Equal(t, int64(1), counterTotal(t, reader, MetricTokenRefreshes))
+}
+
+func TestTracing_GraphQLErrors(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == EndpointToken {
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: "token", ExpiresIn: 3600})
+			return
+		}
+		w.Write([]byte(`{"errors":[{"message":"bad"},{"message":"worse"}]}`))
+	}
+	transport, recorder, _ := newTracedTransport(t, handler, &OTelConfig{})
+
+	_, err := transport.GraphQLPost(context.Background(), EndpointApp, "mutation deletePlan { deletePlan { id } }", nil, nil, nil)
+	require.Error(t, err)
+
+	span := operationSpan(t, recorder, "mutation deletePlan")
+	errorCount, _ := spanAttribute(span, AttrErrorCount)
+	assert.Equal(t, int64(2), errorCount.AsInt64())
+	assert.Equal(t, "Error", span.Status().Code.String())
+	_, recorded := spanAttribute(span, AttrVariables)
+	assert.False(t, recorded, "variables are not recorded unless enabled")
+}
+
+func TestTracing_RetriesAndThrottling(t *testing.T) {
+	var appCalls atomic.Int32
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == EndpointToken {
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: "token", ExpiresIn: 3600})
+			return
+		}
+		if appCalls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"slow down"}`))
+			return
+		}
+		w.Write([]byte(`{"data":{}}`))
+	}
+	transport, _, reader := newTracedTransport(t, handler, &OTelConfig{},
+		WithRetryWaitTime(0), WithRetryMaxWaitTime(0))
+	// GraphQL requests are POSTs, which resty only retries when explicitly allowed
+	transport.client.SetAllowNonIdempotentRetry(true)
+
+	_, err := transport.GraphQLPost(context.Background(), EndpointApp, "query ping { ping }", nil, nil, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), counterTotal(t, reader, MetricThrottled))
+	assert.Equal(t, int64(1), counterTotal(t, reader, MetricRetries))
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// probeQuery is the cheapest GraphQL operation accepted by the /app endpoint
+const probeQuery = `query ProbeQuery { __typename }`
+
+// TenantNotFoundError is returned by Probe when the base URL does not resolve to a
+// Jamf Protect tenant (unknown host, 404, or a non-API response).
+type TenantNotFoundError struct {
+	BaseURL string
+	Err     error
+}
+
+// Error implements the error interface
+func (e *TenantNotFoundError) Error() string {
+	return fmt.Sprintf("no Jamf Protect tenant at %s: %v", e.BaseURL, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *TenantNotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidCredentialsError is returned by Probe when the tenant rejects the client credentials
+type InvalidCredentialsError struct {
+	ClientID   string
+	StatusCode int
+	Err        error
+}
+
+// Error implements the error interface
+func (e *InvalidCredentialsError) Error() string {
+	return fmt.Sprintf("invalid credentials for client %q (status %d): %v", e.ClientID, e.StatusCode, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *InvalidCredentialsError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports InvalidCredentialsError as an ErrAuthentication
+func (e *InvalidCredentialsError) Is(target error) bool {
+	return target == ErrAuthentication
+}
+
+// NetworkError is returned by Probe when the tenant could not be reached
+// (connection refused, timeout, TLS failure, proxy error).
+type NetworkError struct {
+	BaseURL string
+	Err     error
+}
+
+// Error implements the error interface
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("cannot reach %s: %v", e.BaseURL, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+// Probe verifies that the configured tenant is reachable and accepts the client credentials
+// by obtaining an access token and issuing a trivial GraphQL query. A cached token that is
+// still valid is reused, so the credentials are only re-checked once it expires.
+// Failures are reported as *NetworkError, *TenantNotFoundError or *InvalidCredentialsError.
+func (t *Transport) Probe(ctx context.Context) error {
+	if _, err := t.tokenManager.GetToken(ctx); err != nil {
+		return t.classifyProbeError(err)
+	}
+
+	if _, err := t.GraphQLPost(ctx, EndpointApp, probeQuery, nil, nil, nil); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.Code == ErrorCodeGraphQL &&
+			!strings.Contains(apiErr.Message, GraphQLErrorTypeUnauthorized) {
+			// The tenant answered with a GraphQL-level error: it exists and accepted the token
+			t.logger.Debug("Startup probe query returned GraphQL errors", zap.Error(err))
+		} else {
+			return t.classifyProbeError(err)
+		}
+	}
+
+	t.logger.Info("Startup probe succeeded", zap.String("base_url", t.baseURL))
+	return nil
+}
+
+// classifyProbeError maps a probe failure onto a typed probe error
+func (t *Transport) classifyProbeError(err error) error {
+	var (
+		dnsErr   *net.DNSError
+		urlErr   *url.Error
+		netErr   net.Error
+		tokenErr *TokenRequestError
+		apiErr   *APIError
+		jsonErr  *json.SyntaxError
+	)
+
+	switch {
+	case errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+		return &TenantNotFoundError{BaseURL: t.baseURL, Err: err}
+	case errors.As(err, &tokenErr):
+		return t.classifyStatus(tokenErr.StatusCode, err)
+	case errors.As(err, &apiErr) && apiErr.Code == ErrorCodeGraphQL:
+		return &InvalidCredentialsError{ClientID: t.authConfig.ClientID, StatusCode: StatusUnauthorized, Err: err}
+	case errors.As(err, &apiErr):
+		return t.classifyStatus(apiErr.StatusCode, err)
+	case errors.As(err, &jsonErr), errors.Is(err, ErrInvalidResponse):
+		// An HTML or other non-JSON page is served where the API should be
+		return &TenantNotFoundError{BaseURL: t.baseURL, Err: err}
+	case errors.As(err, &netErr), errors.As(err, &urlErr):
+		return &NetworkError{BaseURL: t.baseURL, Err: err}
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return &NetworkError{BaseURL: t.baseURL, Err: err}
+	default:
+		return err
+	}
+}
+
+// classifyStatus maps an HTTP status from the token or GraphQL endpoint onto a probe error
+func (t *Transport) classifyStatus(statusCode int, err error) error {
+	switch statusCode {
+	case StatusBadRequest, StatusUnauthorized, StatusForbidden:
+		return &InvalidCredentialsError{ClientID: t.authConfig.ClientID, StatusCode: statusCode, Err: err}
+	case StatusNotFound:
+		return &TenantNotFoundError{BaseURL: t.baseURL, Err: err}
+	case StatusBadGateway, StatusServiceUnavailable, StatusGatewayTimeout:
+		return &NetworkError{BaseURL: t.baseURL, Err: err}
+	default:
+		return err
+	}
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProbeServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func healthyTenant(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case EndpointToken:
+		json.NewEncoder(w).Encode(TokenResponse{AccessToken: "token", ExpiresIn: 3600})
+	case EndpointApp:
+		w.Write([]byte(`{"data":{"__typename":"Query"}}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestProbe_Success(t *testing.T) {
+	server := newProbeServer(t, healthyTenant)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	assert.NoError(t, transport.Probe(context.Background()))
+}
+
+func TestProbe_InvalidCredentials(t *testing.T) {
+	server := newProbeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid_client"}`))
+	})
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	err = transport.Probe(context.Background())
+
+	var credErr *InvalidCredentialsError
+	require.ErrorAs(t, err, &credErr)
+	assert.Equal(t, "id", credErr.ClientID)
+	assert.Equal(t, http.StatusUnauthorized, credErr.StatusCode)
+	assert.ErrorIs(t, err, ErrAuthentication)
+}
+
+func TestProbe_RejectedToken(t *testing.T) {
+	server := newProbeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == EndpointToken {
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: "token", ExpiresIn: 3600})
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errors":[{"errorType":"UnauthorizedException","message":"denied"}]}`))
+	})
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	var credErr *InvalidCredentialsError
+	assert.ErrorAs(t, transport.Probe(context.Background()), &credErr)
+}
+
+func TestProbe_TenantNotFound(t *testing.T) {
+	server := newProbeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	var notFound *TenantNotFoundError
+	require.ErrorAs(t, transport.Probe(context.Background()), &notFound)
+	assert.Equal(t, server.URL, notFound.BaseURL)
+}
+
+func TestProbe_NonAPIResponse(t *testing.T) {
+	server := newProbeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html>parked domain</html>`))
+	})
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	var notFound *TenantNotFoundError
+	assert.ErrorAs(t, transport.Probe(context.Background()), &notFound)
+}
+
+func TestProbe_NetworkFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(healthyTenant))
+	url := server.URL
+	server.Close()
+
+	transport, err := NewTransport("id", "secret", WithBaseURL(url))
+	require.NoError(t, err)
+
+	var netErr *NetworkError
+	assert.ErrorAs(t, transport.Probe(context.Background()), &netErr)
+}
+
+func TestClassifyProbeError_UnknownHost(t *testing.T) {
+	transport := &Transport{baseURL: "https://missing.protect.jamfcloud.com", authConfig: &AuthConfig{}}
+	dnsErr := &net.DNSError{Err: "no such host", Name: "missing.protect.jamfcloud.com", IsNotFound: true}
+
+	err := transport.classifyProbeError(errors.Join(errors.New("requesting token"), dnsErr))
+
+	var notFound *TenantNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestNewTransport_StartupProbe(t *testing.T) {
+	server := newProbeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithStartupProbe())
+
+	var credErr *InvalidCredentialsError
+	assert.ErrorAs(t, err, &credErr)
+
+	healthy := newProbeServer(t, healthyTenant)
+	_, err = NewTransport("id", "secret", WithBaseURL(healthy.URL), WithStartupProbe())
+	assert.NoError(t, err)
+}
+
+func TestClientOptions_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		option ClientOption
+	}{
+		{"base URL with trailing slash", WithBaseURL("https://acme.protect.jamfcloud.com/")},
+		{"base URL without scheme", WithBaseURL("acme.protect.jamfcloud.com")},
+		{"zero timeout", WithTimeout(0)},
+		{"timeout too large", WithTimeout(2 * time.Hour)},
+		{"negative retry count", WithRetryCount(-1)},
+		{"retry count too large", WithRetryCount(11)},
+		{"unsupported proxy scheme", WithProxy("ftp://proxy.example.com")},
+		{"invalid tenant name", WithTenant("Acme Corp", RegionUS)},
+		{"unknown region", WithTenant("acme", "apac")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTransport("id", "secret", tt.option)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTenantBaseURL(t *testing.T) {
+	us, err := TenantBaseURL("acme", "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.protect.jamfcloud.com", us)
+
+	eu, err := TenantBaseURL("acme", RegionEU)
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.eu.protect.jamfcloud.com", eu)
+
+	transport, err := NewTransport("id", "secret", WithTenant("acme", RegionEU))
+	require.NoError(t, err)
+	assert.Equal(t, eu, transport.baseURL)
+	assert.Equal(t, eu+EndpointToken, transport.authConfig.TokenURL)
+}
@@ -0,0 +1,343 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+	"go.uber.org/zap"
+)
+
+// Query cache defaults
+const (
+	// DefaultQueryCacheTTL is how long cached query results are served
+	DefaultQueryCacheTTL = 30 * time.Second
+
+	// DefaultQueryCacheMaxEntries bounds the default in-memory LRU backend
+	DefaultQueryCacheMaxEntries = 1000
+
+	// HeaderCache is set on responses served from the query cache
+	HeaderCache = "X-Jamfprotect-Cache"
+)
+
+// CacheBackend stores cached query results. Keys are prefixed with a hash of the base URL
+// and client ID, then the service name, each followed by a colon, so one backend can be
+// shared across tenants and DeletePrefix can drop every entry belonging to a tenant or to
+// one of its services. Implementations must be safe for concurrent use.
+type CacheBackend interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+	DeletePrefix(prefix string)
+	Clear()
+}
+
+// QueryCacheConfig configures the read-through query cache
+type QueryCacheConfig struct {
+	// TTL is how long a result is served from the cache. Defaults to DefaultQueryCacheTTL.
+	TTL time.Duration
+
+	// Backend stores the cached results. Defaults to an in-memory LRU holding
+	// DefaultQueryCacheMaxEntries entries. A backend may be shared by transports for
+	// different tenants or clients; their entries never mix.
+	Backend CacheBackend
+
+	// InvalidateOnAnyMutation clears the tenant's whole cache on every mutation instead of
+	// only the mutated service's entries. Use it when reads span services, e.g. plans embedding
+	// analytic sets.
+	InvalidateOnAnyMutation bool
+}
+
+// CacheStats reports query cache effectiveness
+type CacheStats struct {
+	Hits          int64
+	Misses        int64
+	Invalidations int64
+}
+
+// HitRatio returns hits divided by lookups, or 0 when there were no lookups
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+type cacheBypassKey struct{}
+
+// WithCacheBypass returns a context whose queries skip the query cache and refresh it
+func WithCacheBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+// queryCache is a Middleware that serves repeated queries from a CacheBackend
+type queryCache struct {
+	scope         string
+	ttl           time.Duration
+	backend       CacheBackend
+	invalidateAll bool
+	logger        *zap.Logger
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	invalidations atomic.Int64
+}
+
+// cachedResult is the stored form of a query result
+type cachedResult struct {
+	Data       json.RawMessage `json:"data"`
+	StatusCode int             `json:"status_code"`
+	Status     string          `json:"status"`
+}
+
+func newQueryCache(config QueryCacheConfig, baseURL, clientID string, logger *zap.Logger) (*queryCache, error) {
+	if config.TTL < 0 {
+		return nil, fmt.Errorf("query cache TTL cannot be negative")
+	}
+	if config.TTL == 0 {
+		config.TTL = DefaultQueryCacheTTL
+	}
+	if config.Backend == nil {
+		config.Backend = NewLRUCache(DefaultQueryCacheMaxEntries)
+	}
+	return &queryCache{
+		scope:         queryCacheScope(baseURL, clientID),
+		ttl:           config.TTL,
+		backend:       config.Backend,
+		invalidateAll: config.InvalidateOnAnyMutation,
+		logger:        logger,
+	}, nil
+}
+
+// WrapGraphQL implements Middleware
+func (c *queryCache) WrapGraphQL(next GraphQLHandler) GraphQLHandler {
+	return func(ctx context.Context, op *GraphQLOperation) (*interfaces.Response, error) {
+		if op.IsMutation() {
+			resp, err := next(ctx, op)
+			c.invalidate(op)
+			return resp, err
+		}
+		if op.Type != OperationTypeQuery || op.Target == nil {
+			return next(ctx, op)
+		}
+
+		key, err := c.key(op)
+		if err != nil {
+			return next(ctx, op)
+		}
+
+		if bypass, _ := ctx.Value(cacheBypassKey{}).(bool); !bypass {
+			if resp, ok := c.lookup(key, op); ok {
+				return resp, nil
+			}
+		}
+		c.misses.Add(1)
+
+		resp, err := next(ctx, op)
+		if err == nil {
+			c.store(key, op, resp)
+		}
+		return resp, err
+	}
+}
+
+// lookup decodes a cached result into the operation target
+func (c *queryCache) lookup(key string, op *GraphQLOperation) (*interfaces.Response, bool) {
+	raw, ok := c.backend.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	var cached cachedResult
+	if err := json.Unmarshal(raw, &cached); err != nil {
+		return nil, false
+	}
+	if err := json.Unmarshal(cached.Data, op.Target); err != nil {
+		return nil, false
+	}
+
+	c.hits.Add(1)
+	c.logger.Debug("Query served from cache",
+		zap.String("operation", op.Name),
+		zap.String("service", op.Service))
+
+	return &interfaces.Response{
+		StatusCode: cached.StatusCode,
+		Status:     cached.Status,
+		Headers:    http.Header{HeaderCache: []string{"HIT"}},
+		ReceivedAt: time.Now(),
+	}, true
+}
+
+// store caches the decoded target of a successful query
+func (c *queryCache) store(key string, op *GraphQLOperation, resp *interfaces.Response) {
+	data, err := json.Marshal(op.Target)
+	if err != nil {
+		return
+	}
+	cached := cachedResult{Data: data}
+	if resp != nil {
+		cached.StatusCode = resp.StatusCode
+		cached.Status = resp.Status
+	}
+	raw, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+	c.backend.Set(key, raw, c.ttl)
+}
+
+// invalidate drops the entries a mutation may have made stale
+func (c *queryCache) invalidate(op *GraphQLOperation) {
+	c.invalidations.Add(1)
+	if c.invalidateAll || op.Service == "" {
+		c.clear()
+		return
+	}
+	c.backend.DeletePrefix(c.scope + op.Service + ":")
+	c.logger.Debug("Query cache invalidated",
+		zap.String("mutation", op.Name),
+		zap.String("service", op.Service))
+}
+
+// clear drops every entry cached for this tenant and client
+func (c *queryCache) clear() {
+	c.backend.DeletePrefix(c.scope)
+}
+
+// stats returns a snapshot of the cache counters
+func (c *queryCache) stats() CacheStats {
+	return CacheStats{
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Invalidations: c.invalidations.Load(),
+	}
+}
+
+// queryCacheScope returns the key prefix for a base URL and client ID pair, so that
+// transports sharing a backend never serve each other's results
+func queryCacheScope(baseURL, clientID string) string {
+	sum := sha256.Sum256([]byte(baseURL + "|" + clientID))
+	return hex.EncodeToString(sum[:8]) + ":"
+}
+
+// key derives the cache key from the scope, service, operation and variables.
+// encoding/json sorts map keys, so equal variables always produce the same key.
+func (c *queryCache) key(op *GraphQLOperation) (string, error) {
+	vars, err := json.Marshal(op.Variables)
+	if err != nil {
+		return "", err
+	}
+	name := op.Name
+	if name == "" {
+		name = op.Query
+	}
+	return c.scope + op.Service + ":" + name + ":" + string(vars), nil
+}
+
+// LRUCache is an in-memory CacheBackend that evicts the least recently used entry once
+// it holds maxEntries entries. Expired entries are dropped on access.
+type LRUCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRUCache creates an in-memory LRU cache backend. maxEntries <= 0 uses DefaultQueryCacheMaxEntries.
+func NewLRUCache(maxEntries int) *LRUCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultQueryCacheMaxEntries
+	}
+	return &LRUCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value for key if present and not expired
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*lruEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.removeElement(el)
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return entry.value, true
+}
+
+// Set stores value under key for ttl
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.ll.Len() > c.maxEntries {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// DeletePrefix removes every entry whose key starts with prefix
+func (c *LRUCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, el := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.removeElement(el)
+		}
+	}
+}
+
+// Clear removes every entry
+func (c *LRUCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+}
+
+// Len returns the number of entries, including expired entries not yet evicted
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *LRUCache) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*lruEntry).key)
+}
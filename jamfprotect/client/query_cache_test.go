@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	getPlanTestQuery    = "query getPlan($id: ID!) { ping }"
+	updatePlanTestQuery = "mutation updatePlan($id: ID!) { ping }"
+)
+
+func cachedPing(t *testing.T, transport *Transport, ctx context.Context, query string, vars map[string]any) string {
+	t.Helper()
+	var out struct {
+		Ping string `json:"ping"`
+	}
+	_, err := transport.GraphQLPost(ctx, EndpointApp, query, vars, &out, nil)
+	require.NoError(t, err)
+	return out.Ping
+}
+
+func TestQueryCache_HitsAndMisses(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithQueryCache(QueryCacheConfig{}))
+	require.NoError(t, err)
+	ctx := WithOperation(context.Background(), "plan", "GetPlan")
+
+	assert.Equal(t, "pong", cachedPing(t, transport, ctx, getPlanTestQuery, map[string]any{"id": "1"}))
+	assert.Equal(t, "pong", cachedPing(t, transport, ctx, getPlanTestQuery, map[string]any{"id": "1"}))
+	cachedPing(t, transport, ctx, getPlanTestQuery, map[string]any{"id": "2"})
+
+	assert.Equal(t, int32(2), server.appCalls.Load())
+	stats := transport.QueryCacheStats()
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 2}, stats)
+	assert.InDelta(t, 1.0/3.0, stats.HitRatio(), 0.001)
+}
+
+func TestQueryCache_MutationInvalidatesService(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithQueryCache(QueryCacheConfig{}))
+	require.NoError(t, err)
+	planCtx := WithOperation(context.Background(), "plan", "GetPlan")
+	setCtx := WithOperation(context.Background(), "analytic_set", "GetAnalyticSet")
+
+	cachedPing(t, transport, planCtx, getPlanTestQuery, map[string]any{"id": "1"})
+	cachedPing(t, transport, setCtx, "query getAnalyticSet($uuid: ID!) { ping }", map[string]any{"uuid": "a"})
+
+	cachedPing(t, transport, WithOperation(context.Background(), "plan", "UpdatePlan"), updatePlanTestQuery, map[string]any{"id": "1"})
+
+	cachedPing(t, transport, planCtx, getPlanTestQuery, map[string]any{"id": "1"})
+	cachedPing(t, transport, setCtx, "query getAnalyticSet($uuid: ID!) { ping }", map[string]any{"uuid": "a"})
+
+	// getPlan refetched after the plan mutation; getAnalyticSet still served from cache
+	assert.Equal(t, int32(4), server.appCalls.Load())
+	assert.Equal(t, int64(1), transport.QueryCacheStats().Invalidations)
+}
+
+func TestQueryCache_TTLAndBypass(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL),
+		WithQueryCache(QueryCacheConfig{TTL: 50 * time.Millisecond}))
+	require.NoError(t, err)
+	ctx := WithOperation(context.Background(), "plan", "GetPlan")
+
+	cachedPing(t, transport, ctx, getPlanTestQuery, map[string]any{"id": "1"})
+	cachedPing(t, transport, WithCacheBypass(ctx), getPlanTestQuery, map[string]any{"id": "1"})
+	assert.Equal(t, int32(2), server.appCalls.Load())
+
+	time.Sleep(60 * time.Millisecond)
+	cachedPing(t, transport, ctx, getPlanTestQuery, map[string]any{"id": "1"})
+	assert.Equal(t, int32(3), server.appCalls.Load())
+}
+
+func TestQueryCache_ErrorsAreNotCached(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL),
+		WithQueryCache(QueryCacheConfig{}), WithAuthRetryDisabled())
+	require.NoError(t, err)
+	server.revoke("token-1")
+
+	_, err = ping(transport)
+	require.Error(t, err)
+	_, err = ping(transport)
+	require.Error(t, err)
+
+	assert.Equal(t, int32(2), server.appCalls.Load())
+	assert.Zero(t, transport.QueryCacheStats().Hits)
+}
+
+func TestLRUCache_Eviction(t *testing.T) {
+	cache := NewLRUCache(2)
+	cache.Set("plan:a", []byte("a"), time.Minute)
+	cache.Set("plan:b", []byte("b"), time.Minute)
+	cache.Get("plan:a")
+	cache.Set("plan:c", []byte("c"), time.Minute)
+
+	_, okA := cache.Get("plan:a")
+	_, okB := cache.Get("plan:b")
+	assert.True(t, okA)
+	assert.False(t, okB, "least recently used entry is evicted")
+
+	for i := range 2 {
+		cache.Set(fmt.Sprintf("set:%d", i), nil, time.Minute)
+	}
+	cache.DeletePrefix("set:")
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestQueryCache_SharedBackendIsScopedPerTenant(t *testing.T) {
+	backend := NewLRUCache(0)
+	tenantA := newRevokingServer(t)
+	tenantB := newRevokingServer(t)
+	transportA, err := NewTransport("id", "secret", WithBaseURL(tenantA.URL), WithQueryCache(QueryCacheConfig{Backend: backend}))
+	require.NoError(t, err)
+	transportB, err := NewTransport("id", "secret", WithBaseURL(tenantB.URL), WithQueryCache(QueryCacheConfig{Backend: backend}))
+	require.NoError(t, err)
+	otherClient, err := NewTransport("other-id", "secret", WithBaseURL(tenantA.URL), WithQueryCache(QueryCacheConfig{Backend: backend}))
+	require.NoError(t, err)
+	ctx := WithOperation(context.Background(), "plan", "GetPlan")
+
+	cachedPing(t, transportA, ctx, getPlanTestQuery, map[string]any{"id": "1"})
+	cachedPing(t, transportB, ctx, getPlanTestQuery, map[string]any{"id": "1"})
+	cachedPing(t, otherClient, ctx, getPlanTestQuery, map[string]any{"id": "1"})
+	assert.Equal(t, int32(2), tenantA.appCalls.Load(), "another client ID on the same tenant is not served A's entry")
+	assert.Equal(t, int32(1), tenantB.appCalls.Load(), "tenant B is not served tenant A's entry")
+
+	// A mutation or a clear on tenant A leaves tenant B's entries cached
+	cachedPing(t, transportA, WithOperation(context.Background(), "plan", "UpdatePlan"), updatePlanTestQuery, map[string]any{"id": "1"})
+	transportA.ClearQueryCache()
+	cachedPing(t, transportB, ctx, getPlanTestQuery, map[string]any{"id": "1"})
+	assert.Equal(t, int32(1), tenantB.appCalls.Load())
+	assert.Equal(t, int64(1), transportB.QueryCacheStats().Hits)
+	assert.Equal(t, 2, backend.Len(), "the other client's and tenant B's entries remain")
+}
@@ -0,0 +1,324 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RecordMode selects how a RecordingTransport treats the network
+type RecordMode int
+
+const (
+	// RecordModeReplay serves responses from the cassette and never touches the network.
+	// Requests without a recorded interaction fail with ErrCassetteMiss.
+	RecordModeReplay RecordMode = iota
+
+	// RecordModeRecord sends every request to the API and writes the exchanges to a new cassette
+	RecordModeRecord
+
+	// RecordModeAuto replays when the cassette file exists and records otherwise
+	RecordModeAuto
+)
+
+// String returns the mode name
+func (m RecordMode) String() string {
+	switch m {
+	case RecordModeReplay:
+		return "replay"
+	case RecordModeRecord:
+		return "record"
+	case RecordModeAuto:
+		return "auto"
+	default:
+		return fmt.Sprintf("RecordMode(%d)", int(m))
+	}
+}
+
+// CassetteVersion is the current cassette file format version
+const CassetteVersion = 1
+
+// replayAccessToken is served for token requests in replay mode
+const replayAccessToken = "replay-access-token"
+
+// ErrCassetteMiss is returned in replay mode when no recorded interaction matches a request
+var ErrCassetteMiss = errors.New("no recorded interaction matches request")
+
+// Cassette is a recorded sequence of GraphQL exchanges
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded GraphQL request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest identifies a GraphQL request by path, operation name and redacted variables
+type RecordedRequest struct {
+	Path      string          `json:"path"`
+	Operation string          `json:"operation"`
+	Variables json.RawMessage `json:"variables,omitempty"`
+}
+
+// RecordedResponse is a recorded HTTP response with a redacted JSON body
+type RecordedResponse struct {
+	StatusCode  int             `json:"status_code"`
+	ContentType string          `json:"content_type,omitempty"`
+	Body        json.RawMessage `json:"body"`
+}
+
+// RecordingTransport is an http.RoundTripper that records GraphQL exchanges to a cassette
+// file or replays them. Requests are matched on path, operation name and normalized
+// variables; repeated identical requests are served in recorded order. Access tokens,
+// client credentials and values of sensitive keys (password, secret, token, authorization,
+// apikey) are never written to the cassette.
+type RecordingTransport struct {
+	base     http.RoundTripper
+	path     string
+	mode     RecordMode
+	redactor *redactor
+
+	mu       sync.Mutex
+	cassette Cassette
+	served   map[int]bool
+}
+
+// NewRecordingTransport creates a record/replay transport for the cassette at path.
+// base is used for real requests in record mode (http.DefaultTransport when nil).
+// redactKeys lists additional key names whose values are redacted.
+func NewRecordingTransport(base http.RoundTripper, path string, mode RecordMode, redactKeys ...string) (*RecordingTransport, error) {
+	if path == "" {
+		return nil, fmt.Errorf("cassette path cannot be empty")
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if mode == RecordModeAuto {
+		mode = RecordModeRecord
+		if _, err := os.Stat(path); err == nil {
+			mode = RecordModeReplay
+		}
+	}
+
+	rt := &RecordingTransport{
+		base:     base,
+		path:     path,
+		mode:     mode,
+		redactor: newRedactor(redactKeys),
+		cassette: Cassette{Version: CassetteVersion},
+		served:   make(map[int]bool),
+	}
+
+	if mode == RecordModeReplay {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		rt.cassette = *cassette
+	}
+
+	return rt, nil
+}
+
+// LoadCassette reads a cassette file
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("decoding cassette %s: %w", path, err)
+	}
+	if cassette.Version != CassetteVersion {
+		return nil, fmt.Errorf("unsupported cassette version %d in %s", cassette.Version, path)
+	}
+	return &cassette, nil
+}
+
+// Mode returns the effective mode (RecordModeAuto is resolved at construction)
+func (rt *RecordingTransport) Mode() RecordMode {
+	return rt.mode
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.URL.Path, EndpointToken) {
+		if rt.mode == RecordModeReplay {
+			return replayTokenResponse(req), nil
+		}
+		// Token exchanges carry credentials and are never recorded
+		return rt.base.RoundTrip(req)
+	}
+
+	recorded, err := rt.recordedRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rt.mode == RecordModeReplay {
+		return rt.replay(req, recorded)
+	}
+	return rt.record(req, recorded)
+}
+
+// recordedRequest reads the GraphQL payload and builds the match key
+func (rt *RecordingTransport) recordedRequest(req *http.Request) (RecordedRequest, error) {
+	recorded := RecordedRequest{Path: req.URL.Path}
+	if req.Body == nil {
+		return recorded, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return recorded, fmt.Errorf("reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	var payload GraphQLRequest
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return recorded, nil
+	}
+	_, recorded.Operation = ParseOperation(payload.Query)
+	if len(payload.Variables) > 0 {
+		// encoding/json sorts map keys, which normalizes the variables for matching
+		vars, err := json.Marshal(rt.redactor.redactMap(payload.Variables))
+		if err != nil {
+			return recorded, fmt.Errorf("encoding variables: %w", err)
+		}
+		recorded.Variables = vars
+	}
+	return recorded, nil
+}
+
+// replay serves the first unserved interaction matching the request
+func (rt *RecordingTransport) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	for i, interaction := range rt.cassette.Interactions {
+		if rt.served[i] || !sameRequest(interaction.Request, recorded) {
+			continue
+		}
+		rt.served[i] = true
+		return newReplayResponse(req, interaction.Response), nil
+	}
+
+	return nil, fmt.Errorf("%w: operation %q variables %s in %s",
+		ErrCassetteMiss, recorded.Operation, recorded.Variables, rt.path)
+}
+
+// record forwards the request and appends the redacted exchange to the cassette
+func (rt *RecordingTransport) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	resp, err := rt.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	recordedBody := rt.redactor.redactJSON(body)
+	if !json.Valid(recordedBody) {
+		recordedBody, _ = json.Marshal(string(body))
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.cassette.Interactions = append(rt.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get(HeaderContentType),
+			Body:        recordedBody,
+		},
+	})
+	if err := rt.save(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// save atomically writes the cassette file. Callers must hold mu.
+func (rt *RecordingTransport) save() error {
+	data, err := json.MarshalIndent(rt.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(rt.path), 0o755); err != nil {
+		return fmt.Errorf("creating cassette directory: %w", err)
+	}
+	tmp := rt.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	return os.Rename(tmp, rt.path)
+}
+
+// sameRequest reports whether two recorded requests match. Variables are compared in
+// compact form because cassettes are written indented.
+func sameRequest(a, b RecordedRequest) bool {
+	return a.Path == b.Path && a.Operation == b.Operation &&
+		bytes.Equal(compactJSON(a.Variables), compactJSON(b.Variables))
+}
+
+// compactJSON strips insignificant whitespace from a JSON document
+func compactJSON(data json.RawMessage) []byte {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return data
+	}
+	return buf.Bytes()
+}
+
+// newReplayResponse builds an HTTP response from a recorded response
+func newReplayResponse(req *http.Request, recorded RecordedResponse) *http.Response {
+	header := make(http.Header)
+	if recorded.ContentType != "" {
+		header.Set(HeaderContentType, recorded.ContentType)
+	}
+	return &http.Response{
+		StatusCode:    recorded.StatusCode,
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
+
+// replayTokenResponse serves a synthetic access token in replay mode
+func replayTokenResponse(req *http.Request) *http.Response {
+	body, _ := json.Marshal(TokenResponse{
+		AccessToken: replayAccessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(time.Hour / time.Second),
+	})
+	return newReplayResponse(req, RecordedResponse{
+		StatusCode:  http.StatusOK,
+		ContentType: ContentTypeJSON,
+		Body:        body,
+	})
+}
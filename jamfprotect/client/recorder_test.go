@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const listItemsTestQuery = "query listItems($nextToken: String) { listItems(nextToken: $nextToken) { items pageInfo { next } } }"
+
+type listItemsResult struct {
+	ListItems struct {
+		Items    []string `json:"items"`
+		PageInfo struct {
+			Next *string `json:"next"`
+		} `json:"pageInfo"`
+	} `json:"listItems"`
+}
+
+// newPagingServer serves two pages of items and echoes a secret in the response
+func newPagingServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == EndpointToken {
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: "live-token", ExpiresIn: 3600})
+			return
+		}
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Variables["nextToken"] == nil {
+			w.Write([]byte(`{"data":{"listItems":{"items":["a","b"],"pageInfo":{"next":"page-2-cursor"}},"clientSecret":"s3cr3t"}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"listItems":{"items":["c"],"pageInfo":{"next":null}}}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func listAllItems(t *testing.T, transport *Transport) []string {
+	t.Helper()
+	var items []string
+	var next *string
+	for {
+		vars := map[string]any{}
+		if next != nil {
+			vars["nextToken"] = *next
+		}
+		var out listItemsResult
+		_, err := transport.GraphQLPost(context.Background(), EndpointApp, listItemsTestQuery, vars, &out, nil)
+		require.NoError(t, err)
+		items = append(items, out.ListItems.Items...)
+		if out.ListItems.PageInfo.Next == nil {
+			return items
+		}
+		next = out.ListItems.PageInfo.Next
+	}
+}
+
+func TestRecording_RecordThenReplay(t *testing.T) {
+	server := newPagingServer(t)
+	cassette := filepath.Join(t.TempDir(), "cassettes", "list_items.json")
+
+	recorder, err := NewTransport("id", "client-secret", WithBaseURL(server.URL), WithRecording(RecordModeRecord, cassette))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, listAllItems(t, recorder))
+
+	data, err := os.ReadFile(cassette)
+	require.NoError(t, err)
+	for _, secret := range []string{"live-token", "client-secret", "s3cr3t"} {
+		assert.False(t, strings.Contains(string(data), secret), "cassette leaks %q", secret)
+	}
+	assert.Contains(t, string(data), `"nextToken": "page-2-cursor"`, "pagination cursors are kept")
+	info, err := os.Stat(cassette)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// Replay without a server: the URL is unreachable once closed
+	server.Close()
+	replayer, err := NewTransport("id", "other-secret", WithBaseURL(server.URL), WithRecording(RecordModeReplay, cassette))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, listAllItems(t, replayer))
+}
+
+func TestRecording_ReplayMiss(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "empty.json")
+	require.NoError(t, os.WriteFile(cassette, []byte(`{"version":1,"interactions":[]}`), 0o644))
+
+	transport, err := NewTransport("id", "secret", WithRecording(RecordModeReplay, cassette))
+	require.NoError(t, err)
+
+	_, err = ping(transport)
+	assert.ErrorIs(t, err, ErrCassetteMiss)
+}
+
+func TestRecording_AutoMode(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "auto.json")
+
+	rt, err := NewRecordingTransport(nil, cassette, RecordModeAuto)
+	require.NoError(t, err)
+	assert.Equal(t, RecordModeRecord, rt.Mode())
+
+	require.NoError(t, os.WriteFile(cassette, []byte(`{"version":1,"interactions":[]}`), 0o644))
+	rt, err = NewRecordingTransport(nil, cassette, RecordModeAuto)
+	require.NoError(t, err)
+	assert.Equal(t, RecordModeReplay, rt.Mode())
+}
+
+func TestRecording_MatchesNormalizedVariables(t *testing.T) {
+	server := newRevokingServer(t)
+	cassette := filepath.Join(t.TempDir(), "vars.json")
+
+	recorder, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithRecording(RecordModeRecord, cassette))
+	require.NoError(t, err)
+	_, err = recorder.GraphQLPost(context.Background(), EndpointApp, "query ping { ping }",
+		map[string]any{"b": 2, "a": map[string]any{"y": 1, "x": 2}}, nil, nil)
+	require.NoError(t, err)
+
+	replayer, err := NewTransport("id", "secret", WithRecording(RecordModeReplay, cassette))
+	require.NoError(t, err)
+	_, err = replayer.GraphQLPost(context.Background(), EndpointApp, "query ping {\n  ping\n}",
+		map[string]any{"a": map[string]any{"x": 2, "y": 1}, "b": 2}, nil, nil)
+	assert.NoError(t, err)
+}
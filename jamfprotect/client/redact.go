@@ -0,0 +1,225 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces sensitive values in spans, cassettes and logs
+const RedactedValue = "[REDACTED]"
+
+// Patterns matched by redactText in free-form text such as log messages and HTTP dumps
+var (
+	// jsonStringPairPattern matches a "key": "value" pair in JSON text
+	jsonStringPairPattern = regexp.MustCompile(`"([^"\\]+)"(\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+
+	// headerValuePattern matches the value of a {"header": "...", "value": "..."} object,
+	// such as an action config ReportClientHeader
+	headerValuePattern = regexp.MustCompile(`("header"\s*:\s*"(?:[^"\\]|\\.)*"\s*,\s*"value"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// valueHeaderPattern matches the same object with the value first
+	valueHeaderPattern = regexp.MustCompile(`("value"\s*:\s*)"(?:[^"\\]|\\.)*"(\s*,\s*"header"\s*:)`)
+
+	// headerLinePattern matches a "Name: value" header line in an HTTP dump
+	headerLinePattern = regexp.MustCompile(`(?m)^(\s*)([A-Za-z0-9-]+)(\s*:[ \t]*)(\S.*?)\s*$`)
+
+	// bearerPattern matches a bearer token anywhere in text
+	bearerPattern = regexp.MustCompile(`(?i)\b(bearer)\s+[A-Za-z0-9\-._~+/]+=*`)
+
+	// urlCredentialsPattern matches the password in the userinfo of a URL
+	urlCredentialsPattern = regexp.MustCompile(`([A-Za-z][A-Za-z0-9+.-]*://[^/\s:@"]+):[^/\s@"]+@`)
+
+	// queryParamPattern matches a query parameter
+	queryParamPattern = regexp.MustCompile(`([?&])([^=&\s"#]+)=([^&\s"#]*)`)
+)
+
+// defaultRedactedKeys are key name fragments (lower case) whose values are always redacted
+var defaultRedactedKeys = []string{"password", "secret", "token", "authorization", "apikey"}
+
+// exemptKeys are key names (lower case) that match a redacted fragment but never hold a
+// secret, such as the pagination cursor of list queries and the token endpoint and type
+// logged when a token is fetched
+var exemptKeys = []string{"nexttoken", "token_url", "token_type"}
+
+// RedactSecrets returns a copy of a decoded JSON value with the values the default policy
+// treats as secrets, such as passwords, tokens and HTTP header values, replaced by
+// RedactedValue
+func RedactSecrets(v any) any {
+	return newRedactor(nil).redactValue(v)
+}
+
+// redactor is the central redaction policy for spans, cassettes and logs. It replaces:
+//   - values of keys whose lower-cased name contains one of the configured fragments
+//     (tokens, Authorization, client secrets, passwords and API keys by default);
+//   - the value of {"header", "value"} objects, the ReportClientHeader entries of
+//     action config HTTP clients;
+//   - passwords in the userinfo of URLs and Kafka/syslog hosts, and sensitive URL query
+//     parameters, as found in action config report client endpoints;
+//   - bearer tokens and sensitive header lines in free-form text such as HTTP dumps.
+type redactor struct {
+	mu   sync.RWMutex
+	keys []string
+}
+
+// newRedactor creates a redactor for the default keys plus extra
+func newRedactor(extra []string) *redactor {
+	r := &redactor{keys: append([]string{}, defaultRedactedKeys...)}
+	r.addKeys(extra...)
+	return r
+}
+
+// addKeys adds key name fragments to the policy
+func (r *redactor) addKeys(keys ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, k := range keys {
+		if k != "" {
+			r.keys = append(r.keys, strings.ToLower(k))
+		}
+	}
+}
+
+// isRedacted reports whether a key name matches a redaction rule
+func (r *redactor) isRedacted(name string) bool {
+	name = strings.ToLower(name)
+	if slices.Contains(exemptKeys, name) {
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, k := range r.keys {
+		if strings.Contains(name, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactMap returns a copy of m with sensitive values replaced, at any nesting depth
+func (r *redactor) redactMap(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	_, isHeader := m["header"]
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		if r.isRedacted(k) || (isHeader && k == "value") {
+			out[k] = RedactedValue
+			continue
+		}
+		out[k] = r.redactValue(v)
+	}
+	return out
+}
+
+// redactValue redacts nested maps and slices
+func (r *redactor) redactValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		return r.redactMap(val)
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = r.redactValue(item)
+		}
+		return out
+	case string:
+		return r.redactURL(val)
+	default:
+		return v
+	}
+}
+
+// redactURL removes the password from URL and host:port userinfo and redacts sensitive
+// query parameters. Other strings are returned unchanged.
+func (r *redactor) redactURL(s string) string {
+	at := strings.IndexByte(s, '@')
+	query := strings.IndexByte(s, '?')
+	if at == -1 && query == -1 {
+		return s
+	}
+
+	if u, err := url.Parse(s); err == nil && u.Scheme != "" && u.Host != "" {
+		if _, hasPassword := u.User.Password(); hasPassword {
+			u.User = url.UserPassword(u.User.Username(), RedactedValue)
+		}
+		if u.RawQuery != "" {
+			q := u.Query()
+			for k := range q {
+				if r.isRedacted(k) {
+					q.Set(k, RedactedValue)
+				}
+			}
+			u.RawQuery = q.Encode()
+		}
+		out := u.String()
+		return strings.ReplaceAll(out, url.QueryEscape(RedactedValue), RedactedValue)
+	}
+
+	// Bare user:password@host, as in Kafka and syslog hosts
+	if at > 0 && !strings.ContainsAny(s[:at], " /") {
+		if user, _, ok := strings.Cut(s[:at], ":"); ok {
+			return user + ":" + RedactedValue + s[at:]
+		}
+	}
+	return s
+}
+
+// redactJSON redacts a JSON document. Bodies that are not JSON objects or arrays are
+// returned unchanged.
+func (r *redactor) redactJSON(body []byte) []byte {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return body
+	}
+	data, err := json.Marshal(r.redactValue(doc))
+	if err != nil {
+		return body
+	}
+	return data
+}
+
+// redactText redacts sensitive values in free-form text: JSON key-value pairs, header
+// lines, bearer tokens, URL credentials and query parameters
+func (r *redactor) redactText(s string) string {
+	if s == "" {
+		return s
+	}
+
+	s = headerValuePattern.ReplaceAllString(s, `${1}"`+RedactedValue+`"`)
+	s = valueHeaderPattern.ReplaceAllString(s, `${1}"`+RedactedValue+`"${2}`)
+	s = jsonStringPairPattern.ReplaceAllStringFunc(s, func(pair string) string {
+		m := jsonStringPairPattern.FindStringSubmatch(pair)
+		if r.isRedacted(m[1]) {
+			return `"` + m[1] + `"` + m[2] + `"` + RedactedValue + `"`
+		}
+		if redacted := r.redactURL(m[3]); redacted != m[3] {
+			return `"` + m[1] + `"` + m[2] + `"` + redacted + `"`
+		}
+		return pair
+	})
+	s = headerLinePattern.ReplaceAllStringFunc(s, func(line string) string {
+		m := headerLinePattern.FindStringSubmatch(line)
+		if r.isRedacted(m[2]) {
+			return m[1] + m[2] + m[3] + RedactedValue
+		}
+		return line
+	})
+	s = bearerPattern.ReplaceAllString(s, "${1} "+RedactedValue)
+	s = urlCredentialsPattern.ReplaceAllString(s, "${1}:"+RedactedValue+"@")
+	s = queryParamPattern.ReplaceAllStringFunc(s, func(param string) string {
+		m := queryParamPattern.FindStringSubmatch(param)
+		if r.isRedacted(m[2]) && m[3] != RedactedValue {
+			return m[1] + m[2] + "=" + RedactedValue
+		}
+		return param
+	})
+	return s
+}
@@ -11,22 +11,65 @@ import (
 )
 
 // Post executes a POST request with JSON body. Auth is applied automatically via middleware.
+// If the API rejects the access token (HTTP 401 or a GraphQL UnauthorizedException), the token
+// is invalidated, a fresh token is fetched and the request is replayed once.
 func (t *Transport) Post(ctx context.Context, path string, body any, headers map[string]string, result any) (*interfaces.Response, error) {
 	if !strings.HasPrefix(path, "/") {
 		path = "/" + path
 	}
 
-	req := t.client.R().
-		SetContext(ctx).
-		SetResult(result)
+	newRequest := func() *resty.Request {
+		req := t.client.R().
+			SetContext(ctx).
+			SetResult(result)
 
-	if body != nil {
-		req.SetBody(body)
+		if body != nil {
+			req.SetBody(body)
+		}
+
+		t.applyHeaders(req, headers)
+		return req
+	}
+
+	req := newRequest()
+	clientResp, err := t.executeRequest(req, "POST", path)
+	if t.authRetryDisabled || !isUnauthorizedResult(clientResp, err, result) {
+		return clientResp, err
+	}
+
+	if t.tokenManager.InvalidateTokenIfCurrent(req.AuthToken) {
+		t.logger.Warn("Access token rejected by API, refreshing token and replaying request",
+			zap.String("method", "POST"),
+			zap.String("path", path))
+	}
+
+	// Clear the decoded GraphQL payload so stale errors do not survive the replay
+	if gqlResp, ok := result.(*GraphQLResponse); ok {
+		*gqlResp = GraphQLResponse{}
 	}
 
-	t.applyHeaders(req, headers)
+	return t.executeRequest(newRequest(), "POST", path)
+}
 
-	return t.executeRequest(req, "POST", path)
+// isUnauthorizedResult reports whether a response indicates the access token was rejected:
+// an HTTP 401, or a GraphQL UnauthorizedException error in the decoded result.
+func isUnauthorizedResult(resp *interfaces.Response, err error, result any) bool {
+	if resp != nil && resp.StatusCode == StatusUnauthorized {
+		return true
+	}
+	if err != nil {
+		return false
+	}
+	gqlResp, ok := result.(*GraphQLResponse)
+	if !ok {
+		return false
+	}
+	for _, e := range gqlResp.Errors {
+		if e.ErrorType == GraphQLErrorTypeUnauthorized {
+			return true
+		}
+	}
+	return false
 }
 
 // executeRequest is a centralized request executor that handles error processing.
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// revokingServer issues sequential tokens and rejects any token listed as revoked
+type revokingServer struct {
+	*httptest.Server
+	tokenCalls atomic.Int32
+	appCalls   atomic.Int32
+	revoked    sync.Map
+	graphQL401 bool
+}
+
+func newRevokingServer(t *testing.T) *revokingServer {
+	t.Helper()
+	s := &revokingServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case EndpointToken:
+			n := s.tokenCalls.Add(1)
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: fmt.Sprintf("token-%d", n), ExpiresIn: 3600})
+		case EndpointApp:
+			s.appCalls.Add(1)
+			if _, revoked := s.revoked.Load(r.Header.Get(HeaderAuthorization)); revoked {
+				if !s.graphQL401 {
+					w.WriteHeader(http.StatusUnauthorized)
+				}
+				w.Write([]byte(`{"errors":[{"errorType":"UnauthorizedException","message":"You are not authorized to make this call."}]}`))
+				return
+			}
+			w.Write([]byte(`{"data":{"ping":"pong"}}`))
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *revokingServer) revoke(token string) {
+	s.revoked.Store("Bearer "+token, true)
+}
+
+func ping(t *Transport) (string, error) {
+	var out struct {
+		Ping string `json:"ping"`
+	}
+	_, err := t.GraphQLPost(context.Background(), EndpointApp, "query ping { ping }", nil, &out, nil)
+	return out.Ping, err
+}
+
+func TestPost_ReplaysOnceAfterRevokedToken(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	_, err = ping(transport)
+	require.NoError(t, err)
+	server.revoke("token-1")
+
+	result, err := ping(transport)
+
+	require.NoError(t, err)
+	assert.Equal(t, "pong", result)
+	assert.Equal(t, int32(2), server.tokenCalls.Load())
+	assert.Equal(t, int32(3), server.appCalls.Load())
+}
+
+func TestPost_ReplaysOnGraphQLUnauthorizedException(t *testing.T) {
+	server := newRevokingServer(t)
+	server.graphQL401 = true
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+	server.revoke("token-1")
+
+	result, err := ping(transport)
+
+	require.NoError(t, err)
+	assert.Equal(t, "pong", result)
+	assert.Equal(t, int32(2), server.tokenCalls.Load())
+}
+
+func TestPost_ReplaysOnlyOnce(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+	server.revoke("token-1")
+	server.revoke("token-2")
+
+	_, err = ping(transport)
+
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, int32(2), server.appCalls.Load())
+}
+
+func TestPost_NoRefreshStormOnConcurrent401(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+	_, err = ping(transport)
+	require.NoError(t, err)
+	server.revoke("token-1")
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := ping(transport)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), server.tokenCalls.Load(), "only one refresh expected for a burst of 401s")
+}
+
+func TestPost_AuthRetryDisabled(t *testing.T) {
+	server := newRevokingServer(t)
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithAuthRetryDisabled())
+	require.NoError(t, err)
+	server.revoke("token-1")
+
+	_, err = ping(transport)
+
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, int32(1), server.tokenCalls.Load())
+}
+
+func TestPost_RevokedTokenRemovedFromFileCache(t *testing.T) {
+	server := newRevokingServer(t)
+	path := filepath.Join(t.TempDir(), "token.json")
+	transport, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithTokenCacheFile(path))
+	require.NoError(t, err)
+	server.revoke("token-1")
+
+	_, err = ping(transport)
+	require.NoError(t, err)
+
+	// A second process sharing the cache must not pick up the revoked token
+	other, err := NewTransport("id", "secret", WithBaseURL(server.URL), WithTokenCacheFile(path))
+	require.NoError(t, err)
+	token, err := other.AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+}
+
+func TestTokenManager_InvalidateTokenIfCurrent(t *testing.T) {
+	manager := NewTokenManagerWithSource(&countingTokenSource{token: "t"}, nil)
+	_, err := manager.GetToken(context.Background())
+	require.NoError(t, err)
+
+	assert.False(t, manager.InvalidateTokenIfCurrent("other"))
+	assert.True(t, manager.InvalidateTokenIfCurrent("t"))
+	assert.False(t, manager.InvalidateTokenIfCurrent("t"), "second invalidation of the same token is a no-op")
+}
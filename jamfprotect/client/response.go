@@ -72,8 +72,8 @@ func (t *Transport) validateResponse(resp *resty.Response, method, path string)
 				zap.String("path", path),
 				zap.String("content_type", contentType),
 				zap.String("expected", "application/json"))
-			return fmt.Errorf("unexpected response Content-Type from %s %s: got %q, expected application/json",
-				method, path, contentType)
+			return fmt.Errorf("%w: unexpected response Content-Type from %s %s: got %q, expected application/json",
+				ErrInvalidResponse, method, path, contentType)
 		}
 	}
 	return nil
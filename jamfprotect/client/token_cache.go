@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Token cache file settings
+const (
+	// tokenCacheFileMode restricts cached tokens to the owning user
+	tokenCacheFileMode = 0o600
+
+	// tokenCacheDirMode restricts the cache directory to the owning user
+	tokenCacheDirMode = 0o700
+
+	// tokenCacheLockPollInterval is how often a blocked process retries the cache lock
+	tokenCacheLockPollInterval = 50 * time.Millisecond
+
+	// tokenCacheInvalidateTimeout bounds how long invalidation waits for the cache lock
+	tokenCacheInvalidateTimeout = 5 * time.Second
+)
+
+// FileTokenCache is a TokenSource that persists tokens to a file so that multiple
+// processes (CLI invocations, pods sharing a volume) reuse one token instead of each
+// calling /token. Access is serialised across processes with an advisory file lock,
+// and the cache file is written atomically with 0600 permissions.
+type FileTokenCache struct {
+	path   string
+	source TokenSource
+	skew   time.Duration
+	logger *zap.Logger
+}
+
+// NewFileTokenCache creates a file-backed token cache in front of source.
+// Cached tokens within TokenExpirySkew of expiry are treated as stale.
+func NewFileTokenCache(path string, source TokenSource, logger *zap.Logger) *FileTokenCache {
+	return &FileTokenCache{
+		path:   path,
+		source: source,
+		skew:   time.Duration(TokenExpirySkew) * time.Second,
+		logger: logger,
+	}
+}
+
+// DefaultTokenCachePath returns a per-credential cache file path under the user cache directory.
+// The file name is derived from a hash of the base URL and client ID so that different
+// tenants and clients never share a cached token.
+func DefaultTokenCachePath(baseURL, clientID string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("resolving user cache directory: %w", err)
+	}
+	return filepath.Join(dir, "jamfprotect", TokenCacheFileName(baseURL, clientID)), nil
+}
+
+// TokenCacheFileName returns the cache file name used for a base URL and client ID pair
+func TokenCacheFileName(baseURL, clientID string) string {
+	sum := sha256.Sum256([]byte(baseURL + "|" + clientID))
+	return "token-" + hex.EncodeToString(sum[:8]) + ".json"
+}
+
+// Path returns the cache file path
+func (c *FileTokenCache) Path() string {
+	return c.path
+}
+
+// Token returns the cached token if it is still valid, otherwise obtains a new token
+// from the underlying source and writes it to the cache file.
+func (c *FileTokenCache) Token(ctx context.Context) (*TokenResponse, error) {
+	if tok := c.read(); tok != nil {
+		return tok, nil
+	}
+	return c.fetch(ctx, "")
+}
+
+// RenewToken obtains a new token from the underlying source even though the cached token,
+// stale, is still valid, and writes it to the cache file. A different valid token cached
+// by another process in the meantime is returned instead.
+func (c *FileTokenCache) RenewToken(ctx context.Context, stale string) (*TokenResponse, error) {
+	return c.fetch(ctx, stale)
+}
+
+// fetch obtains a token from the underlying source under the cache lock, unless the cache
+// holds a valid token other than stale
+func (c *FileTokenCache) fetch(ctx context.Context, stale string) (*TokenResponse, error) {
+	if err := os.MkdirAll(filepath.Dir(c.path), tokenCacheDirMode); err != nil {
+		return nil, fmt.Errorf("creating token cache directory: %w", err)
+	}
+
+	unlock, err := lockFile(ctx, c.path+".lock")
+	if err != nil {
+		return nil, fmt.Errorf("locking token cache: %w", err)
+	}
+	defer unlock()
+
+	// Another process may have refreshed the token while we waited for the lock
+	if tok := c.read(); tok != nil && tok.AccessToken != stale {
+		return tok, nil
+	}
+
+	tok, err := c.source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tok.ExpiresAt = tokenExpiresAt(tok)
+
+	if err := c.write(tok); err != nil && c.logger != nil {
+		c.logger.Warn("Failed to write token cache",
+			zap.String("path", c.path),
+			zap.Error(err))
+	}
+
+	return tok, nil
+}
+
+// InvalidateToken removes the cache file if it still holds the given token, so that
+// a token revoked by the API is not handed out again to this or other processes.
+func (c *FileTokenCache) InvalidateToken(token string) {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenCacheInvalidateTimeout)
+	defer cancel()
+
+	unlock, err := lockFile(ctx, c.path+".lock")
+	if err != nil {
+		if c.logger != nil {
+			c.logger.Warn("Failed to lock token cache for invalidation", zap.Error(err))
+		}
+		return
+	}
+	defer unlock()
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return
+	}
+	var tok TokenResponse
+	if err := json.Unmarshal(data, &tok); err != nil || tok.AccessToken != token {
+		return
+	}
+	if err := c.Clear(); err != nil && c.logger != nil {
+		c.logger.Warn("Failed to clear token cache", zap.Error(err))
+	}
+}
+
+// Clear removes the cached token so the next call fetches a new one
+func (c *FileTokenCache) Clear() error {
+	if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing token cache: %w", err)
+	}
+	return nil
+}
+
+// read returns the cached token if present, trusted and not within the expiry skew
+func (c *FileTokenCache) read() *TokenResponse {
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return nil
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&^tokenCacheFileMode != 0 {
+		if c.logger != nil {
+			c.logger.Warn("Ignoring token cache with insecure permissions",
+				zap.String("path", c.path),
+				zap.String("mode", info.Mode().Perm().String()))
+		}
+		return nil
+	}
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return nil
+	}
+
+	var tok TokenResponse
+	if err := json.Unmarshal(data, &tok); err != nil || tok.AccessToken == "" || tok.ExpiresAt.IsZero() {
+		return nil
+	}
+	if !time.Now().Add(c.skew).Before(tok.ExpiresAt) {
+		return nil
+	}
+
+	if c.logger != nil {
+		c.logger.Debug("Using cached access token",
+			zap.String("path", c.path),
+			zap.Time("expires_at", tok.ExpiresAt))
+	}
+	return &tok
+}
+
+// write atomically replaces the cache file with the given token
+func (c *FileTokenCache) write(tok *TokenResponse) error {
+	data, err := json.Marshal(tok)
+	if err != nil {
+		return fmt.Errorf("marshalling token: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("creating temporary token file: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName)
+
+	if err := tmp.Chmod(tokenCacheFileMode); err != nil {
+		tmp.Close()
+		return fmt.Errorf("setting token file permissions: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing token file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing token file: %w", err)
+	}
+
+	return os.Rename(tmpName, c.path)
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingTokenSource returns a fixed token and records how many times it was called
+type countingTokenSource struct {
+	calls atomic.Int32
+	token string
+}
+
+func (s *countingTokenSource) Token(ctx context.Context) (*TokenResponse, error) {
+	s.calls.Add(1)
+	return &TokenResponse{AccessToken: s.token, ExpiresIn: 3600, TokenType: "Bearer"}, nil
+}
+
+func newTokenServer(t *testing.T, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TokenResponse{AccessToken: "server-token", ExpiresIn: 3600, TokenType: "Bearer"})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFileTokenCache_SharedAcrossInstances(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	source := &countingTokenSource{token: "shared-token"}
+	logger, _ := newTestLogger()
+
+	// Two caches on the same path simulate two processes
+	first := NewFileTokenCache(path, source, logger)
+	second := NewFileTokenCache(path, source, logger)
+
+	tok1, err := first.Token(context.Background())
+	require.NoError(t, err)
+	tok2, err := second.Token(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "shared-token", tok1.AccessToken)
+	assert.Equal(t, tok1.AccessToken, tok2.AccessToken)
+	assert.Equal(t, int32(1), source.calls.Load(), "expected the second instance to reuse the cached token")
+}
+
+func TestFileTokenCache_ConcurrentRefreshFetchesOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	source := &countingTokenSource{token: "concurrent-token"}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cache := NewFileTokenCache(path, source, nil)
+			_, err := cache.Token(context.Background())
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), source.calls.Load())
+}
+
+func TestFileTokenCache_FilePermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permission bits are not enforced on windows")
+	}
+	path := filepath.Join(t.TempDir(), "token.json")
+	cache := NewFileTokenCache(path, &countingTokenSource{token: "t"}, nil)
+
+	_, err := cache.Token(context.Background())
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestFileTokenCache_IgnoresInsecureFile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permission bits are not enforced on windows")
+	}
+	path := filepath.Join(t.TempDir(), "token.json")
+	data, _ := json.Marshal(TokenResponse{AccessToken: "planted", ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	require.NoError(t, os.Chmod(path, 0o644))
+
+	source := &countingTokenSource{token: "fresh"}
+	tok, err := NewFileTokenCache(path, source, nil).Token(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "fresh", tok.AccessToken)
+	assert.Equal(t, int32(1), source.calls.Load())
+}
+
+func TestFileTokenCache_RefreshesExpiredToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	cache := NewFileTokenCache(path, &countingTokenSource{token: "new"}, nil)
+	require.NoError(t, cache.write(&TokenResponse{AccessToken: "old", ExpiresAt: time.Now().Add(time.Second)}))
+
+	tok, err := cache.Token(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "new", tok.AccessToken, "tokens within the expiry skew must not be reused")
+}
+
+func TestDefaultTokenCachePath_DistinctPerCredential(t *testing.T) {
+	a, err := DefaultTokenCachePath("https://a.example.com", "client")
+	require.NoError(t, err)
+	b, err := DefaultTokenCachePath("https://b.example.com", "client")
+	require.NoError(t, err)
+	assert.NotEqual(t, a, b)
+}
+
+func TestNewTransport_LazyTokenFetch(t *testing.T) {
+	var calls atomic.Int32
+	server := newTokenServer(t, &calls)
+
+	transport, err := NewTransport("test-client", "test-secret", WithBaseURL(server.URL))
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), calls.Load(), "NewTransport must not call /token")
+
+	token, err := transport.AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "server-token", token)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestNewTransport_EagerTokenFetch(t *testing.T) {
+	var calls atomic.Int32
+	server := newTokenServer(t, &calls)
+
+	_, err := NewTransport("test-client", "test-secret",
+		WithBaseURL(server.URL),
+		WithEagerTokenFetch(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestNewTransport_CustomTokenSourceWithCache(t *testing.T) {
+	source := &countingTokenSource{token: "custom-token"}
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	for range 2 {
+		transport, err := NewTransport("test-client", "test-secret",
+			WithBaseURL("https://example.invalid"),
+			WithTokenSource(source),
+			WithTokenCacheFile(path),
+		)
+		require.NoError(t, err)
+
+		token, err := transport.AccessToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "custom-token", token)
+	}
+
+	assert.Equal(t, int32(1), source.calls.Load(), "second transport should read the cached token")
+}
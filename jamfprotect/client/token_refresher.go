@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Background refresh defaults
+const (
+	// DefaultTokenRefreshAt is the fraction of a token's lifetime after which it is renewed
+	DefaultTokenRefreshAt = 0.8
+
+	// DefaultTokenRefreshMinBackoff is the initial wait after a failed background refresh
+	DefaultTokenRefreshMinBackoff = 1 * time.Second
+
+	// DefaultTokenRefreshMaxBackoff caps the wait between failed background refreshes
+	DefaultTokenRefreshMaxBackoff = 1 * time.Minute
+)
+
+// TokenRefreshEvent describes a token refresh attempt passed to TokenHooks
+type TokenRefreshEvent struct {
+	// Background is true when the refresh was made by the background refresher
+	// rather than in the request path
+	Background bool
+
+	// Attempt is the number of consecutive failed background attempts before this one
+	Attempt int
+
+	// Duration is how long the token source took to respond
+	Duration time.Duration
+
+	// ExpiresAt is the expiry of the new token (zero on failure)
+	ExpiresAt time.Time
+}
+
+// TokenHooks are callbacks invoked on token lifecycle events, e.g. for metrics and alerting.
+// Hooks are called synchronously and must not block.
+type TokenHooks struct {
+	OnTokenRefreshed     func(event TokenRefreshEvent)
+	OnTokenRefreshFailed func(event TokenRefreshEvent, err error)
+}
+
+// BackgroundRefreshConfig configures proactive token renewal
+type BackgroundRefreshConfig struct {
+	// RefreshAt is the fraction of the token lifetime (0 < RefreshAt < 1) after which
+	// the token is renewed. Defaults to DefaultTokenRefreshAt.
+	RefreshAt float64
+
+	// MinBackoff is the initial retry delay after a failed refresh.
+	// Defaults to DefaultTokenRefreshMinBackoff.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the exponential retry delay. Defaults to DefaultTokenRefreshMaxBackoff.
+	MaxBackoff time.Duration
+}
+
+// withDefaults returns a copy of the config with zero values replaced by defaults
+func (c BackgroundRefreshConfig) withDefaults() BackgroundRefreshConfig {
+	if c.RefreshAt == 0 {
+		c.RefreshAt = DefaultTokenRefreshAt
+	}
+	if c.MinBackoff == 0 {
+		c.MinBackoff = DefaultTokenRefreshMinBackoff
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = DefaultTokenRefreshMaxBackoff
+	}
+	return c
+}
+
+// Validate checks the background refresh configuration
+func (c BackgroundRefreshConfig) Validate() error {
+	c = c.withDefaults()
+	if c.RefreshAt <= 0 || c.RefreshAt >= 1 {
+		return fmt.Errorf("refresh point must be between 0 and 1 exclusive, got %v", c.RefreshAt)
+	}
+	if c.MinBackoff < 0 || c.MaxBackoff < c.MinBackoff {
+		return fmt.Errorf("invalid refresh backoff: min %s, max %s", c.MinBackoff, c.MaxBackoff)
+	}
+	return nil
+}
+
+// backgroundRefresher renews the token ahead of expiry on its own goroutine
+type backgroundRefresher struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// SetHooks registers token lifecycle callbacks. It must be called before the token
+// manager is shared between goroutines.
+func (tm *TokenManager) SetHooks(hooks TokenHooks) {
+	tm.hooks = hooks
+}
+
+// setTelemetry records token fetches in the client metrics
+func (tm *TokenManager) setTelemetry(tel *telemetry) {
+	tm.refreshMu.Lock()
+	defer tm.refreshMu.Unlock()
+	tm.telemetry = tel
+}
+
+// StartBackgroundRefresh starts a goroutine that renews the access token once
+// config.RefreshAt of its lifetime has elapsed, so requests never pay the token latency.
+// If no token has been obtained yet, the first token is fetched immediately in the background.
+// Failed refreshes are retried with exponential backoff. Calling it again replaces the
+// running refresher.
+func (tm *TokenManager) StartBackgroundRefresh(config BackgroundRefreshConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	config = config.withDefaults()
+
+	tm.StopBackgroundRefresh()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &backgroundRefresher{cancel: cancel, done: make(chan struct{})}
+
+	tm.mu.Lock()
+	tm.refresher = r
+	tm.mu.Unlock()
+
+	go tm.runBackgroundRefresh(ctx, r, config)
+
+	if tm.logger != nil {
+		tm.logger.Info("Background token refresh started",
+			zap.Float64("refresh_at", config.RefreshAt))
+	}
+	return nil
+}
+
+// StopBackgroundRefresh stops the background refresher, if running, and waits for it to exit
+func (tm *TokenManager) StopBackgroundRefresh() {
+	tm.mu.Lock()
+	r := tm.refresher
+	tm.refresher = nil
+	tm.mu.Unlock()
+
+	if r == nil {
+		return
+	}
+	r.once.Do(r.cancel)
+	<-r.done
+
+	if tm.logger != nil {
+		tm.logger.Info("Background token refresh stopped")
+	}
+}
+
+// runBackgroundRefresh is the refresher loop
+func (tm *TokenManager) runBackgroundRefresh(ctx context.Context, r *backgroundRefresher, config BackgroundRefreshConfig) {
+	defer close(r.done)
+
+	failures := 0
+	backoff := config.MinBackoff
+	wait := tm.nextRefreshIn(config.RefreshAt)
+
+	for {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		tm.refreshMu.Lock()
+		_, err := tm.fetchLocked(ctx, true, failures)
+		tm.refreshMu.Unlock()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			failures++
+			wait = backoff
+			backoff = min(backoff*2, config.MaxBackoff)
+			if tm.logger != nil {
+				tm.logger.Warn("Background token refresh failed",
+					zap.Int("attempt", failures),
+					zap.Duration("retry_in", wait),
+					zap.Error(err))
+			}
+			continue
+		}
+
+		failures = 0
+		backoff = config.MinBackoff
+		wait = tm.nextRefreshIn(config.RefreshAt)
+	}
+}
+
+// nextRefreshIn returns how long to wait before renewing the current token
+func (tm *TokenManager) nextRefreshIn(refreshAt float64) time.Duration {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	if tm.currentToken == nil {
+		return 0
+	}
+	lifetime := tm.tokenExpiry.Sub(tm.tokenIssued)
+	due := tm.tokenIssued.Add(time.Duration(float64(lifetime) * refreshAt))
+	return max(time.Until(due), 0)
+}
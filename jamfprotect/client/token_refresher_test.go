@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenManager_BackgroundRefresh_RenewsBeforeExpiry(t *testing.T) {
+	var calls atomic.Int32
+	source := TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+		calls.Add(1)
+		return &TokenResponse{AccessToken: "bg-token", ExpiresAt: time.Now().Add(200 * time.Millisecond)}, nil
+	})
+
+	var refreshed atomic.Int32
+	manager := NewTokenManagerWithSource(source, nil)
+	manager.SetHooks(TokenHooks{
+		OnTokenRefreshed: func(event TokenRefreshEvent) {
+			assert.True(t, event.Background)
+			assert.False(t, event.ExpiresAt.IsZero())
+			refreshed.Add(1)
+		},
+	})
+
+	require.NoError(t, manager.StartBackgroundRefresh(BackgroundRefreshConfig{RefreshAt: 0.5}))
+	t.Cleanup(manager.StopBackgroundRefresh)
+
+	assert.Eventually(t, func() bool { return calls.Load() >= 3 }, 2*time.Second, 10*time.Millisecond,
+		"expected the first fetch plus at least two proactive renewals")
+	assert.GreaterOrEqual(t, refreshed.Load(), int32(3))
+}
+
+func TestTokenManager_BackgroundRefresh_BacksOffOnFailure(t *testing.T) {
+	var calls atomic.Int32
+	source := TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+		if calls.Add(1) <= 2 {
+			return nil, errors.New("token endpoint unavailable")
+		}
+		return &TokenResponse{AccessToken: "recovered", ExpiresIn: 3600}, nil
+	})
+
+	var failures []int
+	manager := NewTokenManagerWithSource(source, nil)
+	manager.SetHooks(TokenHooks{
+		OnTokenRefreshFailed: func(event TokenRefreshEvent, err error) {
+			failures = append(failures, event.Attempt)
+		},
+	})
+
+	require.NoError(t, manager.StartBackgroundRefresh(BackgroundRefreshConfig{
+		MinBackoff: 10 * time.Millisecond,
+		MaxBackoff: 20 * time.Millisecond,
+	}))
+	t.Cleanup(manager.StopBackgroundRefresh)
+
+	assert.Eventually(t, func() bool { return calls.Load() >= 3 }, 2*time.Second, 5*time.Millisecond)
+	manager.StopBackgroundRefresh()
+
+	assert.Equal(t, []int{0, 1}, failures)
+	token, err := manager.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "recovered", token)
+}
+
+func TestTokenManager_BackgroundRefresh_DoesNotBlockReaders(t *testing.T) {
+	var calls atomic.Int32
+	source := TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+		if calls.Add(1) > 1 {
+			time.Sleep(300 * time.Millisecond)
+		}
+		return &TokenResponse{AccessToken: "token", ExpiresIn: 3600}, nil
+	})
+
+	manager := NewTokenManagerWithSource(source, nil)
+	_, err := manager.GetToken(context.Background())
+	require.NoError(t, err)
+
+	// Force an immediate, slow background renewal
+	manager.tokenIssued = time.Now().Add(-10 * time.Hour)
+	require.NoError(t, manager.StartBackgroundRefresh(BackgroundRefreshConfig{}))
+	t.Cleanup(manager.StopBackgroundRefresh)
+	assert.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, time.Millisecond)
+
+	started := time.Now()
+	_, err = manager.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Less(t, time.Since(started), 100*time.Millisecond, "a valid token must be served while renewal is in flight")
+}
+
+func TestTokenManager_StopBackgroundRefresh(t *testing.T) {
+	var calls atomic.Int32
+	source := TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+		calls.Add(1)
+		return &TokenResponse{AccessToken: "t", ExpiresAt: time.Now().Add(50 * time.Millisecond)}, nil
+	})
+
+	manager := NewTokenManagerWithSource(source, nil)
+	require.NoError(t, manager.StartBackgroundRefresh(BackgroundRefreshConfig{RefreshAt: 0.5}))
+	assert.Eventually(t, func() bool { return calls.Load() >= 1 }, time.Second, time.Millisecond)
+
+	manager.StopBackgroundRefresh()
+	stopped := calls.Load()
+	time.Sleep(150 * time.Millisecond)
+
+	assert.Equal(t, stopped, calls.Load())
+	manager.StopBackgroundRefresh() // idempotent
+}
+
+func TestBackgroundRefreshConfig_Validate(t *testing.T) {
+	assert.NoError(t, BackgroundRefreshConfig{}.Validate())
+	assert.NoError(t, BackgroundRefreshConfig{RefreshAt: 0.8}.Validate())
+	assert.Error(t, BackgroundRefreshConfig{RefreshAt: 1.2}.Validate())
+	assert.Error(t, BackgroundRefreshConfig{RefreshAt: -0.1}.Validate())
+	assert.Error(t, BackgroundRefreshConfig{MinBackoff: time.Minute, MaxBackoff: time.Second}.Validate())
+}
+
+func TestTransport_CloseStopsBackgroundRefresh(t *testing.T) {
+	var calls atomic.Int32
+	source := TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+		calls.Add(1)
+		return &TokenResponse{AccessToken: "t", ExpiresAt: time.Now().Add(40 * time.Millisecond)}, nil
+	})
+
+	transport, err := NewTransport("test-client", "test-secret",
+		WithBaseURL("https://example.invalid"),
+		WithTokenSource(source),
+		WithBackgroundTokenRefresh(BackgroundRefreshConfig{RefreshAt: 0.5}),
+	)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return calls.Load() >= 2 }, time.Second, time.Millisecond)
+
+	require.NoError(t, transport.Close())
+	stopped := calls.Load()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, stopped, calls.Load())
+}
+
+func TestNewTransport_FailedSetupLeavesNoBackgroundRefresh(t *testing.T) {
+	var calls atomic.Int32
+	source := TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+		calls.Add(1)
+		return &TokenResponse{AccessToken: "t", ExpiresAt: time.Now().Add(40 * time.Millisecond)}, nil
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	_, err := NewTransport("test-client", "test-secret",
+		WithBaseURL(server.URL),
+		WithTokenSource(source),
+		WithBackgroundTokenRefresh(BackgroundRefreshConfig{RefreshAt: 0.5}),
+		WithStartupProbe(),
+	)
+	require.Error(t, err)
+
+	failed := calls.Load()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, failed, calls.Load(), "no refresher renews the token after setup failed")
+}
+
+func TestNewTransport_BackgroundRefreshRenewsCachedToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	source := &countingTokenSource{token: "renewed"}
+
+	// A token another process cached 50 minutes into its hour: still valid for the cache,
+	// but past the refresh point of its own lifetime
+	cached := &TokenResponse{AccessToken: "cached", ExpiresIn: 3600, ExpiresAt: time.Now().Add(10 * time.Minute)}
+	require.NoError(t, NewFileTokenCache(path, source, nil).write(cached))
+
+	transport, err := NewTransport("test-client", "test-secret",
+		WithBaseURL("https://example.invalid"),
+		WithTokenSource(source),
+		WithTokenCacheFile(path),
+		WithBackgroundTokenRefresh(BackgroundRefreshConfig{}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { transport.Close() })
+
+	assert.Eventually(t, func() bool { return source.calls.Load() == 1 }, time.Second, time.Millisecond,
+		"the refresher bypasses the cache once the token is due")
+	token, err := transport.AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "renewed", token)
+
+	stored, err := NewFileTokenCache(path, source, nil).Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "renewed", stored.AccessToken, "the renewed token is written to the cache")
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), source.calls.Load(), "the next renewal is scheduled from the new token's issue time")
+}
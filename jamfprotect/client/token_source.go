@@ -0,0 +1,215 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// TokenSource supplies OAuth2 access tokens to the TokenManager.
+// Implementations must return a token with ExpiresAt set, or with a positive ExpiresIn
+// from which the TokenManager derives the expiry.
+type TokenSource interface {
+	Token(ctx context.Context) (*TokenResponse, error)
+}
+
+// TokenInvalidator is implemented by token sources that cache tokens and must discard
+// a token the API has rejected before its expiry (e.g. after revocation).
+type TokenInvalidator interface {
+	InvalidateToken(token string)
+}
+
+// TokenRenewer is implemented by token sources that cache tokens. RenewToken bypasses the
+// cache to replace stale, the token the caller holds, unless the cache already holds a
+// different valid token, e.g. one another process obtained in the meantime.
+type TokenRenewer interface {
+	RenewToken(ctx context.Context, stale string) (*TokenResponse, error)
+}
+
+// TokenSourceFunc adapts an ordinary function to the TokenSource interface
+type TokenSourceFunc func(ctx context.Context) (*TokenResponse, error)
+
+// Token calls f(ctx)
+func (f TokenSourceFunc) Token(ctx context.Context) (*TokenResponse, error) {
+	return f(ctx)
+}
+
+// ClientCredentialsTokenSource fetches tokens from the Jamf Protect /token endpoint
+// using the configured client ID and password.
+type ClientCredentialsTokenSource struct {
+	authConfig  *AuthConfig
+	credentials CredentialProvider
+	httpClient  *http.Client
+	logger      *zap.Logger
+}
+
+// NewClientCredentialsTokenSource creates a token source that exchanges client credentials for tokens
+func NewClientCredentialsTokenSource(authConfig *AuthConfig, httpClient *http.Client, logger *zap.Logger) *ClientCredentialsTokenSource {
+	return &ClientCredentialsTokenSource{
+		authConfig: authConfig,
+		httpClient: httpClient,
+		logger:     logger,
+	}
+}
+
+// NewCredentialProviderTokenSource creates a token source that asks provider for the client
+// credentials on every token request. authConfig supplies the token URL.
+func NewCredentialProviderTokenSource(authConfig *AuthConfig, provider CredentialProvider, httpClient *http.Client, logger *zap.Logger) *ClientCredentialsTokenSource {
+	return &ClientCredentialsTokenSource{
+		authConfig:  authConfig,
+		credentials: provider,
+		httpClient:  httpClient,
+		logger:      logger,
+	}
+}
+
+// Token performs the HTTP request to the /token endpoint and returns a new access token.
+// When the credentials come from a caching provider and the endpoint rejects them, the
+// cache is invalidated and the request is retried once with fresh credentials.
+func (s *ClientCredentialsTokenSource) Token(ctx context.Context) (*TokenResponse, error) {
+	creds, err := s.resolveCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenResp, err := s.requestToken(ctx, creds)
+	if err == nil || !isCredentialRejection(err) {
+		return tokenResp, err
+	}
+
+	inv, ok := s.credentials.(CredentialInvalidator)
+	if !ok {
+		return nil, err
+	}
+	if s.logger != nil {
+		s.logger.Info("Token endpoint rejected credentials; re-reading from credential provider",
+			zap.String("client_id", creds.ClientID))
+	}
+	inv.InvalidateCredentials()
+
+	creds, err = s.resolveCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.requestToken(ctx, creds)
+}
+
+// resolveCredentials asks the credential provider, or falls back to the static auth config
+func (s *ClientCredentialsTokenSource) resolveCredentials(ctx context.Context) (*Credentials, error) {
+	if s.credentials == nil {
+		return &Credentials{ClientID: s.authConfig.ClientID, ClientSecret: s.authConfig.ClientSecret}, nil
+	}
+
+	creds, err := s.credentials.Credentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: obtaining client credentials: %w", ErrAuthentication, err)
+	}
+	if err := creds.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAuthentication, err)
+	}
+	return creds, nil
+}
+
+// requestToken exchanges creds for an access token
+func (s *ClientCredentialsTokenSource) requestToken(ctx context.Context, creds *Credentials) (*TokenResponse, error) {
+	body, err := json.Marshal(TokenRequest{
+		ClientID: creds.ClientID,
+		Password: creds.ClientSecret,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling token request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		s.authConfig.TokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", DefaultUserAgent)
+
+	if s.logger != nil {
+		s.logger.Debug("OAuth2 token request",
+			zap.String("method", http.MethodPost),
+			zap.String("url", req.URL.String()),
+			zap.ByteString("body", redactTokenRequestBody(creds.ClientID)))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading token response: %w", err)
+	}
+
+	if s.logger != nil {
+		s.logger.Debug("OAuth2 token response",
+			zap.Int("status_code", resp.StatusCode),
+			zap.ByteString("body", redactTokenResponseBody(respBody)))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &TokenRequestError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	var tokenResp TokenResponse
+	if err := json.Unmarshal(respBody, &tokenResp); err != nil {
+		return nil, fmt.Errorf("decoding token response: %w", err)
+	}
+
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("%w: token response missing access_token", ErrAuthentication)
+	}
+
+	if tokenResp.ExpiresIn <= 0 {
+		return nil, fmt.Errorf("%w: token response missing expires_in", ErrAuthentication)
+	}
+
+	tokenResp.ExpiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+
+	return &tokenResp, nil
+}
+
+// TokenRequestError is returned when the /token endpoint responds with a non-200 status
+type TokenRequestError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error implements the error interface
+func (e *TokenRequestError) Error() string {
+	return fmt.Sprintf("token request returned %d: %s", e.StatusCode, e.Body)
+}
+
+// setLogger updates the logger used for token request debug output
+func (s *ClientCredentialsTokenSource) setLogger(logger *zap.Logger) {
+	s.logger = logger
+}
+
+// tokenExpiresAt returns the absolute expiry of a token, deriving it from ExpiresIn when unset
+func tokenExpiresAt(tok *TokenResponse) time.Time {
+	if !tok.ExpiresAt.IsZero() {
+		return tok.ExpiresAt
+	}
+	return time.Now().Add(time.Duration(tok.ExpiresIn) * time.Second)
+}
+
+// tokenIssuedAt returns when a token was issued, derived from its expiry and lifetime so
+// that a token read back from a cache keeps its original issue time. Tokens without a
+// lifetime are taken to have been issued now.
+func tokenIssuedAt(tok *TokenResponse, expiry time.Time) time.Time {
+	if tok.ExpiresIn <= 0 {
+		return time.Now()
+	}
+	return expiry.Add(-time.Duration(tok.ExpiresIn) * time.Second)
+}
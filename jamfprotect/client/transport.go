@@ -21,9 +21,46 @@ type Transport struct {
 	baseURL       string
 	userAgent     string
 	logger        *zap.Logger
+	logRedactor   *redactor
 	authConfig    *AuthConfig
+	credentials   CredentialProvider
 	tokenManager  *TokenManager
 	globalHeaders map[string]string
+
+	// Token acquisition settings applied by NewTransport after options are evaluated
+	tokenSource     TokenSource
+	tokenCachePath  string
+	tokenCacheOn    bool
+	eagerTokenFetch bool
+	tokenHooks      TokenHooks
+	tokenRefresh    *BackgroundRefreshConfig
+
+	// authRetryDisabled turns off token invalidation and replay on 401 responses
+	authRetryDisabled bool
+
+	// startupProbe verifies tenant, credentials and connectivity in NewTransport
+	startupProbe bool
+
+	// otelConfig enables OpenTelemetry instrumentation in NewTransport when set
+	otelConfig *OTelConfig
+
+	// telemetry emits per-operation spans and metrics once tracing is enabled
+	telemetry *telemetry
+
+	// middleware wraps every GraphQLPost call, outermost first
+	middleware []Middleware
+
+	// queryCacheConfig enables the read-through query cache when set
+	queryCacheConfig *QueryCacheConfig
+
+	// queryCache serves repeated queries; it runs inside the user middleware
+	queryCache *queryCache
+
+	// dryRun plans mutations instead of sending them, unless overridden per context
+	dryRun bool
+
+	// changeSet records the mutations planned in dry-run mode
+	changeSet *ChangeSet
 }
 
 // NewTransport creates a new Jamf Protect GraphQL transport.
@@ -33,11 +70,23 @@ func NewTransport(clientID, clientSecret string, options ...ClientOption) (*Tran
 		return nil, fmt.Errorf("invalid transport configuration: %w", err)
 	}
 
-	logger, err := zap.NewProduction()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create logger: %w", err)
+	return newTransport(StaticCredentials(clientID, clientSecret), options...)
+}
+
+// NewTransportWithCredentials creates a new Jamf Protect GraphQL transport whose client
+// credentials come from provider. The provider is asked once here, to validate the
+// credentials, and again before every token request, so rotated secrets are picked up
+// without rebuilding the transport.
+func NewTransportWithCredentials(provider CredentialProvider, options ...ClientOption) (*Transport, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("invalid transport configuration: credential provider cannot be nil")
 	}
 
+	return newTransport(provider, options...)
+}
+
+// newTransport creates the transport and sets up authentication with provider
+func newTransport(provider CredentialProvider, options ...ClientOption) (*Transport, error) {
 	userAgent := fmt.Sprintf("%s/%s", UserAgentBase, Version)
 
 	restyClient := resty.New()
@@ -51,11 +100,14 @@ func NewTransport(clientID, clientSecret string, options ...ClientOption) (*Tran
 
 	transport := &Transport{
 		client:        restyClient,
-		logger:        logger,
+		logRedactor:   newRedactor(nil),
 		baseURL:       DefaultBaseURL,
 		globalHeaders: make(map[string]string),
 		userAgent:     userAgent,
+		changeSet:     NewChangeSet(),
 	}
+	transport.logger = transport.redactLogger(DefaultLogger())
+	restyClient.SetLogger(&restyLogger{transport: transport})
 
 	// Apply options before auth setup so that WithBaseURL is respected in the token URL
 	for _, opt := range options {
@@ -64,29 +116,108 @@ func NewTransport(clientID, clientSecret string, options ...ClientOption) (*Tran
 		}
 	}
 
+	credsCtx, cancel := context.WithTimeout(context.Background(), restyClient.Timeout())
+	creds, err := provider.Credentials(credsCtx)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup authentication: obtaining client credentials: %w", err)
+	}
+	if err := creds.validate(); err != nil {
+		return nil, fmt.Errorf("invalid transport configuration: %w", err)
+	}
+
 	authConfig := &AuthConfig{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
+		ClientID:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
 		TokenURL:     strings.TrimRight(transport.baseURL, "/") + EndpointToken,
 	}
 	transport.authConfig = authConfig
+	transport.credentials = provider
+
+	if err := authConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to setup authentication: %w", err)
+	}
 
-	// Setup OAuth2 authentication
-	tokenManager, err := SetupAuthentication(restyClient, authConfig, transport.logger)
+	source, err := transport.buildTokenSource(restyClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup authentication: %w", err)
 	}
-	transport.tokenManager = tokenManager
+
+	// Setup OAuth2 authentication. The first token is fetched lazily on the first request.
+	transport.tokenManager = NewTokenManagerWithSource(source, transport.logger)
+	transport.tokenManager.SetHooks(transport.tokenHooks)
+	SetupTokenAuthentication(restyClient, transport.tokenManager, transport.logger)
+
+	if transport.eagerTokenFetch {
+		if _, err := transport.tokenManager.GetToken(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to obtain initial access token: %w", err)
+		}
+	}
 
 	restyClient.SetBaseURL(transport.baseURL)
 
+	if transport.queryCacheConfig != nil {
+		cache, err := newQueryCache(*transport.queryCacheConfig, transport.baseURL, authConfig.ClientID, transport.logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure query cache: %w", err)
+		}
+		transport.queryCache = cache
+	}
+
+	if transport.otelConfig != nil {
+		if err := transport.EnableTracing(transport.otelConfig); err != nil {
+			return nil, fmt.Errorf("failed to enable tracing: %w", err)
+		}
+	}
+
+	if transport.startupProbe {
+		ctx, cancel := context.WithTimeout(context.Background(), restyClient.Timeout())
+		defer cancel()
+		if err := transport.Probe(ctx); err != nil {
+			return nil, fmt.Errorf("startup probe failed: %w", err)
+		}
+	}
+
+	// Started last so that no earlier setup failure leaves the refresher goroutine running
+	if transport.tokenRefresh != nil {
+		if err := transport.tokenManager.StartBackgroundRefresh(*transport.tokenRefresh); err != nil {
+			return nil, fmt.Errorf("failed to start background token refresh: %w", err)
+		}
+	}
+
 	transport.logger.Info("Jamf Protect API client created",
 		zap.String("base_url", transport.baseURL),
-		zap.String("client_id", clientID))
+		zap.String("client_id", authConfig.ClientID))
 
 	return transport, nil
 }
 
+// buildTokenSource resolves the token source from client options: a custom source or the
+// client credentials source, optionally wrapped in a file-backed cache.
+func (t *Transport) buildTokenSource(restyClient *resty.Client) (TokenSource, error) {
+	source := t.tokenSource
+	if source == nil {
+		// Use the underlying *http.Client for token requests so they bypass resty middleware
+		source = NewCredentialProviderTokenSource(t.authConfig, t.credentials, restyClient.Client(), t.logger)
+	}
+
+	if !t.tokenCacheOn {
+		return source, nil
+	}
+
+	path := t.tokenCachePath
+	if path == "" {
+		defaultPath, err := DefaultTokenCachePath(t.baseURL, t.authConfig.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		path = defaultPath
+	}
+
+	t.logger.Info("File token cache enabled", zap.String("path", path))
+	return NewFileTokenCache(path, source, t.logger), nil
+}
+
 // GetHTTPClient returns the underlying resty client
 func (t *Transport) GetHTTPClient() *resty.Client {
 	return t.client
@@ -102,11 +233,28 @@ func (t *Transport) GetTokenManager() *TokenManager {
 	return t.tokenManager
 }
 
+// QueryCacheStats returns the query cache hit, miss and invalidation counts.
+// It returns zero stats when the query cache is not enabled.
+func (t *Transport) QueryCacheStats() CacheStats {
+	if t.queryCache == nil {
+		return CacheStats{}
+	}
+	return t.queryCache.stats()
+}
+
+// ClearQueryCache drops every query result cached for this transport's tenant and client.
+// Entries other transports stored in a shared backend are kept.
+func (t *Transport) ClearQueryCache() {
+	if t.queryCache != nil {
+		t.queryCache.clear()
+	}
+}
+
 // SetLogger updates the logger at runtime
 func (t *Transport) SetLogger(logger *zap.Logger) {
 	if logger != nil {
-		t.logger = logger
-		t.tokenManager.logger = logger
+		t.logger = t.redactLogger(logger)
+		t.tokenManager.setLogger(t.logger)
 	}
 }
 
@@ -125,3 +273,10 @@ func (t *Transport) RefreshToken(ctx context.Context) error {
 func (t *Transport) InvalidateToken() {
 	t.tokenManager.InvalidateToken()
 }
+
+// Close stops background work started by the transport, such as the background token
+// refresher. The transport must not be used after Close.
+func (t *Transport) Close() error {
+	t.tokenManager.StopBackgroundRefresh()
+	return nil
+}
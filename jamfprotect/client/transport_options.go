@@ -4,7 +4,9 @@ import (
 	"crypto/tls"
 	"fmt"
 	"maps"
+	"math"
 	"net/http"
+	"slices"
 	"time"
 
 	"go.uber.org/zap"
@@ -16,6 +18,9 @@ type ClientOption func(*Transport) error
 // WithBaseURL sets a custom base URL for the API client
 func WithBaseURL(baseURL string) ClientOption {
 	return func(t *Transport) error {
+		if err := ValidateBaseURL(baseURL); err != nil {
+			return err
+		}
 		t.baseURL = baseURL
 		t.client.SetBaseURL(baseURL)
 		t.logger.Info("Base URL configured", zap.String("base_url", baseURL))
@@ -23,9 +28,25 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// WithTenant sets the base URL to the Jamf Protect tenant URL for the given tenant name and
+// region (e.g. WithTenant("acme", RegionUS) uses https://acme.protect.jamfcloud.com).
+// An empty region defaults to RegionUS.
+func WithTenant(name, region string) ClientOption {
+	return func(t *Transport) error {
+		baseURL, err := TenantBaseURL(name, region)
+		if err != nil {
+			return err
+		}
+		return WithBaseURL(baseURL)(t)
+	}
+}
+
 // WithTimeout sets a custom timeout for HTTP requests
 func WithTimeout(timeout time.Duration) ClientOption {
 	return func(t *Transport) error {
+		if err := ValidateTimeout(int(math.Ceil(timeout.Seconds()))); err != nil {
+			return err
+		}
 		t.client.SetTimeout(timeout)
 		t.logger.Info("HTTP timeout configured", zap.Duration("timeout", timeout))
 		return nil
@@ -35,6 +56,9 @@ func WithTimeout(timeout time.Duration) ClientOption {
 // WithRetryCount sets the number of retries for failed requests
 func WithRetryCount(count int) ClientOption {
 	return func(t *Transport) error {
+		if err := ValidateRetryCount(count); err != nil {
+			return err
+		}
 		t.client.SetRetryCount(count)
 		t.logger.Info("Retry count configured", zap.Int("retry_count", count))
 		return nil
@@ -61,10 +85,14 @@ func WithRetryMaxWaitTime(maxWaitTime time.Duration) ClientOption {
 	}
 }
 
-// WithLogger sets a custom logger for the client
+// WithLogger sets a custom logger for the client. Its output passes through the
+// client's redaction policy.
 func WithLogger(logger *zap.Logger) ClientOption {
 	return func(t *Transport) error {
-		t.logger = logger
+		if logger == nil {
+			return fmt.Errorf("logger cannot be nil")
+		}
+		t.logger = t.redactLogger(logger)
 		t.logger.Info("Custom logger configured")
 		return nil
 	}
@@ -106,7 +134,7 @@ func WithCustomAgent(customAgent string) ClientOption {
 func WithGlobalHeader(key, value string) ClientOption {
 	return func(t *Transport) error {
 		t.globalHeaders[key] = value
-		t.logger.Info("Global header configured", zap.String("key", key), zap.String("value", value))
+		t.logger.Info("Global header configured", zap.String("key", key))
 		return nil
 	}
 }
@@ -115,7 +143,7 @@ func WithGlobalHeader(key, value string) ClientOption {
 func WithGlobalHeaders(headers map[string]string) ClientOption {
 	return func(t *Transport) error {
 		maps.Copy(t.globalHeaders, headers)
-		t.logger.Info("Multiple global headers configured", zap.Int("count", len(headers)))
+		t.logger.Info("Multiple global headers configured", zap.Strings("keys", slices.Sorted(maps.Keys(headers))))
 		return nil
 	}
 }
@@ -124,6 +152,9 @@ func WithGlobalHeaders(headers map[string]string) ClientOption {
 // Example: "http://proxy.company.com:8080" or "socks5://127.0.0.1:1080"
 func WithProxy(proxyURL string) ClientOption {
 	return func(t *Transport) error {
+		if err := ValidateProxyURL(proxyURL); err != nil {
+			return err
+		}
 		t.client.SetProxy(proxyURL)
 		t.logger.Info("Proxy configured", zap.String("proxy", proxyURL))
 		return nil
@@ -139,6 +170,20 @@ func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
 	}
 }
 
+// WithCABundle trusts the PEM certificates in the file at path, instead of the system roots,
+// when verifying the API's TLS certificate. Use it behind TLS-intercepting proxies.
+func WithCABundle(path string) ClientOption {
+	return func(t *Transport) error {
+		pool, err := loadCABundle(path)
+		if err != nil {
+			return err
+		}
+		t.client.SetTLSClientConfig(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+		t.logger.Info("CA bundle configured", zap.String("path", path))
+		return nil
+	}
+}
+
 // WithTLSInsecureSkipVerify disables SSL certificate verification
 // WARNING: Only use this for testing. Never in production!
 func WithTLSInsecureSkipVerify() ClientOption {
@@ -161,6 +206,165 @@ func WithTransport(transport http.RoundTripper) ClientOption {
 	}
 }
 
+// WithTokenSource sets a custom source for OAuth2 access tokens, replacing the built-in
+// client credentials exchange. The client ID and secret are still required by NewClient.
+func WithTokenSource(source TokenSource) ClientOption {
+	return func(t *Transport) error {
+		if source == nil {
+			return fmt.Errorf("token source cannot be nil")
+		}
+		t.tokenSource = source
+		t.logger.Info("Custom token source configured")
+		return nil
+	}
+}
+
+// WithTokenCacheFile enables a file-backed token cache shared by all processes using the same path.
+// The file is locked during refresh and written with 0600 permissions.
+// An empty path uses DefaultTokenCachePath for the configured base URL and client ID.
+func WithTokenCacheFile(path string) ClientOption {
+	return func(t *Transport) error {
+		t.tokenCacheOn = true
+		t.tokenCachePath = path
+		t.logger.Info("Token cache file configured", zap.String("path", path))
+		return nil
+	}
+}
+
+// WithEagerTokenFetch fetches the first access token while the client is being constructed,
+// so that bad credentials fail NewClient. By default the first token is fetched lazily.
+func WithEagerTokenFetch() ClientOption {
+	return func(t *Transport) error {
+		t.eagerTokenFetch = true
+		t.logger.Info("Eager token fetch enabled")
+		return nil
+	}
+}
+
+// WithBackgroundTokenRefresh renews the access token on a background goroutine once
+// config.RefreshAt (e.g. 0.8) of its lifetime has elapsed, so requests never wait on /token.
+// Failed refreshes back off exponentially. Call Close on the client to stop the refresher.
+func WithBackgroundTokenRefresh(config BackgroundRefreshConfig) ClientOption {
+	return func(t *Transport) error {
+		if err := config.Validate(); err != nil {
+			return fmt.Errorf("invalid background token refresh: %w", err)
+		}
+		t.tokenRefresh = &config
+		t.logger.Info("Background token refresh configured",
+			zap.Float64("refresh_at", config.withDefaults().RefreshAt))
+		return nil
+	}
+}
+
+// WithOnTokenRefreshed registers a callback invoked after every successful token refresh
+func WithOnTokenRefreshed(fn func(event TokenRefreshEvent)) ClientOption {
+	return func(t *Transport) error {
+		t.tokenHooks.OnTokenRefreshed = fn
+		return nil
+	}
+}
+
+// WithOnTokenRefreshFailed registers a callback invoked after every failed token refresh
+func WithOnTokenRefreshFailed(fn func(event TokenRefreshEvent, err error)) ClientOption {
+	return func(t *Transport) error {
+		t.tokenHooks.OnTokenRefreshFailed = fn
+		return nil
+	}
+}
+
+// WithTracing enables OpenTelemetry spans and metrics for every GraphQL operation.
+// A nil config uses DefaultOTelConfig. See Transport.EnableTracing.
+func WithTracing(config *OTelConfig) ClientOption {
+	return func(t *Transport) error {
+		if config == nil {
+			config = DefaultOTelConfig()
+		}
+		t.otelConfig = config
+		return nil
+	}
+}
+
+// WithMiddleware adds middleware that wraps every GraphQL operation. Middleware runs in
+// the order given, across calls: the first middleware registered is the outermost.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(t *Transport) error {
+		for _, mw := range middleware {
+			if mw == nil {
+				return fmt.Errorf("middleware cannot be nil")
+			}
+		}
+		t.middleware = append(t.middleware, middleware...)
+		t.logger.Info("GraphQL middleware configured", zap.Int("count", len(t.middleware)))
+		return nil
+	}
+}
+
+// WithQueryCache enables a read-through cache for GraphQL queries, keyed by service,
+// operation name and variables. Mutations invalidate the cached entries of their service.
+// Use WithCacheBypass on a context to force a fresh read.
+func WithQueryCache(config QueryCacheConfig) ClientOption {
+	return func(t *Transport) error {
+		if config.TTL < 0 {
+			return fmt.Errorf("query cache TTL cannot be negative")
+		}
+		t.queryCacheConfig = &config
+		t.logger.Info("Query cache enabled", zap.Duration("ttl", config.TTL))
+		return nil
+	}
+}
+
+// WithDryRun makes every create, update and delete validate its input and build its
+// variables as usual, then record the planned mutation in the client's change set and
+// return a synthetic result instead of calling the API. Queries are still sent.
+// Use WithDryRunOverride to enable or disable dry-run mode for a single context.
+func WithDryRun() ClientOption {
+	return func(t *Transport) error {
+		t.dryRun = true
+		t.logger.Info("Dry-run mode enabled")
+		return nil
+	}
+}
+
+// WithRecording records GraphQL exchanges to the cassette file at path, or replays them
+// without network access, depending on mode. Tokens and secrets are redacted from cassettes;
+// redactKeys adds key names to redact. Apply it after WithTransport or WithHTTPClient.
+func WithRecording(mode RecordMode, path string, redactKeys ...string) ClientOption {
+	return func(t *Transport) error {
+		httpClient := t.client.Client()
+		rt, err := NewRecordingTransport(httpClient.Transport, path, mode, redactKeys...)
+		if err != nil {
+			return err
+		}
+		httpClient.Transport = rt
+		t.logger.Info("HTTP recording configured",
+			zap.String("cassette", path),
+			zap.Stringer("mode", rt.Mode()))
+		return nil
+	}
+}
+
+// WithStartupProbe makes NewClient verify the tenant before returning by fetching a token and
+// issuing a trivial query. Failures are typed so callers can tell a wrong tenant
+// (*TenantNotFoundError) from bad credentials (*InvalidCredentialsError) and connectivity
+// problems (*NetworkError).
+func WithStartupProbe() ClientOption {
+	return func(t *Transport) error {
+		t.startupProbe = true
+		t.logger.Info("Startup probe enabled")
+		return nil
+	}
+}
+
+// WithAuthRetryDisabled disables the automatic token invalidation and single replay
+// performed when the API rejects an access token with 401 / UnauthorizedException
+func WithAuthRetryDisabled() ClientOption {
+	return func(t *Transport) error {
+		t.authRetryDisabled = true
+		t.logger.Info("Automatic replay on unauthorized responses disabled")
+		return nil
+	}
+}
+
 // WithRateLimiter sets a custom rate limiter function
 // The function is called before each request and can return an error to rate limit
 func WithRateLimiter(limiter func() error) ClientOption {
@@ -182,6 +386,24 @@ func WithRateLimiter(limiter func() error) ClientOption {
 	}
 }
 
+// WithMaxConcurrentRequests limits the number of in-flight HTTP requests made by the client,
+// including token requests. Requests beyond the limit wait for a free slot.
+func WithMaxConcurrentRequests(max int) ClientOption {
+	return func(t *Transport) error {
+		httpClient := t.client.Client()
+		if httpClient == nil {
+			return nil
+		}
+		limited, err := NewConcurrencyLimitTransport(httpClient.Transport, max)
+		if err != nil {
+			return err
+		}
+		httpClient.Transport = limited
+		t.logger.Info("Max concurrent requests configured", zap.Int("max", max))
+		return nil
+	}
+}
+
 // rateLimitTransport wraps an HTTP transport with rate limiting
 type rateLimitTransport struct {
 	base    http.RoundTripper
@@ -1,10 +1,18 @@
 package client
 
 import (
+	"crypto/x509"
 	"fmt"
+	"os"
+	"regexp"
 	"strings"
+
+	"go.uber.org/zap/zapcore"
 )
 
+// tenantNamePattern matches a single DNS label, which Jamf Protect tenant names must be
+var tenantNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
 // ValidateTransportConfig validates the transport configuration parameters
 func ValidateTransportConfig(clientID, clientSecret string) error {
 	if clientID == "" {
@@ -75,3 +83,73 @@ func ValidateProxyURL(proxyURL string) error {
 
 	return nil
 }
+
+// ValidateLogLevel validates a log level name (debug, info, warn, error, dpanic, panic or fatal)
+func ValidateLogLevel(level string) error {
+	if level == "" {
+		return nil // Empty is valid (default level)
+	}
+
+	if _, err := zapcore.ParseLevel(level); err != nil {
+		return fmt.Errorf("invalid log level %q: must be one of debug, info, warn, error, dpanic, panic, fatal", level)
+	}
+
+	return nil
+}
+
+// ValidateCABundle validates that path is a readable PEM file containing at least one certificate
+func ValidateCABundle(path string) error {
+	if path == "" {
+		return nil // Empty is valid (system roots)
+	}
+
+	_, err := loadCABundle(path)
+	return err
+}
+
+// loadCABundle reads the PEM certificates at path into a new certificate pool
+func loadCABundle(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA bundle: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("CA bundle %s contains no PEM certificates", path)
+	}
+
+	return pool, nil
+}
+
+// ValidateTenantName validates a Jamf Protect tenant name
+func ValidateTenantName(name string) error {
+	if name == "" {
+		return fmt.Errorf("tenant name cannot be empty")
+	}
+
+	if !tenantNamePattern.MatchString(name) {
+		return fmt.Errorf("tenant name %q must be a lowercase DNS label (letters, digits and hyphens)", name)
+	}
+
+	return nil
+}
+
+// TenantBaseURL builds the API base URL for a tenant in the given region.
+// An empty region defaults to RegionUS.
+func TenantBaseURL(name, region string) (string, error) {
+	if err := ValidateTenantName(name); err != nil {
+		return "", err
+	}
+
+	if region == "" {
+		region = RegionUS
+	}
+
+	format, ok := tenantURLFormats[region]
+	if !ok {
+		return "", fmt.Errorf("unknown region %q", region)
+	}
+
+	return fmt.Sprintf(format, name), nil
+}
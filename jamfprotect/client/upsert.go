@@ -0,0 +1,198 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertAction reports what an Upsert method did
+type UpsertAction string
+
+const (
+	// UpsertCreated means no existing object matched, so one was created
+	UpsertCreated UpsertAction = "created"
+
+	// UpsertUpdated means an existing object differed from the desired state and was updated
+	UpsertUpdated UpsertAction = "updated"
+
+	// UpsertUnchanged means an existing object already matched the desired state
+	UpsertUnchanged UpsertAction = "unchanged"
+)
+
+// UpsertOptions control how an Upsert method finds the existing object
+type UpsertOptions struct {
+	// ID, when set, identifies the existing object instead of its name, so an upsert can
+	// rename it. The object must exist.
+	ID string
+}
+
+// UpsertOption configures an Upsert call
+type UpsertOption func(*UpsertOptions)
+
+// UpsertByID keys an upsert on the object's ID instead of its name
+func UpsertByID(id string) UpsertOption {
+	return func(o *UpsertOptions) {
+		o.ID = id
+	}
+}
+
+// NewUpsertOptions applies opts to the default options
+func NewUpsertOptions(opts ...UpsertOption) UpsertOptions {
+	var o UpsertOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// Upserter supplies the service calls Upsert makes for one kind of object
+type Upserter[T any] struct {
+	// Kind names the object in errors, e.g. "plan"
+	Kind string
+
+	// Get and GetByName read the existing object. GetByName returns an error wrapping
+	// ErrNotFound when no object has the name.
+	Get       func(ctx context.Context, id string) (*T, *interfaces.Response, error)
+	GetByName func(ctx context.Context, name string) (*T, *interfaces.Response, error)
+
+	// Create creates the object in its desired state
+	Create func(ctx context.Context) (*T, *interfaces.Response, error)
+
+	// Update brings the existing object to its desired state
+	Update func(ctx context.Context, existing *T) (*T, *interfaces.Response, error)
+
+	// Matches reports whether the existing object is already in its desired state
+	Matches func(existing *T) bool
+}
+
+// Upsert finds the object named name, or the one identified by UpsertByID, and creates it
+// when none exists, updates it when it does not match, and otherwise leaves it alone. The
+// Upsert methods of the services are built on it.
+func Upsert[T any](ctx context.Context, name string, u Upserter[T], opts ...UpsertOption) (*T, UpsertAction, *interfaces.Response, error) {
+	options := NewUpsertOptions(opts...)
+	var existing *T
+	var resp *interfaces.Response
+	var err error
+	if options.ID != "" {
+		existing, resp, err = u.Get(ctx, options.ID)
+	} else {
+		existing, resp, err = u.GetByName(ctx, name)
+		if errors.Is(err, ErrNotFound) {
+			existing, err = nil, nil
+		}
+	}
+	if err != nil {
+		return nil, "", resp, err
+	}
+	if existing == nil && options.ID != "" {
+		return nil, "", resp, fmt.Errorf("%w: %s %q", ErrNotFound, u.Kind, options.ID)
+	}
+
+	if existing == nil {
+		created, resp, err := u.Create(ctx)
+		if err != nil {
+			return nil, "", resp, err
+		}
+		return created, UpsertCreated, resp, nil
+	}
+
+	if u.Matches(existing) {
+		return existing, UpsertUnchanged, resp, nil
+	}
+
+	updated, resp, err := u.Update(ctx, existing)
+	if err != nil {
+		return nil, "", resp, err
+	}
+	return updated, UpsertUpdated, resp, nil
+}
+
+// VariablesMatch reports whether the mutation variables built from an existing object,
+// current, already express desired. Only the variables present in desired are compared,
+// so optional inputs the caller left unset do not count as changes. Values are compared
+// by their JSON form, and null, empty lists and empty objects are treated as equal.
+func VariablesMatch(current, desired map[string]any) bool {
+	cur, ok1 := normalizeJSON(current).(map[string]any)
+	want, ok2 := normalizeJSON(desired).(map[string]any)
+	if !ok1 || !ok2 {
+		return false
+	}
+	for key, value := range want {
+		if !jsonEquivalent(cur[key], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeJSON converts v to the generic form produced by decoding its JSON encoding
+func normalizeJSON(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return v
+	}
+	return out
+}
+
+// jsonEquivalent compares decoded JSON values, treating null, [] and {} as equal and
+// missing object keys as null
+func jsonEquivalent(a, b any) bool {
+	if isEmptyJSON(a) && isEmptyJSON(b) {
+		return true
+	}
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range av {
+			if !jsonEquivalent(v, bv[k]) {
+				return false
+			}
+		}
+		for k, v := range bv {
+			if _, seen := av[k]; !seen && !isEmptyJSON(v) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEquivalent(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// isEmptyJSON reports whether v is null, an empty list or an empty object
+func isEmptyJSON(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case []any:
+		return len(t) == 0
+	case map[string]any:
+		return len(t) == 0
+	}
+	return false
+}
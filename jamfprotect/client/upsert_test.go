@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariablesMatch(t *testing.T) {
+	current := map[string]any{
+		"name":        "Plan",
+		"description": "",
+		"tags":        []string{},
+		"telemetry":   nil,
+		"commsConfig": map[string]any{"fqdn": "protect.example.com", "protocol": "mqtt"},
+	}
+
+	tests := []struct {
+		name    string
+		desired map[string]any
+		want    bool
+	}{
+		{"identical", map[string]any{"name": "Plan", "commsConfig": map[string]any{"fqdn": "protect.example.com", "protocol": "mqtt"}}, true},
+		{"unset inputs ignored", map[string]any{"name": "Plan"}, true},
+		{"null and empty list equal", map[string]any{"tags": nil, "telemetry": []any{}}, true},
+		{"missing nested key is null", map[string]any{"commsConfig": map[string]any{"fqdn": "protect.example.com", "protocol": "mqtt", "extra": nil}}, true},
+		{"different scalar", map[string]any{"name": "Other"}, false},
+		{"different nested value", map[string]any{"commsConfig": map[string]any{"fqdn": "protect.example.com", "protocol": "wss"}}, false},
+		{"list gained an item", map[string]any{"tags": []string{"a"}}, false},
+		{"new key with value", map[string]any{"autoUpdate": true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, VariablesMatch(current, tt.desired))
+		})
+	}
+}
+
+type upsertItem struct {
+	ID, Name, Value string
+}
+
+func TestUpsert(t *testing.T) {
+	errBoom := errors.New("boom")
+	tests := []struct {
+		name       string
+		stored     *upsertItem
+		lookupErr  error
+		writeErr   error
+		opts       []UpsertOption
+		wantAction UpsertAction
+		wantValue  string
+		wantErr    error
+		wantCalls  []string
+	}{
+		{name: "missing is created", wantAction: UpsertCreated, wantValue: "desired",
+			wantCalls: []string{"getByName", "create"}},
+		{name: "matching is unchanged", stored: &upsertItem{ID: "1", Name: "item", Value: "desired"},
+			wantAction: UpsertUnchanged, wantValue: "desired", wantCalls: []string{"getByName"}},
+		{name: "different is updated", stored: &upsertItem{ID: "1", Name: "item", Value: "old"},
+			wantAction: UpsertUpdated, wantValue: "desired", wantCalls: []string{"getByName", "update 1"}},
+		{name: "by ID", stored: &upsertItem{ID: "1", Name: "renamed", Value: "old"}, opts: []UpsertOption{UpsertByID("1")},
+			wantAction: UpsertUpdated, wantValue: "desired", wantCalls: []string{"get 1", "update 1"}},
+		{name: "missing ID is not found", opts: []UpsertOption{UpsertByID("1"), nil},
+			wantErr: ErrNotFound, wantCalls: []string{"get 1"}},
+		{name: "ambiguous name", lookupErr: fmt.Errorf("%w: 2 items", ErrAmbiguousName),
+			wantErr: ErrAmbiguousName, wantCalls: []string{"getByName"}},
+		{name: "create error", writeErr: errBoom, wantErr: errBoom, wantCalls: []string{"getByName", "create"}},
+		{name: "update error", stored: &upsertItem{ID: "1", Name: "item", Value: "old"}, writeErr: errBoom,
+			wantErr: errBoom, wantCalls: []string{"getByName", "update 1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			desired := upsertItem{Name: "item", Value: "desired"}
+			u := Upserter[upsertItem]{
+				Kind: "item",
+				Get: func(ctx context.Context, id string) (*upsertItem, *interfaces.Response, error) {
+					calls = append(calls, "get "+id)
+					return tt.stored, nil, tt.lookupErr
+				},
+				GetByName: func(ctx context.Context, name string) (*upsertItem, *interfaces.Response, error) {
+					calls = append(calls, "getByName")
+					if tt.lookupErr != nil {
+						return nil, nil, tt.lookupErr
+					}
+					if tt.stored == nil {
+						return nil, nil, fmt.Errorf("%w: no item named %q", ErrNotFound, name)
+					}
+					return tt.stored, nil, nil
+				},
+				Create: func(ctx context.Context) (*upsertItem, *interfaces.Response, error) {
+					calls = append(calls, "create")
+					created := desired
+					return &created, nil, tt.writeErr
+				},
+				Update: func(ctx context.Context, existing *upsertItem) (*upsertItem, *interfaces.Response, error) {
+					calls = append(calls, "update "+existing.ID)
+					updated := desired
+					updated.ID = existing.ID
+					return &updated, nil, tt.writeErr
+				},
+				Matches: func(existing *upsertItem) bool {
+					return existing.Name == desired.Name && existing.Value == desired.Value
+				},
+			}
+
+			got, action, _, err := Upsert(context.Background(), desired.Name, u, tt.opts...)
+
+			assert.Equal(t, tt.wantCalls, calls)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAction, action)
+			assert.Equal(t, tt.wantValue, got.Value)
+		})
+	}
+}
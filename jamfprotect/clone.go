@@ -0,0 +1,166 @@
+package jamfprotect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	plans "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+)
+
+// ClonePlanOptions selects which objects referenced by a plan ClonePlan copies along with
+// it. References that are not cloned are shared between the original plan and the clone.
+type ClonePlanOptions struct {
+	// ActionConfig clones the plan's action configuration
+	ActionConfig bool
+
+	// ExceptionSets clones the plan's custom exception sets. Jamf-managed sets are always shared.
+	ExceptionSets bool
+
+	// AnalyticSets clones the plan's custom analytic sets. Jamf-managed sets are always shared.
+	AnalyticSets bool
+
+	// USBControlSet clones the plan's USB control set
+	USBControlSet bool
+
+	// TelemetryV2 clones the plan's telemetry v2 configuration
+	TelemetryV2 bool
+
+	// Rename returns the name of a cloned object from its original name. Defaults to
+	// "<name> (<new plan name>)".
+	Rename func(name string) string
+}
+
+// DeepClone returns options that clone every object a plan references
+func DeepClone() *ClonePlanOptions {
+	return &ClonePlanOptions{
+		ActionConfig:  true,
+		ExceptionSets: true,
+		AnalyticSets:  true,
+		USBControlSet: true,
+		TelemetryV2:   true,
+	}
+}
+
+// ClonePlan creates a copy of a plan named newName. With nil opts the clone references the
+// same action configuration, sets and telemetry as the original; opts selects referenced
+// objects to copy as well, in which case the clone references the copies. If any step fails,
+// the objects already copied are deleted again before the error is returned.
+func (c *Client) ClonePlan(ctx context.Context, id, newName string, opts *ClonePlanOptions) (*plans.Plan, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if newName == "" {
+		return nil, fmt.Errorf("%w: new name is required", client.ErrInvalidInput)
+	}
+	if opts == nil {
+		opts = &ClonePlanOptions{}
+	}
+	rename := opts.Rename
+	if rename == nil {
+		rename = func(name string) string { return fmt.Sprintf("%s (%s)", name, newName) }
+	}
+
+	source, _, err := c.Plan.GetPlan(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan %q: %w", id, err)
+	}
+	if source == nil {
+		return nil, fmt.Errorf("%w: plan %q", client.ErrNotFound, id)
+	}
+
+	req := source.ToCreateRequest()
+	req.Name = newName
+
+	// undo deletes the copies made so far, newest first, if the clone fails
+	var undo []func(context.Context) error
+	rollback := func(err error) (*plans.Plan, error) {
+		var errs []error
+		for i := len(undo) - 1; i >= 0; i-- {
+			if undoErr := undo[i](context.WithoutCancel(ctx)); undoErr != nil {
+				errs = append(errs, undoErr)
+			}
+		}
+		if len(errs) > 0 {
+			return nil, fmt.Errorf("%w (rollback failed: %w)", err, errors.Join(errs...))
+		}
+		return nil, err
+	}
+
+	if opts.ActionConfig && source.ActionConfigs != nil {
+		clone, _, err := c.ActionConfig.CloneActionConfig(ctx, source.ActionConfigs.ID, rename(source.ActionConfigs.Name))
+		if err != nil {
+			return rollback(fmt.Errorf("failed to clone action configuration %q: %w", source.ActionConfigs.Name, err))
+		}
+		undo = append(undo, func(ctx context.Context) error {
+			_, err := c.ActionConfig.DeleteActionConfig(ctx, clone.ID)
+			return err
+		})
+		req.ActionConfigs = clone.ID
+	}
+
+	if opts.ExceptionSets {
+		for i, set := range source.ExceptionSets {
+			if set.Managed {
+				continue
+			}
+			clone, _, err := c.ExceptionSet.CloneExceptionSet(ctx, set.UUID, rename(set.Name))
+			if err != nil {
+				return rollback(fmt.Errorf("failed to clone exception set %q: %w", set.Name, err))
+			}
+			undo = append(undo, func(ctx context.Context) error {
+				_, err := c.ExceptionSet.DeleteExceptionSet(ctx, clone.UUID)
+				return err
+			})
+			req.ExceptionSets[i] = clone.UUID
+		}
+	}
+
+	if opts.AnalyticSets {
+		for i, set := range source.AnalyticSets {
+			if set.AnalyticSet.Managed {
+				continue
+			}
+			clone, _, err := c.AnalyticSet.CloneAnalyticSet(ctx, set.AnalyticSet.UUID, rename(set.AnalyticSet.Name))
+			if err != nil {
+				return rollback(fmt.Errorf("failed to clone analytic set %q: %w", set.AnalyticSet.Name, err))
+			}
+			undo = append(undo, func(ctx context.Context) error {
+				_, err := c.AnalyticSet.DeleteAnalyticSet(ctx, clone.UUID)
+				return err
+			})
+			req.AnalyticSets[i].UUID = clone.UUID
+		}
+	}
+
+	if opts.USBControlSet && source.USBControlSet != nil {
+		clone, _, err := c.USBControlSet.CloneUSBControlSet(ctx, source.USBControlSet.ID, rename(source.USBControlSet.Name))
+		if err != nil {
+			return rollback(fmt.Errorf("failed to clone USB control set %q: %w", source.USBControlSet.Name, err))
+		}
+		undo = append(undo, func(ctx context.Context) error {
+			_, err := c.USBControlSet.DeleteUSBControlSet(ctx, clone.ID)
+			return err
+		})
+		req.USBControlSet = &clone.ID
+	}
+
+	if opts.TelemetryV2 && source.TelemetryV2 != nil {
+		clone, _, err := c.TelemetryV2.CloneTelemetryV2(ctx, source.TelemetryV2.ID, rename(source.TelemetryV2.Name))
+		if err != nil {
+			return rollback(fmt.Errorf("failed to clone telemetry %q: %w", source.TelemetryV2.Name, err))
+		}
+		undo = append(undo, func(ctx context.Context) error {
+			_, err := c.TelemetryV2.DeleteTelemetryV2(ctx, clone.ID)
+			return err
+		})
+		req.TelemetryV2 = &clone.ID
+	}
+
+	created, _, err := c.Plan.CreatePlan(ctx, req)
+	if err != nil {
+		return rollback(fmt.Errorf("failed to create plan %q: %w", newName, err))
+	}
+	return created, nil
+}
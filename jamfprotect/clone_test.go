@@ -0,0 +1,170 @@
+package jamfprotect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/jamfprotecttest"
+	actionconfigs "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	analytics "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic"
+	analyticsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic_set"
+	exceptionsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/exception_set"
+	plans "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+	usbcontrolsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/removable_storage_control_set"
+	telemetryv2 "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newProductionPlan seeds a fake tenant with a plan referencing one object of every kind,
+// plus a Jamf-managed analytic set
+func newProductionPlan(t *testing.T) (*jamfprotecttest.Server, *jamfprotect.Client, *plans.Plan) {
+	t.Helper()
+	server := jamfprotecttest.NewServer()
+	t.Cleanup(server.Close)
+	c, err := server.NewClient(client.WithLogger(zap.NewNop()))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	actionConfig, _, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "Prod Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	require.NoError(t, err)
+	analytic, _, err := c.Analytic.CreateAnalytic(ctx, &analytics.CreateAnalyticRequest{
+		Name:      "Analytic",
+		InputType: analytics.InputTypeGPFSEvent,
+		Filter:    "$event.type == 0",
+		Severity:  analytics.SeverityLow,
+	})
+	require.NoError(t, err)
+	analyticSet, _, err := c.AnalyticSet.CreateAnalyticSet(ctx, &analyticsets.CreateAnalyticSetRequest{
+		Name:      "Prod Analytics",
+		Types:     []string{"Report"},
+		Analytics: []string{analytic.UUID},
+	})
+	require.NoError(t, err)
+	managedSet, err := server.Put(jamfprotecttest.KindAnalyticSet, map[string]any{
+		"name":      "Jamf Baseline",
+		"managed":   true,
+		"types":     []string{"Report"},
+		"analytics": []string{analytic.UUID},
+	})
+	require.NoError(t, err)
+	exceptionSet, _, err := c.ExceptionSet.CreateExceptionSet(ctx, &exceptionsets.CreateExceptionSetRequest{
+		Name: "Prod Exceptions",
+		Exceptions: []exceptionsets.ExceptionInput{{
+			Type:           exceptionsets.ExceptionTypeUser,
+			Value:          "admin",
+			IgnoreActivity: exceptionsets.IgnoreActivityAnalytics,
+			AnalyticUuid:   analytic.UUID,
+		}},
+	})
+	require.NoError(t, err)
+	usbSet, _, err := c.USBControlSet.CreateUSBControlSet(ctx, &usbcontrolsets.CreateUSBControlSetRequest{
+		Name:               "Prod USB",
+		DefaultMountAction: usbcontrolsets.MountActionReadOnly,
+		Rules: []usbcontrolsets.USBControlRuleInput{{
+			Type:       usbcontrolsets.RuleTypeVendor,
+			VendorRule: &usbcontrolsets.USBControlRuleDetails{MountAction: usbcontrolsets.MountActionPrevented, Vendors: []string{"0x1234"}},
+		}},
+	})
+	require.NoError(t, err)
+	telemetry, _, err := c.TelemetryV2.CreateTelemetryV2(ctx, &telemetryv2.CreateTelemetryV2Request{
+		Name:     "Prod Telemetry",
+		LogFiles: []string{"/var/log/system.log"},
+	})
+	require.NoError(t, err)
+
+	plan, _, err := c.Plan.CreatePlan(ctx, &plans.CreatePlanRequest{
+		Name:          "Production",
+		ActionConfigs: actionConfig.ID,
+		ExceptionSets: []string{exceptionSet.UUID},
+		TelemetryV2:   &telemetry.ID,
+		AnalyticSets: []plans.AnalyticSetInput{
+			{Type: "Report", UUID: analyticSet.UUID},
+			{Type: "Report", UUID: managedSet},
+		},
+		USBControlSet: &usbSet.ID,
+		CommsConfig:   plans.CommsConfigInput{FQDN: "protect.example.com", Protocol: plans.ProtocolMQTT},
+		InfoSync:      plans.InfoSyncInput{Attrs: []string{"hostName"}, InsightsSyncInterval: 3600},
+		AutoUpdate:    true,
+	})
+	require.NoError(t, err)
+	return server, c, plan
+}
+
+func TestClonePlan_SharesReferences(t *testing.T) {
+	server, c, source := newProductionPlan(t)
+
+	clone, err := c.ClonePlan(context.Background(), source.ID, "Pilot", nil)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, source.ID, clone.ID)
+	assert.Equal(t, "Pilot", clone.Name)
+	assert.Equal(t, source.ActionConfigs.ID, clone.ActionConfigs.ID)
+	assert.Equal(t, source.ExceptionSets, clone.ExceptionSets)
+	assert.Equal(t, source.AnalyticSets, clone.AnalyticSets)
+	assert.Equal(t, source.USBControlSet, clone.USBControlSet)
+	assert.Equal(t, source.TelemetryV2, clone.TelemetryV2)
+	assert.Equal(t, source.CommsConfig, clone.CommsConfig)
+	assert.Equal(t, source.InfoSync, clone.InfoSync)
+	assert.True(t, clone.AutoUpdate)
+	assert.Equal(t, 1, server.Len(jamfprotecttest.KindActionConfig))
+}
+
+func TestClonePlan_DeepClone(t *testing.T) {
+	server, c, source := newProductionPlan(t)
+	ctx := context.Background()
+
+	clone, err := c.ClonePlan(ctx, source.ID, "Pilot", jamfprotect.DeepClone())
+	require.NoError(t, err)
+
+	assert.NotEqual(t, source.ActionConfigs.ID, clone.ActionConfigs.ID)
+	assert.Equal(t, "Prod Actions (Pilot)", clone.ActionConfigs.Name)
+	require.Len(t, clone.ExceptionSets, 1)
+	assert.NotEqual(t, source.ExceptionSets[0].UUID, clone.ExceptionSets[0].UUID)
+	assert.Equal(t, "Prod Exceptions (Pilot)", clone.ExceptionSets[0].Name)
+	assert.Equal(t, "Prod USB (Pilot)", clone.USBControlSet.Name)
+	assert.Equal(t, "Prod Telemetry (Pilot)", clone.TelemetryV2.Name)
+
+	require.Len(t, clone.AnalyticSets, 2)
+	assert.Equal(t, "Prod Analytics (Pilot)", clone.AnalyticSets[0].AnalyticSet.Name)
+	assert.Equal(t, source.AnalyticSets[1].AnalyticSet.UUID, clone.AnalyticSets[1].AnalyticSet.UUID, "Jamf-managed sets are shared")
+
+	exceptions, _, err := c.ExceptionSet.GetExceptionSet(ctx, clone.ExceptionSets[0].UUID)
+	require.NoError(t, err)
+	require.Len(t, exceptions.Exceptions, 1)
+	assert.Equal(t, "admin", exceptions.Exceptions[0].Value)
+
+	assert.Equal(t, 2, server.Len(jamfprotecttest.KindActionConfig))
+	assert.Equal(t, 3, server.Len(jamfprotecttest.KindAnalyticSet))
+
+	original, _, err := c.Plan.GetPlan(ctx, source.ID)
+	require.NoError(t, err)
+	assert.Equal(t, source.Hash, original.Hash, "the original plan is unchanged")
+}
+
+func TestClonePlan_RollsBackOnFailure(t *testing.T) {
+	server, c, source := newProductionPlan(t)
+
+	opts := jamfprotect.DeepClone()
+	opts.Rename = func(name string) string {
+		if name == "Prod Telemetry" {
+			return ""
+		}
+		return name + " copy"
+	}
+
+	_, err := c.ClonePlan(context.Background(), source.ID, "Pilot", opts)
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+
+	assert.Equal(t, 1, server.Len(jamfprotecttest.KindActionConfig))
+	assert.Equal(t, 1, server.Len(jamfprotecttest.KindExceptionSet))
+	assert.Equal(t, 2, server.Len(jamfprotecttest.KindAnalyticSet))
+	assert.Equal(t, 1, server.Len(jamfprotecttest.KindUSBControlSet))
+	assert.Equal(t, 1, server.Len(jamfprotecttest.KindPlan))
+}
@@ -0,0 +1,588 @@
+package jamfprotect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+)
+
+// Environment variables read by LoadConfig and Config.Profile. Values set in the
+// environment override the selected profile.
+const (
+	EnvConfigFile        = "JAMFPROTECT_CONFIG"
+	EnvProfile           = "JAMFPROTECT_PROFILE"
+	EnvClientID          = "JAMFPROTECT_CLIENT_ID"
+	EnvClientSecret      = "JAMFPROTECT_CLIENT_SECRET"
+	EnvBaseURL           = "JAMFPROTECT_BASE_URL"
+	EnvTenant            = "JAMFPROTECT_TENANT"
+	EnvRegion            = "JAMFPROTECT_REGION"
+	EnvTimeout           = "JAMFPROTECT_TIMEOUT"
+	EnvRetryCount        = "JAMFPROTECT_RETRY_COUNT"
+	EnvProxy             = "JAMFPROTECT_PROXY"
+	EnvCABundle          = "JAMFPROTECT_CA_BUNDLE"
+	EnvLogLevel          = "JAMFPROTECT_LOG_LEVEL"
+	EnvTracing           = "JAMFPROTECT_TRACING"
+	EnvCredentialCommand = "JAMFPROTECT_CREDENTIAL_COMMAND"
+	EnvCredentialFile    = "JAMFPROTECT_CREDENTIAL_FILE"
+	EnvCredentialHelper  = "JAMFPROTECT_CREDENTIAL_HELPER"
+)
+
+// DefaultProfileName is the profile used when none is named in the call, the
+// environment or the config file
+const DefaultProfileName = "default"
+
+// Config is a configuration file holding named client profiles. It is read from YAML or
+// JSON; JSON files are recognised by their .json extension.
+//
+//	default_profile: production
+//	profiles:
+//	  production:
+//	    tenant: acme
+//	    client_id: 0123456789abcdef
+//	    credential_command: ["op", "read", "op://jamf/protect/secret"]
+//	    timeout: 30s
+//	    retry: {count: 3, wait_time: 2s, max_wait_time: 10s}
+//	    log_level: warn
+//	  staging:
+//	    base_url: https://acme-staging.protect.jamfcloud.com
+//	    client_id: fedcba9876543210
+//	    client_secret: s3cr3t
+type Config struct {
+	// DefaultProfile names the profile used when none is requested
+	DefaultProfile string `json:"default_profile,omitempty" yaml:"default_profile,omitempty"`
+
+	// Profiles maps profile names to client settings
+	Profiles map[string]Profile `json:"profiles" yaml:"profiles"`
+}
+
+// Profile holds the settings of one client
+type Profile struct {
+	// ClientID and ClientSecret are the API client credentials
+	ClientID     string `json:"client_id,omitempty" yaml:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty" yaml:"client_secret,omitempty"`
+
+	// CredentialFile is a file holding the secret, or a JSON object with client_secret and
+	// optionally client_id fields. It must not be accessible by group or others.
+	CredentialFile string `json:"credential_file,omitempty" yaml:"credential_file,omitempty"`
+
+	// CredentialHelper is a git-style credential helper, run as "<helper...> get"
+	// (see client.HelperCredentials)
+	CredentialHelper []string `json:"credential_helper,omitempty" yaml:"credential_helper,omitempty"`
+
+	// CredentialCommand is run when ClientSecret is empty. Its standard output is either
+	// a JSON object with client_secret (and optionally client_id) fields, or the secret alone.
+	// In the environment, both commands are split into words like a shell does, so
+	// arguments containing spaces can be quoted.
+	CredentialCommand []string `json:"credential_command,omitempty" yaml:"credential_command,omitempty"`
+
+	// BaseURL is the API base URL. Tenant and Region build it instead (see client.WithTenant).
+	BaseURL string `json:"base_url,omitempty" yaml:"base_url,omitempty"`
+	Tenant  string `json:"tenant,omitempty" yaml:"tenant,omitempty"`
+	Region  string `json:"region,omitempty" yaml:"region,omitempty"`
+
+	// Timeout is the HTTP request timeout
+	Timeout Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// Retry configures retries of failed requests
+	Retry *RetryProfile `json:"retry,omitempty" yaml:"retry,omitempty"`
+
+	// Proxy is an http://, https:// or socks5:// proxy URL
+	Proxy string `json:"proxy,omitempty" yaml:"proxy,omitempty"`
+
+	// CABundle is a PEM file of certificates trusted instead of the system roots
+	CABundle string `json:"ca_bundle,omitempty" yaml:"ca_bundle,omitempty"`
+
+	// LogLevel is the minimum level logged: debug, info, warn or error
+	LogLevel string `json:"log_level,omitempty" yaml:"log_level,omitempty"`
+
+	// Tracing enables OpenTelemetry spans and metrics
+	Tracing *TracingProfile `json:"tracing,omitempty" yaml:"tracing,omitempty"`
+}
+
+// RetryProfile configures retries of failed requests
+type RetryProfile struct {
+	Count       *int     `json:"count,omitempty" yaml:"count,omitempty"`
+	WaitTime    Duration `json:"wait_time,omitempty" yaml:"wait_time,omitempty"`
+	MaxWaitTime Duration `json:"max_wait_time,omitempty" yaml:"max_wait_time,omitempty"`
+}
+
+// TracingProfile configures OpenTelemetry instrumentation using the global providers
+type TracingProfile struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// ServiceName names the tracer and meter. Defaults to "jamfprotect-client".
+	ServiceName string `json:"service_name,omitempty" yaml:"service_name,omitempty"`
+
+	// RecordVariables attaches redacted GraphQL variables to spans. Defaults to true.
+	RecordVariables *bool `json:"record_variables,omitempty" yaml:"record_variables,omitempty"`
+}
+
+// Duration is a time.Duration read from a string such as "30s" or "1m30s", or from a
+// number of seconds
+type Duration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		s = string(data)
+	}
+	return d.parse(s)
+}
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (d *Duration) UnmarshalYAML(node *yaml.Node) error {
+	return d.parse(node.Value)
+}
+
+// MarshalYAML implements yaml.Marshaler
+func (d Duration) MarshalYAML() (any, error) {
+	return time.Duration(d).String(), nil
+}
+
+func (d *Duration) parse(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		*d = 0
+		return nil
+	}
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		*d = Duration(seconds * float64(time.Second))
+		return nil
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q", s)
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// DefaultConfigPath returns the config file read when LoadConfig is given no path:
+// $JAMFPROTECT_CONFIG if set, otherwise jamfprotect/config.yaml in the user config directory
+func DefaultConfigPath() (string, error) {
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating user config directory: %w", err)
+	}
+	return filepath.Join(dir, "jamfprotect", "config.yaml"), nil
+}
+
+// LoadConfig reads a YAML or JSON config file. An empty path reads DefaultConfigPath.
+// Unknown keys are rejected so that misspelled settings are not silently ignored.
+func LoadConfig(path string) (*Config, error) {
+	if path == "" {
+		var err error
+		if path, err = DefaultConfigPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	var cfg Config
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&cfg)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(&cfg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// ProfileNames returns the names of the configured profiles in sorted order
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Profile returns the named profile with environment overrides applied and every value
+// validated. An empty name selects $JAMFPROTECT_PROFILE, then DefaultProfile, then
+// DefaultProfileName. The credential command is not run until Credentials is called.
+func (c *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	if name == "" {
+		name = c.DefaultProfile
+	}
+	if name == "" {
+		name = DefaultProfileName
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in config (available: %s)", name, strings.Join(c.ProfileNames(), ", "))
+	}
+
+	if err := profile.applyEnv(); err != nil {
+		return nil, fmt.Errorf("profile %q: %w", name, err)
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("profile %q: %w", name, err)
+	}
+
+	return &profile, nil
+}
+
+// applyEnv overrides profile settings with the JAMFPROTECT_* environment variables
+func (p *Profile) applyEnv() error {
+	strs := map[string]*string{
+		EnvClientID:       &p.ClientID,
+		EnvClientSecret:   &p.ClientSecret,
+		EnvBaseURL:        &p.BaseURL,
+		EnvTenant:         &p.Tenant,
+		EnvRegion:         &p.Region,
+		EnvProxy:          &p.Proxy,
+		EnvCABundle:       &p.CABundle,
+		EnvLogLevel:       &p.LogLevel,
+		EnvCredentialFile: &p.CredentialFile,
+	}
+	for env, field := range strs {
+		if value, ok := os.LookupEnv(env); ok {
+			*field = value
+		}
+	}
+
+	// A base URL in the environment wins over a tenant in the file, and vice versa
+	if _, ok := os.LookupEnv(EnvBaseURL); ok {
+		if _, ok := os.LookupEnv(EnvTenant); !ok {
+			p.Tenant = ""
+		}
+	} else if _, ok := os.LookupEnv(EnvTenant); ok {
+		p.BaseURL = ""
+	}
+
+	commands := map[string]*[]string{
+		EnvCredentialCommand: &p.CredentialCommand,
+		EnvCredentialHelper:  &p.CredentialHelper,
+	}
+	for env, field := range commands {
+		if value, ok := os.LookupEnv(env); ok {
+			command, err := splitCommand(value)
+			if err != nil {
+				return fmt.Errorf("%s: %w", env, err)
+			}
+			*field = command
+		}
+	}
+
+	if value, ok := os.LookupEnv(EnvTimeout); ok {
+		if err := p.Timeout.parse(value); err != nil {
+			return fmt.Errorf("%s: %w", EnvTimeout, err)
+		}
+	}
+
+	if value, ok := os.LookupEnv(EnvRetryCount); ok {
+		count, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s: invalid retry count %q", EnvRetryCount, value)
+		}
+		if p.Retry == nil {
+			p.Retry = &RetryProfile{}
+		} else {
+			retry := *p.Retry
+			p.Retry = &retry
+		}
+		p.Retry.Count = &count
+	}
+
+	if value, ok := os.LookupEnv(EnvTracing); ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%s: invalid boolean %q", EnvTracing, value)
+		}
+		if p.Tracing == nil {
+			p.Tracing = &TracingProfile{}
+		} else {
+			tracing := *p.Tracing
+			p.Tracing = &tracing
+		}
+		p.Tracing.Enabled = enabled
+	}
+
+	return nil
+}
+
+// Validate checks every setting with the client package's Validate* functions.
+// Credentials are checked by Credentials, since they may come from an external source.
+func (p *Profile) Validate() error {
+	external := p.CredentialFile != "" || len(p.CredentialHelper) > 0 || len(p.CredentialCommand) > 0
+	if p.ClientID == "" && !external {
+		return fmt.Errorf("client_id is required")
+	}
+	if p.ClientSecret == "" && !external {
+		return fmt.Errorf("client_secret, credential_file, credential_helper or credential_command is required")
+	}
+
+	if p.BaseURL != "" && p.Tenant != "" {
+		return fmt.Errorf("base_url and tenant are mutually exclusive")
+	}
+	if p.BaseURL != "" {
+		if err := client.ValidateBaseURL(p.BaseURL); err != nil {
+			return fmt.Errorf("base_url: %w", err)
+		}
+	}
+	if p.Tenant != "" {
+		if _, err := client.TenantBaseURL(p.Tenant, p.Region); err != nil {
+			return fmt.Errorf("tenant: %w", err)
+		}
+	} else if p.Region != "" {
+		return fmt.Errorf("region requires tenant")
+	}
+
+	if p.Timeout != 0 {
+		if err := client.ValidateTimeout(int(math.Ceil(time.Duration(p.Timeout).Seconds()))); err != nil {
+			return fmt.Errorf("timeout: %w", err)
+		}
+	}
+
+	if p.Retry != nil {
+		if p.Retry.Count != nil {
+			if err := client.ValidateRetryCount(*p.Retry.Count); err != nil {
+				return fmt.Errorf("retry.count: %w", err)
+			}
+		}
+		if p.Retry.WaitTime < 0 || p.Retry.MaxWaitTime < 0 {
+			return fmt.Errorf("retry wait times cannot be negative")
+		}
+		if p.Retry.WaitTime > 0 && p.Retry.MaxWaitTime > 0 && p.Retry.MaxWaitTime < p.Retry.WaitTime {
+			return fmt.Errorf("retry.max_wait_time cannot be less than retry.wait_time")
+		}
+	}
+
+	if err := client.ValidateProxyURL(p.Proxy); err != nil {
+		return fmt.Errorf("proxy: %w", err)
+	}
+	if err := client.ValidateCABundle(p.CABundle); err != nil {
+		return fmt.Errorf("ca_bundle: %w", err)
+	}
+	if err := client.ValidateLogLevel(p.LogLevel); err != nil {
+		return fmt.Errorf("log_level: %w", err)
+	}
+
+	return nil
+}
+
+// CredentialProvider returns the provider of the profile's client credentials: the
+// client secret, credential file, credential helper or credential command, in that order.
+// File, helper and command sources are read again before every token request.
+func (p *Profile) CredentialProvider() client.CredentialProvider {
+	switch {
+	case p.ClientSecret != "":
+		return client.StaticCredentials(p.ClientID, p.ClientSecret)
+	case p.CredentialFile != "":
+		return client.FileCredentials(p.CredentialFile, p.ClientID)
+	case len(p.CredentialHelper) > 0:
+		helper := client.NewHelperCredentials(p.helperHost(), p.CredentialHelper...)
+		clientID := p.ClientID
+		return client.CredentialProviderFunc(func(ctx context.Context) (*client.Credentials, error) {
+			creds, err := helper.Credentials(ctx)
+			if err == nil && creds.ClientID == "" {
+				creds.ClientID = clientID
+			}
+			return creds, err
+		})
+	default:
+		return client.CommandCredentials(p.ClientID, p.CredentialCommand...)
+	}
+}
+
+// splitCommand splits a command line into words the way a POSIX shell does, honouring
+// single quotes, double quotes and backslash escapes. Expansions are not performed.
+func splitCommand(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == '\\':
+			escaped = true
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", line)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// helperHost returns the host sent to the credential helper
+func (p *Profile) helperHost() string {
+	baseURL := p.BaseURL
+	if p.Tenant != "" {
+		baseURL, _ = client.TenantBaseURL(p.Tenant, p.Region)
+	}
+	if baseURL == "" {
+		baseURL = client.DefaultBaseURL
+	}
+	return strings.TrimPrefix(strings.TrimPrefix(baseURL, "https://"), "http://")
+}
+
+// Credentials returns the client ID and secret from the profile's credential provider
+func (p *Profile) Credentials(ctx context.Context) (clientID, clientSecret string, err error) {
+	creds, err := p.CredentialProvider().Credentials(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if err := client.ValidateTransportConfig(creds.ClientID, creds.ClientSecret); err != nil {
+		return "", "", err
+	}
+	return creds.ClientID, creds.ClientSecret, nil
+}
+
+// ClientOptions returns the client options for the profile's non-credential settings
+func (p *Profile) ClientOptions() ([]client.ClientOption, error) {
+	var options []client.ClientOption
+
+	if p.LogLevel != "" {
+		level, err := zapcore.ParseLevel(p.LogLevel)
+		if err != nil {
+			return nil, fmt.Errorf("log_level: %w", err)
+		}
+		handler := slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: client.SlogLevel(level)})
+		options = append(options, client.WithSlogHandler(handler))
+	}
+
+	switch {
+	case p.BaseURL != "":
+		options = append(options, client.WithBaseURL(p.BaseURL))
+	case p.Tenant != "":
+		options = append(options, client.WithTenant(p.Tenant, p.Region))
+	}
+
+	if p.Timeout != 0 {
+		options = append(options, client.WithTimeout(time.Duration(p.Timeout)))
+	}
+
+	if p.Retry != nil {
+		if p.Retry.Count != nil {
+			options = append(options, client.WithRetryCount(*p.Retry.Count))
+		}
+		if p.Retry.WaitTime != 0 {
+			options = append(options, client.WithRetryWaitTime(time.Duration(p.Retry.WaitTime)))
+		}
+		if p.Retry.MaxWaitTime != 0 {
+			options = append(options, client.WithRetryMaxWaitTime(time.Duration(p.Retry.MaxWaitTime)))
+		}
+	}
+
+	if p.Proxy != "" {
+		options = append(options, client.WithProxy(p.Proxy))
+	}
+
+	if p.CABundle != "" {
+		options = append(options, client.WithCABundle(p.CABundle))
+	}
+
+	if p.Tracing != nil && p.Tracing.Enabled {
+		otelConfig := client.DefaultOTelConfig()
+		if p.Tracing.ServiceName != "" {
+			otelConfig.ServiceName = p.Tracing.ServiceName
+		}
+		if p.Tracing.RecordVariables != nil {
+			otelConfig.RecordVariables = *p.Tracing.RecordVariables
+		}
+		options = append(options, client.WithTracing(otelConfig))
+	}
+
+	return options, nil
+}
+
+// NewClientFromConfig creates a client from a profile in a YAML or JSON config file.
+// An empty path reads DefaultConfigPath and an empty profile selects the default profile
+// (see Config.Profile). JAMFPROTECT_* environment variables override the file, and options
+// are applied after the profile's settings.
+//
+// Example:
+//
+//	client, err := jamfprotect.NewClientFromConfig("", "production")
+func NewClientFromConfig(path, profile string, options ...client.ClientOption) (*Client, error) {
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := cfg.Profile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	profileOptions, err := p.ClientOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientWithCredentials(p.CredentialProvider(), append(profileOptions, options...)...)
+}
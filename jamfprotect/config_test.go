@@ -0,0 +1,210 @@
+package jamfprotect
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+)
+
+const testConfigYAML = `
+default_profile: production
+profiles:
+  production:
+    tenant: acme
+    region: eu
+    client_id: prod-id
+    client_secret: prod-secret
+    timeout: 45s
+    retry:
+      count: 5
+      wait_time: 2s
+      max_wait_time: 10s
+    proxy: http://proxy.example.com:8080
+    log_level: warn
+    tracing:
+      enabled: true
+      service_name: jamf-sync
+  staging:
+    base_url: https://staging.example.com
+    client_id: staging-id
+    client_secret: staging-secret
+    timeout: 30
+`
+
+// clearConfigEnv unsets every override so the host environment cannot leak into tests
+func clearConfigEnv(t *testing.T) {
+	t.Helper()
+	for _, env := range []string{EnvConfigFile, EnvProfile, EnvClientID, EnvClientSecret, EnvBaseURL,
+		EnvTenant, EnvRegion, EnvTimeout, EnvRetryCount, EnvProxy, EnvCABundle, EnvLogLevel,
+		EnvTracing, EnvCredentialCommand, EnvCredentialFile, EnvCredentialHelper} {
+		if value, ok := os.LookupEnv(env); ok {
+			require.NoError(t, os.Unsetenv(env))
+			t.Cleanup(func() { os.Setenv(env, value) })
+		}
+	}
+}
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadConfig_YAMLProfiles(t *testing.T) {
+	clearConfigEnv(t)
+	cfg, err := LoadConfig(writeConfig(t, "config.yaml", testConfigYAML))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"production", "staging"}, cfg.ProfileNames())
+
+	p, err := cfg.Profile("")
+	require.NoError(t, err)
+	assert.Equal(t, "acme", p.Tenant)
+	assert.Equal(t, 45*time.Second, time.Duration(p.Timeout))
+	require.NotNil(t, p.Retry.Count)
+	assert.Equal(t, 5, *p.Retry.Count)
+	assert.Equal(t, 10*time.Second, time.Duration(p.Retry.MaxWaitTime))
+	assert.True(t, p.Tracing.Enabled)
+
+	staging, err := cfg.Profile("staging")
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, time.Duration(staging.Timeout))
+
+	_, err = cfg.Profile("missing")
+	assert.ErrorContains(t, err, "available: production, staging")
+}
+
+func TestLoadConfig_JSON(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeConfig(t, "config.json", `{
+		"profiles": {
+			"default": {"base_url": "https://example.com", "client_id": "id", "client_secret": "secret", "timeout": "1m"}
+		}
+	}`)
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	p, err := cfg.Profile("")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, time.Duration(p.Timeout))
+}
+
+func TestLoadConfig_RejectsUnknownKeys(t *testing.T) {
+	_, err := LoadConfig(writeConfig(t, "config.yaml", "profiles:\n  default:\n    client_secert: typo\n"))
+	assert.Error(t, err)
+}
+
+func TestConfigProfile_EnvironmentOverrides(t *testing.T) {
+	clearConfigEnv(t)
+	cfg, err := LoadConfig(writeConfig(t, "config.yaml", testConfigYAML))
+	require.NoError(t, err)
+
+	t.Setenv(EnvProfile, "staging")
+	t.Setenv(EnvClientSecret, "env-secret")
+	t.Setenv(EnvTenant, "other")
+	t.Setenv(EnvRetryCount, "2")
+	t.Setenv(EnvTimeout, "5s")
+
+	p, err := cfg.Profile("")
+	require.NoError(t, err)
+	assert.Equal(t, "staging-id", p.ClientID)
+	assert.Equal(t, "env-secret", p.ClientSecret)
+	assert.Equal(t, "other", p.Tenant)
+	assert.Empty(t, p.BaseURL, "a tenant in the environment replaces the file's base URL")
+	assert.Equal(t, 2, *p.Retry.Count)
+	assert.Equal(t, 5*time.Second, time.Duration(p.Timeout))
+
+	// The loaded config is not modified by the overrides
+	assert.Equal(t, "staging-secret", cfg.Profiles["staging"].ClientSecret)
+}
+
+func TestConfigProfile_ValidatesValues(t *testing.T) {
+	clearConfigEnv(t)
+	tests := map[string]string{
+		"base_url":  "base_url: ftp://example.com",
+		"timeout":   "timeout: 2h",
+		"retry":     "retry: {count: 11}",
+		"proxy":     "proxy: ftp://proxy",
+		"log_level": "log_level: loud",
+		"tenant":    "tenant: Not_A_Label",
+		"ca_bundle": "ca_bundle: /does/not/exist.pem",
+	}
+	for field, line := range tests {
+		t.Run(field, func(t *testing.T) {
+			cfg, err := LoadConfig(writeConfig(t, "config.yaml",
+				"profiles:\n  default:\n    client_id: id\n    client_secret: secret\n    "+line+"\n"))
+			require.NoError(t, err)
+			_, err = cfg.Profile("")
+			assert.ErrorContains(t, err, field)
+		})
+	}
+}
+
+func TestProfileCredentials_Command(t *testing.T) {
+	p := &Profile{ClientID: "file-id", CredentialCommand: []string{"echo", `{"client_secret": "from-command"}`}}
+	id, secret, err := p.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "file-id", id)
+	assert.Equal(t, "from-command", secret)
+
+	p = &Profile{ClientID: "file-id", CredentialCommand: []string{"echo", "plain-secret"}}
+	_, secret, err = p.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "plain-secret", secret)
+
+	p = &Profile{ClientID: "file-id", CredentialCommand: []string{"false"}}
+	_, _, err = p.Credentials(context.Background())
+	assert.ErrorContains(t, err, "credential command false failed")
+}
+
+func TestNewClientFromConfig(t *testing.T) {
+	clearConfigEnv(t)
+	server := newTenantServer(t)
+	path := writeConfig(t, "config.yaml", "profiles:\n  default:\n    base_url: "+server.URL+
+		"\n    client_id: tenant-a\n    client_secret: secret\n    log_level: error\n")
+
+	c, err := NewClientFromConfig(path, "")
+	require.NoError(t, err)
+	token, err := c.GetTransport().AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-for-tenant-a", token)
+
+	_, err = NewClientFromConfig(path, "", client.WithTimeout(-time.Second))
+	assert.Error(t, err)
+}
+
+func TestSplitCommand(t *testing.T) {
+	tests := map[string][]string{
+		"vault read -field=secret kv/protect":                 {"vault", "read", "-field=secret", "kv/protect"},
+		`op read "op://Vault/Jamf Protect/secret"`:            {"op", "read", "op://Vault/Jamf Protect/secret"},
+		`security find-generic-password -s 'jamf protect' -w`: {"security", "find-generic-password", "-s", "jamf protect", "-w"},
+		`helper path\ with\ spaces "" 'it"s'`:                 {"helper", "path with spaces", "", `it"s`},
+		"  padded\targs  ":                                    {"padded", "args"},
+	}
+	for line, want := range tests {
+		got, err := splitCommand(line)
+		require.NoError(t, err, line)
+		assert.Equal(t, want, got, line)
+	}
+
+	for _, line := range []string{`op read "unterminated`, `it's`, `trailing\`} {
+		_, err := splitCommand(line)
+		assert.Error(t, err, line)
+	}
+
+	clearConfigEnv(t)
+	t.Setenv(EnvCredentialCommand, `op read "op://Vault/Jamf Protect/secret"`)
+	p := &Profile{}
+	require.NoError(t, p.applyEnv())
+	assert.Equal(t, []string{"op", "read", "op://Vault/Jamf Protect/secret"}, p.CredentialCommand)
+
+	t.Setenv(EnvCredentialCommand, `op read "unterminated`)
+	assert.ErrorContains(t, (&Profile{}).applyEnv(), EnvCredentialCommand)
+}
@@ -0,0 +1,361 @@
+package jamfprotect
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	plans "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+)
+
+// ResourceKind identifies a type of object in a DependencyGraph or ImportReport
+type ResourceKind string
+
+const (
+	ResourcePlan                 ResourceKind = "plan"
+	ResourceActionConfig         ResourceKind = "action configuration"
+	ResourceAnalytic             ResourceKind = "analytic"
+	ResourceAnalyticSet          ResourceKind = "analytic set"
+	ResourceExceptionSet         ResourceKind = "exception set"
+	ResourceUSBControlSet        ResourceKind = "USB control set"
+	ResourcePreventList          ResourceKind = "prevent list"
+	ResourceTelemetry            ResourceKind = "telemetry"
+	ResourceTelemetryV2          ResourceKind = "telemetry v2"
+	ResourceUnifiedLoggingFilter ResourceKind = "unified logging filter"
+)
+
+// ResourceRef identifies an object by kind and ID (the UUID for analytics, analytic sets and
+// exception sets). Name is informational.
+type ResourceRef struct {
+	Kind ResourceKind
+	ID   string
+	Name string
+}
+
+// String returns the kind and name of the object, or its ID when the name is unknown
+func (r ResourceRef) String() string {
+	if r.Name == "" {
+		return fmt.Sprintf("%s %s", r.Kind, r.ID)
+	}
+	return fmt.Sprintf("%s %q", r.Kind, r.Name)
+}
+
+// resourceKey identifies a node of the graph regardless of its name
+type resourceKey struct {
+	kind ResourceKind
+	id   string
+}
+
+// DependencyGraph records which objects reference which. Plans reference their action
+// configuration, exception sets, analytic sets, USB control set and telemetry
+// configurations; analytic sets and exception sets reference analytics.
+type DependencyGraph struct {
+	names      map[resourceKey]string
+	dependents map[resourceKey]map[resourceKey]bool
+}
+
+// BuildDependencyGraph reads every plan, analytic set, exception set, USB control set and
+// telemetry v2 configuration and returns the references between them. Plan references are
+// combined with the back-references the sets report in their Plans fields.
+func (c *Client) BuildDependencyGraph(ctx context.Context) (*DependencyGraph, error) {
+	return c.buildDependencyGraph(ctx, true)
+}
+
+// buildDependencyGraph builds the graph, reading each exception set for the analytics it
+// references only when exceptionAnalytics is set
+func (c *Client) buildDependencyGraph(ctx context.Context, exceptionAnalytics bool) (*DependencyGraph, error) {
+	g := &DependencyGraph{
+		names:      make(map[resourceKey]string),
+		dependents: make(map[resourceKey]map[resourceKey]bool),
+	}
+
+	planList, _, err := c.Plan.ListPlans(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	for _, p := range planList {
+		plan := ResourceRef{Kind: ResourcePlan, ID: p.ID, Name: p.Name}
+		g.addNode(plan)
+		if p.ActionConfigs != nil {
+			g.addEdge(ResourceRef{Kind: ResourceActionConfig, ID: p.ActionConfigs.ID, Name: p.ActionConfigs.Name}, plan)
+		}
+		for _, set := range p.ExceptionSets {
+			g.addEdge(ResourceRef{Kind: ResourceExceptionSet, ID: set.UUID, Name: set.Name}, plan)
+		}
+		for _, set := range p.AnalyticSets {
+			g.addEdge(ResourceRef{Kind: ResourceAnalyticSet, ID: set.AnalyticSet.UUID, Name: set.AnalyticSet.Name}, plan)
+		}
+		if p.USBControlSet != nil {
+			g.addEdge(ResourceRef{Kind: ResourceUSBControlSet, ID: p.USBControlSet.ID, Name: p.USBControlSet.Name}, plan)
+		}
+		if p.Telemetry != nil {
+			g.addEdge(ResourceRef{Kind: ResourceTelemetry, ID: p.Telemetry.ID, Name: p.Telemetry.Name}, plan)
+		}
+		if p.TelemetryV2 != nil {
+			g.addEdge(ResourceRef{Kind: ResourceTelemetryV2, ID: p.TelemetryV2.ID, Name: p.TelemetryV2.Name}, plan)
+		}
+	}
+
+	analyticSets, _, err := c.AnalyticSet.ListAnalyticSets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list analytic sets: %w", err)
+	}
+	for _, s := range analyticSets {
+		set := ResourceRef{Kind: ResourceAnalyticSet, ID: s.UUID, Name: s.Name}
+		g.addNode(set)
+		for _, p := range s.Plans {
+			g.addEdge(set, ResourceRef{Kind: ResourcePlan, ID: p.ID, Name: p.Name})
+		}
+		for _, a := range s.Analytics {
+			g.addEdge(ResourceRef{Kind: ResourceAnalytic, ID: a.UUID, Name: a.Name}, set)
+		}
+	}
+
+	usbSets, _, err := c.USBControlSet.ListUSBControlSets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list USB control sets: %w", err)
+	}
+	for _, s := range usbSets {
+		set := ResourceRef{Kind: ResourceUSBControlSet, ID: s.ID, Name: s.Name}
+		g.addNode(set)
+		for _, p := range s.Plans {
+			g.addEdge(set, ResourceRef{Kind: ResourcePlan, ID: p.ID, Name: p.Name})
+		}
+	}
+
+	telemetries, _, err := c.TelemetryV2.ListTelemetriesV2(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list telemetry v2 configurations: %w", err)
+	}
+	for _, t := range telemetries {
+		telemetry := ResourceRef{Kind: ResourceTelemetryV2, ID: t.ID, Name: t.Name}
+		g.addNode(telemetry)
+		for _, p := range t.Plans {
+			g.addEdge(telemetry, ResourceRef{Kind: ResourcePlan, ID: p.ID, Name: p.Name})
+		}
+	}
+
+	// exception set list items carry no exceptions, so each set is read for its analytics
+	exceptionSets, _, err := c.ExceptionSet.ListExceptionSets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exception sets: %w", err)
+	}
+	for _, item := range exceptionSets {
+		set := ResourceRef{Kind: ResourceExceptionSet, ID: item.UUID, Name: item.Name}
+		g.addNode(set)
+		if !exceptionAnalytics {
+			continue
+		}
+		full, _, err := c.ExceptionSet.GetExceptionSet(ctx, item.UUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get exception set %q: %w", item.Name, err)
+		}
+		if full == nil {
+			continue
+		}
+		for _, e := range full.Exceptions {
+			if input := e.ToInput(); input.AnalyticUuid != "" {
+				var name string
+				if e.Analytic != nil {
+					name = e.Analytic.Name
+				}
+				g.addEdge(ResourceRef{Kind: ResourceAnalytic, ID: input.AnalyticUuid, Name: name}, set)
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// Dependents returns the objects that directly reference the given object, sorted by kind
+// and name
+func (g *DependencyGraph) Dependents(kind ResourceKind, id string) []ResourceRef {
+	var refs []ResourceRef
+	for key := range g.dependents[resourceKey{kind, id}] {
+		refs = append(refs, g.ref(key))
+	}
+	slices.SortFunc(refs, func(a, b ResourceRef) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+	return refs
+}
+
+// Ref returns the reference to an object with its name filled in from the graph
+func (g *DependencyGraph) Ref(kind ResourceKind, id string) ResourceRef {
+	return g.ref(resourceKey{kind, id})
+}
+
+func (g *DependencyGraph) ref(key resourceKey) ResourceRef {
+	return ResourceRef{Kind: key.kind, ID: key.id, Name: g.names[key]}
+}
+
+// addNode records an object's name
+func (g *DependencyGraph) addNode(ref ResourceRef) {
+	key := resourceKey{ref.Kind, ref.ID}
+	if ref.Name != "" || g.names[key] == "" {
+		g.names[key] = ref.Name
+	}
+}
+
+// addEdge records that dependent references dependency
+func (g *DependencyGraph) addEdge(dependency, dependent ResourceRef) {
+	if dependency.ID == "" || dependent.ID == "" {
+		return
+	}
+	g.addNode(dependency)
+	g.addNode(dependent)
+	key := resourceKey{dependency.Kind, dependency.ID}
+	if g.dependents[key] == nil {
+		g.dependents[key] = make(map[resourceKey]bool)
+	}
+	g.dependents[key][resourceKey{dependent.Kind, dependent.ID}] = true
+}
+
+// DependentsError is returned by the SafeDelete methods when other objects still reference
+// the object to delete. It wraps client.ErrInUse.
+type DependentsError struct {
+	Resource   ResourceRef
+	Dependents []ResourceRef
+}
+
+// Error implements the error interface
+func (e *DependentsError) Error() string {
+	names := make([]string, len(e.Dependents))
+	for i, d := range e.Dependents {
+		names[i] = d.String()
+	}
+	return fmt.Sprintf("%s: %s is referenced by %s", client.ErrInUse, e.Resource, strings.Join(names, ", "))
+}
+
+// Unwrap returns client.ErrInUse
+func (e *DependentsError) Unwrap() error {
+	return client.ErrInUse
+}
+
+// SafeDeleteOptions control the SafeDelete methods
+type SafeDeleteOptions struct {
+	// Force detaches the object from the plans that reference it before deleting it.
+	// Objects referenced by anything other than plans are still refused, as are action
+	// configurations, which every plan requires.
+	Force bool
+}
+
+// SafeDeleteOption configures a SafeDelete call
+type SafeDeleteOption func(*SafeDeleteOptions)
+
+// ForceDetach makes a SafeDelete method remove the object from referencing plans first
+func ForceDetach() SafeDeleteOption {
+	return func(o *SafeDeleteOptions) {
+		o.Force = true
+	}
+}
+
+// SafeDeleteActionConfig deletes an action configuration that no plan references
+func (c *Client) SafeDeleteActionConfig(ctx context.Context, id string, opts ...SafeDeleteOption) error {
+	return c.safeDelete(ctx, ResourceActionConfig, id, nil, func(ctx context.Context) error {
+		_, err := c.ActionConfig.DeleteActionConfig(ctx, id)
+		return err
+	}, opts)
+}
+
+// SafeDeleteAnalytic deletes an analytic that no analytic set or exception set references
+func (c *Client) SafeDeleteAnalytic(ctx context.Context, uuid string, opts ...SafeDeleteOption) error {
+	return c.safeDelete(ctx, ResourceAnalytic, uuid, nil, func(ctx context.Context) error {
+		_, err := c.Analytic.DeleteAnalytic(ctx, uuid)
+		return err
+	}, opts)
+}
+
+// SafeDeleteAnalyticSet deletes an analytic set that no plan references, or with ForceDetach
+// removes it from the plans that do first
+func (c *Client) SafeDeleteAnalyticSet(ctx context.Context, uuid string, opts ...SafeDeleteOption) error {
+	detach := func(req *plans.UpdatePlanRequest) {
+		req.AnalyticSets = slices.DeleteFunc(req.AnalyticSets, func(set plans.AnalyticSetInput) bool { return set.UUID == uuid })
+	}
+	return c.safeDelete(ctx, ResourceAnalyticSet, uuid, detach, func(ctx context.Context) error {
+		_, err := c.AnalyticSet.DeleteAnalyticSet(ctx, uuid)
+		return err
+	}, opts)
+}
+
+// SafeDeleteExceptionSet deletes an exception set that no plan references, or with
+// ForceDetach removes it from the plans that do first
+func (c *Client) SafeDeleteExceptionSet(ctx context.Context, uuid string, opts ...SafeDeleteOption) error {
+	detach := func(req *plans.UpdatePlanRequest) {
+		req.ExceptionSets = slices.DeleteFunc(req.ExceptionSets, func(id string) bool { return id == uuid })
+	}
+	return c.safeDelete(ctx, ResourceExceptionSet, uuid, detach, func(ctx context.Context) error {
+		_, err := c.ExceptionSet.DeleteExceptionSet(ctx, uuid)
+		return err
+	}, opts)
+}
+
+// SafeDeleteUSBControlSet deletes a USB control set that no plan references, or with
+// ForceDetach removes it from the plans that do first
+func (c *Client) SafeDeleteUSBControlSet(ctx context.Context, id string, opts ...SafeDeleteOption) error {
+	detach := func(req *plans.UpdatePlanRequest) {
+		req.USBControlSet = nil
+		req.USBControlSetNull = true
+	}
+	return c.safeDelete(ctx, ResourceUSBControlSet, id, detach, func(ctx context.Context) error {
+		_, err := c.USBControlSet.DeleteUSBControlSet(ctx, id)
+		return err
+	}, opts)
+}
+
+// SafeDeleteTelemetryV2 deletes a telemetry v2 configuration that no plan references, or
+// with ForceDetach removes it from the plans that do first
+func (c *Client) SafeDeleteTelemetryV2(ctx context.Context, id string, opts ...SafeDeleteOption) error {
+	detach := func(req *plans.UpdatePlanRequest) {
+		req.TelemetryV2 = nil
+		req.TelemetryV2Null = true
+	}
+	return c.safeDelete(ctx, ResourceTelemetryV2, id, detach, func(ctx context.Context) error {
+		_, err := c.TelemetryV2.DeleteTelemetryV2(ctx, id)
+		return err
+	}, opts)
+}
+
+// safeDelete refuses to delete an object that others reference, unless Force is set, every
+// dependent is a plan and detach can remove the reference from a plan update request.
+// Plans are detached one at a time with ModifyPlan, so a failure part-way leaves the plans
+// before it already detached and the object itself in place.
+func (c *Client) safeDelete(ctx context.Context, kind ResourceKind, id string, detach func(*plans.UpdatePlanRequest), del func(context.Context) error, opts []SafeDeleteOption) error {
+	if id == "" {
+		return fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	var options SafeDeleteOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	// only analytics are referenced by exception sets, so only they need each set read
+	graph, err := c.buildDependencyGraph(ctx, kind == ResourceAnalytic)
+	if err != nil {
+		return err
+	}
+
+	dependents := graph.Dependents(kind, id)
+	if len(dependents) > 0 {
+		onlyPlans := !slices.ContainsFunc(dependents, func(d ResourceRef) bool { return d.Kind != ResourcePlan })
+		if !options.Force || detach == nil || !onlyPlans {
+			return &DependentsError{Resource: graph.Ref(kind, id), Dependents: dependents}
+		}
+		for _, plan := range dependents {
+			merge := func(_ *plans.Plan, req *plans.UpdatePlanRequest) error {
+				detach(req)
+				return nil
+			}
+			if _, _, err := c.Plan.ModifyPlan(ctx, plan.ID, merge, 0); err != nil {
+				return fmt.Errorf("failed to detach %s from %s: %w", graph.Ref(kind, id), plan, err)
+			}
+		}
+	}
+
+	return del(ctx)
+}
@@ -0,0 +1,111 @@
+package jamfprotect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/jamfprotecttest"
+	plans "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDependencyGraph(t *testing.T) {
+	_, c, plan := newProductionPlan(t)
+	ctx := context.Background()
+
+	graph, err := c.BuildDependencyGraph(ctx)
+	require.NoError(t, err)
+
+	planRef := jamfprotect.ResourceRef{Kind: jamfprotect.ResourcePlan, ID: plan.ID, Name: "Production"}
+	assert.Equal(t, []jamfprotect.ResourceRef{planRef}, graph.Dependents(jamfprotect.ResourceActionConfig, plan.ActionConfigs.ID))
+	assert.Equal(t, []jamfprotect.ResourceRef{planRef}, graph.Dependents(jamfprotect.ResourceExceptionSet, plan.ExceptionSets[0].UUID))
+	assert.Equal(t, []jamfprotect.ResourceRef{planRef}, graph.Dependents(jamfprotect.ResourceUSBControlSet, plan.USBControlSet.ID))
+	assert.Equal(t, []jamfprotect.ResourceRef{planRef}, graph.Dependents(jamfprotect.ResourceTelemetryV2, plan.TelemetryV2.ID))
+	assert.Equal(t, []jamfprotect.ResourceRef{planRef}, graph.Dependents(jamfprotect.ResourceAnalyticSet, plan.AnalyticSets[1].AnalyticSet.UUID))
+	assert.Empty(t, graph.Dependents(jamfprotect.ResourcePlan, plan.ID))
+
+	analyticUUID := plan.AnalyticSets[0].AnalyticSet.Analytics[0].UUID
+	var names []string
+	for _, d := range graph.Dependents(jamfprotect.ResourceAnalytic, analyticUUID) {
+		names = append(names, d.String())
+	}
+	assert.Equal(t, []string{`analytic set "Jamf Baseline"`, `analytic set "Prod Analytics"`, `exception set "Prod Exceptions"`}, names)
+}
+
+func TestBuildDependencyGraph_LegacyTelemetry(t *testing.T) {
+	_, c, plan := newProductionPlan(t)
+	ctx := context.Background()
+
+	legacyID := "legacy-telemetry"
+	legacy, _, err := c.Plan.CreatePlan(ctx, &plans.CreatePlanRequest{
+		Name:          "Legacy",
+		ActionConfigs: plan.ActionConfigs.ID,
+		Telemetry:     &legacyID,
+		CommsConfig:   plans.CommsConfigInput{FQDN: "protect.example.com", Protocol: plans.ProtocolMQTT},
+	})
+	require.NoError(t, err)
+
+	graph, err := c.BuildDependencyGraph(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []jamfprotect.ResourceRef{{Kind: jamfprotect.ResourcePlan, ID: legacy.ID, Name: "Legacy"}},
+		graph.Dependents(jamfprotect.ResourceTelemetry, legacyID))
+}
+
+func TestSafeDelete_RefusesWithDependents(t *testing.T) {
+	server, c, plan := newProductionPlan(t)
+	ctx := context.Background()
+
+	err := c.SafeDeleteExceptionSet(ctx, plan.ExceptionSets[0].UUID)
+	require.ErrorIs(t, err, client.ErrInUse)
+	var inUse *jamfprotect.DependentsError
+	require.ErrorAs(t, err, &inUse)
+	assert.Equal(t, "Prod Exceptions", inUse.Resource.Name)
+	require.Len(t, inUse.Dependents, 1)
+	assert.Equal(t, plan.ID, inUse.Dependents[0].ID)
+	assert.Contains(t, err.Error(), `referenced by plan "Production"`)
+	assert.Equal(t, 1, server.Len(jamfprotecttest.KindExceptionSet))
+	assert.Equal(t, 0, server.OperationCount("getExceptionSet"), "exception sets are only read when deleting an analytic")
+
+	err = c.SafeDeleteActionConfig(ctx, plan.ActionConfigs.ID, jamfprotect.ForceDetach())
+	assert.ErrorIs(t, err, client.ErrInUse, "plans cannot be detached from their action configuration")
+
+	err = c.SafeDeleteAnalytic(ctx, plan.AnalyticSets[0].AnalyticSet.Analytics[0].UUID, jamfprotect.ForceDetach())
+	assert.ErrorIs(t, err, client.ErrInUse, "force only detaches from plans")
+	assert.Contains(t, err.Error(), `exception set "Prod Exceptions"`)
+	assert.Equal(t, 0, server.OperationCount("updatePlan"))
+}
+
+func TestSafeDelete_ForceDetachesFromPlans(t *testing.T) {
+	server, c, plan := newProductionPlan(t)
+	ctx := context.Background()
+
+	reads := server.OperationCount("getPlan")
+	require.NoError(t, c.SafeDeleteExceptionSet(ctx, plan.ExceptionSets[0].UUID, jamfprotect.ForceDetach()))
+	assert.Equal(t, reads+2, server.OperationCount("getPlan"), "the plan is read again to check its hash before the update")
+	require.NoError(t, c.SafeDeleteAnalyticSet(ctx, plan.AnalyticSets[0].AnalyticSet.UUID, jamfprotect.ForceDetach()))
+	require.NoError(t, c.SafeDeleteUSBControlSet(ctx, plan.USBControlSet.ID, jamfprotect.ForceDetach()))
+	require.NoError(t, c.SafeDeleteTelemetryV2(ctx, plan.TelemetryV2.ID, jamfprotect.ForceDetach()))
+
+	got, _, err := c.Plan.GetPlan(ctx, plan.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.ExceptionSets)
+	require.Len(t, got.AnalyticSets, 1)
+	assert.Equal(t, "Jamf Baseline", got.AnalyticSets[0].AnalyticSet.Name)
+	assert.Nil(t, got.USBControlSet)
+	assert.Nil(t, got.TelemetryV2)
+	assert.Equal(t, plan.CommsConfig, got.CommsConfig)
+
+	assert.Equal(t, 0, server.Len(jamfprotecttest.KindExceptionSet))
+	assert.Equal(t, 0, server.Len(jamfprotecttest.KindUSBControlSet))
+	assert.Equal(t, 0, server.Len(jamfprotecttest.KindTelemetryV2))
+
+	// unreferenced objects are deleted without detaching anything
+	updates := server.OperationCount("updatePlan")
+	_, err = c.Plan.DeletePlan(ctx, plan.ID)
+	require.NoError(t, err)
+	require.NoError(t, c.SafeDeleteActionConfig(ctx, plan.ActionConfigs.ID))
+	assert.Equal(t, updates, server.OperationCount("updatePlan"))
+}
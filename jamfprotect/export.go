@@ -0,0 +1,458 @@
+package jamfprotect
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	actionconfigs "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	analytics "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic"
+	analyticsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic_set"
+	preventlists "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/custom_prevent_list"
+	exceptionsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/exception_set"
+	plans "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+	usbcontrolsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/removable_storage_control_set"
+	telemetryv2 "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	unifiedloggingfilters "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/unified_logging_filter"
+)
+
+// ExportOptions configures ExportTenant
+type ExportOptions struct {
+	// IncludeManaged exports Jamf-managed analytics, analytic sets and exception sets as
+	// well, marked as managed. By default they are left out and only referenced by name.
+	IncludeManaged bool
+
+	// IncludeSecrets exports action configuration secrets, such as HTTP header values, as
+	// they are. By default they are redacted.
+	IncludeSecrets bool
+}
+
+// ExportOption configures ExportTenant
+type ExportOption func(*ExportOptions)
+
+// ExportIncludeManaged exports Jamf-managed objects along with the tenant's own
+func ExportIncludeManaged() ExportOption {
+	return func(o *ExportOptions) {
+		o.IncludeManaged = true
+	}
+}
+
+// ExportIncludeSecrets exports action configuration secrets instead of redacting them. The
+// bundle must then be stored as carefully as the credentials it holds.
+func ExportIncludeSecrets() ExportOption {
+	return func(o *ExportOptions) {
+		o.IncludeSecrets = true
+	}
+}
+
+// ExportTenant reads the tenant's configuration into a Bundle: its custom analytics,
+// analytic sets, exception sets, action configurations, telemetry v1 and v2
+// configurations, USB control sets, prevent lists, unified logging filters and plans.
+// References between objects become names, so every kind must have unique names; a
+// duplicate fails the export with client.ErrAmbiguousName.
+//
+// Example:
+//
+//	bundle, err := c.ExportTenant(ctx)
+//	err = bundle.WriteDir("tenant-backup", jamfprotect.BundleFormatYAML)
+func (c *Client) ExportTenant(ctx context.Context, opts ...ExportOption) (*Bundle, error) {
+	var options ExportOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+	b := &Bundle{Version: BundleVersion}
+
+	analyticList, _, err := c.Analytic.ListAnalytics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list analytics: %w", err)
+	}
+	analyticNames := make(map[string]string, len(analyticList))
+	for _, a := range analyticList {
+		analyticNames[a.UUID] = a.Name
+		if a.Jamf && !options.IncludeManaged {
+			continue
+		}
+		b.Analytics = append(b.Analytics, analyticDocument(&a))
+	}
+
+	analyticSets, _, err := c.AnalyticSet.ListAnalyticSets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list analytic sets: %w", err)
+	}
+	for _, s := range analyticSets {
+		if s.Managed && !options.IncludeManaged {
+			continue
+		}
+		doc, err := analyticSetDocument(&s, analyticNames)
+		if err != nil {
+			return nil, fmt.Errorf("analytic set %q: %w", s.Name, err)
+		}
+		b.AnalyticSets = append(b.AnalyticSets, doc)
+	}
+
+	exceptionSets, _, err := c.ExceptionSet.ListExceptionSets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exception sets: %w", err)
+	}
+	for _, item := range exceptionSets {
+		if item.Managed && !options.IncludeManaged {
+			continue
+		}
+		set, _, err := c.ExceptionSet.GetExceptionSet(ctx, item.UUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get exception set %q: %w", item.Name, err)
+		}
+		if set == nil {
+			continue
+		}
+		doc, err := exceptionSetDocument(set, analyticNames)
+		if err != nil {
+			return nil, fmt.Errorf("exception set %q: %w", set.Name, err)
+		}
+		b.ExceptionSets = append(b.ExceptionSets, doc)
+	}
+
+	actionConfigs, _, err := c.ActionConfig.ListActionConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list action configurations: %w", err)
+	}
+	for _, item := range actionConfigs {
+		config, _, err := c.ActionConfig.GetActionConfig(ctx, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get action configuration %q: %w", item.Name, err)
+		}
+		if config == nil {
+			continue
+		}
+		doc := actionConfigDocument(config, options.IncludeSecrets)
+		if !options.IncludeSecrets && len(redactedFields(doc)) > 0 {
+			b.Redacted = true
+		}
+		b.ActionConfigs = append(b.ActionConfigs, doc)
+	}
+
+	telemetries, _, err := c.TelemetryV2.ListTelemetriesCombined(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list telemetry configurations: %w", err)
+	}
+	for _, t := range telemetries.Telemetries {
+		b.Telemetries = append(b.Telemetries, TelemetryDocument{
+			Name:               t.Name,
+			Description:        t.Description,
+			Verbose:            t.Verbose,
+			Level:              t.Level,
+			LogFiles:           t.LogFiles,
+			LogFileCollection:  t.LogFileCollection,
+			PerformanceMetrics: t.PerformanceMetrics,
+		})
+	}
+	for _, t := range telemetries.TelemetriesV2 {
+		b.TelemetriesV2 = append(b.TelemetriesV2, telemetryV2Document(&t))
+	}
+
+	usbSets, _, err := c.USBControlSet.ListUSBControlSets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list USB control sets: %w", err)
+	}
+	for _, s := range usbSets {
+		b.USBControlSets = append(b.USBControlSets, usbControlSetDocument(&s))
+	}
+
+	preventLists, _, err := c.PreventList.ListPreventLists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prevent lists: %w", err)
+	}
+	for _, l := range preventLists {
+		b.PreventLists = append(b.PreventLists, preventListDocument(&l))
+	}
+
+	filters, _, err := c.UnifiedLoggingFilter.ListUnifiedLoggingFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unified logging filters: %w", err)
+	}
+	for _, f := range filters {
+		b.UnifiedLoggingFilters = append(b.UnifiedLoggingFilters, unifiedLoggingFilterDocument(&f))
+	}
+
+	planList, _, err := c.Plan.ListPlans(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	for _, p := range planList {
+		doc, err := planDocument(&p)
+		if err != nil {
+			return nil, fmt.Errorf("plan %q: %w", p.Name, err)
+		}
+		b.Plans = append(b.Plans, doc)
+	}
+
+	b.normalize()
+	if err := b.checkUniqueNames(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// checkUniqueNames fails when two objects of one kind share a name, since references to
+// them could not be told apart. Lists must be sorted.
+func (b *Bundle) checkUniqueNames() error {
+	for _, s := range bundleSections {
+		names := s.names(b)
+		for i := 1; i < len(names); i++ {
+			if names[i] == names[i-1] {
+				return fmt.Errorf("%w: more than one %s is named %q", client.ErrAmbiguousName, s.kind, names[i])
+			}
+		}
+	}
+	return nil
+}
+
+// refName returns the name of a referenced object, preferring the name known for its ID
+// over the one carried by the reference
+func refName(kind ResourceKind, names map[string]string, id, name string) (string, error) {
+	if known := names[id]; known != "" {
+		return known, nil
+	}
+	if name != "" {
+		return name, nil
+	}
+	return "", fmt.Errorf("%w: %s %s has no name", client.ErrNotFound, kind, id)
+}
+
+func analyticDocument(a *analytics.Analytic) AnalyticDocument {
+	doc := AnalyticDocument{
+		Name:          a.Name,
+		Managed:       a.Jamf,
+		InputType:     a.InputType,
+		Description:   a.Description,
+		Filter:        a.Filter,
+		Severity:      a.Severity,
+		Level:         a.Level,
+		Actions:       a.Actions,
+		Tags:          a.Tags,
+		Categories:    a.Categories,
+		SnapshotFiles: a.SnapshotFiles,
+	}
+	for _, action := range a.AnalyticActions {
+		doc.AnalyticActions = append(doc.AnalyticActions, AnalyticActionDocument{Name: action.Name, Parameters: action.Parameters})
+	}
+	for _, ctx := range a.Context {
+		doc.Context = append(doc.Context, AnalyticContextDocument{Name: ctx.Name, Type: ctx.Type, Exprs: ctx.Exprs})
+	}
+	return doc
+}
+
+func analyticSetDocument(s *analyticsets.AnalyticSet, analyticNames map[string]string) (AnalyticSetDocument, error) {
+	doc := AnalyticSetDocument{
+		Name:        s.Name,
+		Managed:     s.Managed,
+		Description: s.Description,
+		Types:       s.Types,
+		Analytics:   make([]string, 0, len(s.Analytics)),
+	}
+	for _, a := range s.Analytics {
+		name, err := refName(ResourceAnalytic, analyticNames, a.UUID, a.Name)
+		if err != nil {
+			return doc, err
+		}
+		doc.Analytics = append(doc.Analytics, name)
+	}
+	slices.Sort(doc.Analytics)
+	return doc, nil
+}
+
+func exceptionSetDocument(set *exceptionsets.ExceptionSet, analyticNames map[string]string) (ExceptionSetDocument, error) {
+	doc := ExceptionSetDocument{
+		Name:        set.Name,
+		Managed:     set.Managed,
+		Description: set.Description,
+	}
+	for _, e := range set.Exceptions {
+		exception := ExceptionDocument{
+			Type:           e.Type,
+			Value:          e.Value,
+			AppSigningInfo: appSigningInfoDocument(e.AppSigningInfo),
+			IgnoreActivity: e.IgnoreActivity,
+			AnalyticTypes:  e.AnalyticTypes,
+		}
+		if id := e.ToInput().AnalyticUuid; id != "" {
+			var name string
+			if e.Analytic != nil {
+				name = e.Analytic.Name
+			}
+			name, err := refName(ResourceAnalytic, analyticNames, id, name)
+			if err != nil {
+				return doc, err
+			}
+			exception.Analytic = name
+		}
+		doc.Exceptions = append(doc.Exceptions, exception)
+	}
+	for _, e := range set.EsExceptions {
+		doc.EsExceptions = append(doc.EsExceptions, EsExceptionDocument{
+			Type:              e.Type,
+			Value:             e.Value,
+			AppSigningInfo:    appSigningInfoDocument(e.AppSigningInfo),
+			IgnoreActivity:    e.IgnoreActivity,
+			IgnoreListType:    e.IgnoreListType,
+			IgnoreListSubType: e.IgnoreListSubType,
+			EventType:         e.EventType,
+		})
+	}
+	return doc, nil
+}
+
+func appSigningInfoDocument(info *exceptionsets.AppSigningInfo) *AppSigningInfoDocument {
+	if info == nil {
+		return nil
+	}
+	return &AppSigningInfoDocument{AppID: info.AppId, TeamID: info.TeamId}
+}
+
+func actionConfigDocument(config *actionconfigs.ActionConfig, includeSecrets bool) ActionConfigDocument {
+	req := config.ToCreateRequest()
+	doc := ActionConfigDocument{
+		Name:        req.Name,
+		Description: req.Description,
+		AlertConfig: req.AlertConfig,
+	}
+	if len(req.Clients) > 0 {
+		doc.Clients = req.Clients
+	}
+	if !includeSecrets {
+		for i, c := range doc.Clients {
+			doc.Clients[i], _ = client.RedactSecrets(c).(map[string]any)
+		}
+	}
+	return doc
+}
+
+func telemetryV2Document(t *telemetryv2.TelemetryV2) TelemetryV2Document {
+	logFiles := t.LogFiles
+	if logFiles == nil {
+		logFiles = []string{}
+	}
+	return TelemetryV2Document{
+		Name:               t.Name,
+		Description:        t.Description,
+		LogFiles:           logFiles,
+		LogFileCollection:  t.LogFileCollection,
+		PerformanceMetrics: t.PerformanceMetrics,
+		Events:             t.Events,
+		FileHashing:        t.FileHashing,
+	}
+}
+
+func usbControlSetDocument(s *usbcontrolsets.USBControlSet) USBControlSetDocument {
+	doc := USBControlSetDocument{
+		Name:                 s.Name,
+		Description:          s.Description,
+		DefaultMountAction:   s.DefaultMountAction,
+		DefaultMessageAction: s.DefaultMessageAction,
+		Rules:                make([]USBControlRuleDocument, 0, len(s.Rules)),
+	}
+	for _, r := range s.Rules {
+		rule := USBControlRuleDocument{
+			Type:          r.Type,
+			MountAction:   r.MountAction,
+			MessageAction: r.MessageAction,
+			ApplyTo:       r.ApplyTo,
+			Vendors:       r.Vendors,
+			Serials:       r.Serials,
+		}
+		for _, p := range r.Products {
+			rule.Products = append(rule.Products, USBControlProductDocument{Vendor: p.Vendor, Product: p.Product})
+		}
+		doc.Rules = append(doc.Rules, rule)
+	}
+	return doc
+}
+
+func preventListDocument(l *preventlists.PreventList) PreventListDocument {
+	list := l.List
+	if list == nil {
+		list = []string{}
+	}
+	return PreventListDocument{
+		Name:        l.Name,
+		Description: l.Description,
+		Type:        l.Type,
+		Tags:        l.Tags,
+		List:        list,
+	}
+}
+
+func unifiedLoggingFilterDocument(f *unifiedloggingfilters.UnifiedLoggingFilter) UnifiedLoggingFilterDocument {
+	return UnifiedLoggingFilterDocument{
+		Name:        f.Name,
+		Description: f.Description,
+		Filter:      f.Filter,
+		Tags:        f.Tags,
+		Enabled:     f.Enabled,
+	}
+}
+
+// planDocument converts a plan, whose references carry the referenced objects' names
+func planDocument(p *plans.Plan) (PlanDocument, error) {
+	doc := PlanDocument{
+		Name:        p.Name,
+		Description: p.Description,
+		LogLevel:    p.LogLevel,
+		AutoUpdate:  p.AutoUpdate,
+	}
+	refs := []struct {
+		kind ResourceKind
+		ref  *plans.PlanRef
+		name *string
+	}{
+		{ResourceActionConfig, p.ActionConfigs, &doc.ActionConfig},
+		{ResourceUSBControlSet, p.USBControlSet, &doc.USBControlSet},
+		{ResourceTelemetry, p.Telemetry, &doc.Telemetry},
+		{ResourceTelemetryV2, p.TelemetryV2, &doc.TelemetryV2},
+	}
+	for _, r := range refs {
+		if r.ref == nil || r.ref.ID == "" && r.ref.Name == "" {
+			continue
+		}
+		name, err := refName(r.kind, nil, r.ref.ID, r.ref.Name)
+		if err != nil {
+			return doc, err
+		}
+		*r.name = name
+	}
+
+	for _, set := range p.ExceptionSets {
+		name, err := refName(ResourceExceptionSet, nil, set.UUID, set.Name)
+		if err != nil {
+			return doc, err
+		}
+		doc.ExceptionSets = append(doc.ExceptionSets, name)
+	}
+	slices.Sort(doc.ExceptionSets)
+
+	for _, set := range p.AnalyticSets {
+		name, err := refName(ResourceAnalyticSet, nil, set.AnalyticSet.UUID, set.AnalyticSet.Name)
+		if err != nil {
+			return doc, err
+		}
+		doc.AnalyticSets = append(doc.AnalyticSets, PlanAnalyticSetDocument{Name: name, Type: set.Type})
+	}
+	slices.SortFunc(doc.AnalyticSets, func(a, b PlanAnalyticSetDocument) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Type, b.Type))
+	})
+
+	if p.CommsConfig != nil {
+		doc.CommsConfig = &CommsConfigDocument{FQDN: p.CommsConfig.FQDN, Protocol: p.CommsConfig.Protocol}
+	}
+	if p.InfoSync != nil {
+		doc.InfoSync = &InfoSyncDocument{Attrs: p.InfoSync.Attrs, InsightsSyncInterval: p.InfoSync.InsightsSyncInterval}
+	}
+	if p.SignaturesFeedConfig != nil && p.SignaturesFeedConfig.Mode != "" {
+		doc.SignaturesFeedConfig = &SignaturesFeedConfigDocument{Mode: p.SignaturesFeedConfig.Mode}
+	}
+	return doc, nil
+}
@@ -0,0 +1,205 @@
+package jamfprotect_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	actionconfigs "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	preventlists "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/custom_prevent_list"
+	unifiedloggingfilters "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/unified_logging_filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProductionTenant seeds newProductionPlan's tenant with a prevent list and a unified
+// logging filter as well
+func newProductionTenant(t *testing.T) *jamfprotect.Client {
+	t.Helper()
+	_, c, _ := newProductionPlan(t)
+	ctx := context.Background()
+
+	_, _, err := c.PreventList.CreatePreventList(ctx, &preventlists.CreatePreventListRequest{
+		Name: "Blocked Teams",
+		Type: preventlists.PreventTypeTEAMID,
+		Tags: []string{"prod"},
+		List: []string{"ABCDE12345"},
+	})
+	require.NoError(t, err)
+	_, _, err = c.UnifiedLoggingFilter.CreateUnifiedLoggingFilter(ctx, &unifiedloggingfilters.CreateUnifiedLoggingFilterRequest{
+		Name:    "SSH Logins",
+		Filter:  `process == "sshd"`,
+		Tags:    []string{"prod"},
+		Enabled: true,
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func TestExportTenant(t *testing.T) {
+	c := newProductionTenant(t)
+
+	bundle, err := c.ExportTenant(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, jamfprotect.BundleVersion, bundle.Version)
+	assert.False(t, bundle.Redacted, "nothing to redact")
+	require.Len(t, bundle.Plans, 1)
+	assert.Equal(t, jamfprotect.PlanDocument{
+		Name:          "Production",
+		AutoUpdate:    true,
+		ActionConfig:  "Prod Actions",
+		ExceptionSets: []string{"Prod Exceptions"},
+		AnalyticSets: []jamfprotect.PlanAnalyticSetDocument{
+			{Name: "Jamf Baseline", Type: "Report"},
+			{Name: "Prod Analytics", Type: "Report"},
+		},
+		USBControlSet: "Prod USB",
+		TelemetryV2:   "Prod Telemetry",
+		CommsConfig:   &jamfprotect.CommsConfigDocument{FQDN: "protect.example.com", Protocol: "mqtt"},
+		InfoSync:      &jamfprotect.InfoSyncDocument{Attrs: []string{"hostName"}, InsightsSyncInterval: 3600},
+	}, bundle.Plans[0])
+
+	require.Len(t, bundle.AnalyticSets, 1, "Jamf-managed sets are only referenced")
+	assert.Equal(t, []string{"Analytic"}, bundle.AnalyticSets[0].Analytics)
+	require.Len(t, bundle.ExceptionSets, 1)
+	assert.Equal(t, "Analytic", bundle.ExceptionSets[0].Exceptions[0].Analytic)
+	require.Len(t, bundle.USBControlSets, 1)
+	assert.Equal(t, []string{"0x1234"}, bundle.USBControlSets[0].Rules[0].Vendors)
+	assert.Len(t, bundle.Analytics, 1)
+	assert.Len(t, bundle.ActionConfigs, 1)
+	assert.Len(t, bundle.TelemetriesV2, 1)
+	assert.Len(t, bundle.PreventLists, 1)
+	assert.Len(t, bundle.UnifiedLoggingFilters, 1)
+
+	managed, err := c.ExportTenant(context.Background(), jamfprotect.ExportIncludeManaged())
+	require.NoError(t, err)
+	require.Len(t, managed.AnalyticSets, 2)
+	assert.True(t, managed.AnalyticSets[0].Managed)
+}
+
+func TestExportTenant_RedactsActionConfigSecrets(t *testing.T) {
+	_, c, _ := newEmptyTenant(t)
+	ctx := context.Background()
+	_, _, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "SIEM",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+		Clients: []map[string]any{{
+			"type":             "Http",
+			"supportedReports": []string{"AlertReport"},
+			"params": map[string]any{
+				"url":     "https://siem.example.com",
+				"method":  "POST",
+				"headers": []any{map[string]any{"header": "X-Api-Key", "value": "secret"}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	headers := func(b *jamfprotect.Bundle) any {
+		require.Len(t, b.ActionConfigs, 1)
+		require.Len(t, b.ActionConfigs[0].Clients, 1)
+		params := b.ActionConfigs[0].Clients[0]["params"].(map[string]any)
+		assert.Equal(t, "https://siem.example.com", params["url"])
+		return params["headers"]
+	}
+
+	bundle, err := c.ExportTenant(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"header": "X-Api-Key", "value": client.RedactedValue}}, headers(bundle))
+	assert.True(t, bundle.Redacted)
+
+	dir := t.TempDir()
+	require.NoError(t, bundle.WriteDir(dir, jamfprotect.BundleFormatYAML))
+	manifest, err := os.ReadFile(filepath.Join(dir, "bundle.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "version: 1\nredacted: true\n", string(manifest))
+	read, err := jamfprotect.ReadBundleDir(dir)
+	require.NoError(t, err)
+	assert.True(t, read.Redacted)
+
+	bundle, err = c.ExportTenant(ctx, jamfprotect.ExportIncludeSecrets())
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"header": "X-Api-Key", "value": "secret"}}, headers(bundle))
+	assert.False(t, bundle.Redacted)
+}
+
+func TestBundle_FilesAreDeterministic(t *testing.T) {
+	c := newProductionTenant(t)
+	ctx := context.Background()
+
+	first, err := c.ExportTenant(ctx)
+	require.NoError(t, err)
+	second, err := c.ExportTenant(ctx)
+	require.NoError(t, err)
+
+	for _, format := range []jamfprotect.BundleFormat{jamfprotect.BundleFormatYAML, jamfprotect.BundleFormatJSON} {
+		files, err := first.Files(format)
+		require.NoError(t, err)
+		again, err := second.Files(format)
+		require.NoError(t, err)
+		assert.Equal(t, files, again)
+
+		ext := "." + string(format)
+		assert.Contains(t, files, "bundle"+ext)
+		assert.Contains(t, files, "plans/production"+ext)
+		assert.Contains(t, files, "analytic-sets/prod-analytics"+ext)
+		assert.Contains(t, files, "unified-logging-filters/ssh-logins"+ext)
+		assert.Len(t, files, 10)
+		for path, data := range files {
+			for _, field := range []string{"created", "updated", "hash", "uuid"} {
+				assert.NotContains(t, string(data), `"`+field+`"`, path)
+				assert.NotContains(t, string(data), field+":", path)
+			}
+		}
+	}
+}
+
+func TestBundle_WriteDirAndRead(t *testing.T) {
+	c := newProductionTenant(t)
+	bundle, err := c.ExportTenant(context.Background())
+	require.NoError(t, err)
+
+	for _, format := range []jamfprotect.BundleFormat{jamfprotect.BundleFormatYAML, jamfprotect.BundleFormatJSON} {
+		dir := t.TempDir()
+		stale := filepath.Join(dir, "plans", "retired."+string(format))
+		require.NoError(t, os.MkdirAll(filepath.Dir(stale), 0o755))
+		require.NoError(t, os.WriteFile(stale, []byte("name: Retired\n"), 0o644))
+
+		require.NoError(t, bundle.WriteDir(dir, format))
+		assert.NoFileExists(t, stale, "objects gone from the tenant are removed")
+		info, err := os.Stat(filepath.Join(dir, "plans"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+		info, err = os.Stat(filepath.Join(dir, "plans", "production."+string(format)))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm(), "bundles may hold secrets")
+
+		read, err := jamfprotect.ReadBundleDir(dir)
+		require.NoError(t, err)
+		want, err := bundle.Files(format)
+		require.NoError(t, err)
+		got, err := read.Files(format)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestReadBundleDir_Rejects(t *testing.T) {
+	dir := t.TempDir()
+	_, err := jamfprotect.ReadBundleDir(dir)
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bundle.yaml"), []byte("version: 99\n"), 0o644))
+	_, err = jamfprotect.ReadBundleDir(dir)
+	assert.ErrorContains(t, err, "unsupported bundle version 99")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bundle.yaml"), []byte("version: 1\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "plans"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plans", "p.yaml"), []byte("name: P\nactionConfg: X\n"), 0o644))
+	_, err = jamfprotect.ReadBundleDir(dir)
+	assert.ErrorIs(t, err, client.ErrInvalidInput, "misspelled keys are rejected")
+}
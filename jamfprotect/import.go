@@ -0,0 +1,773 @@
+package jamfprotect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+	actionconfigs "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	analytics "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic"
+	analyticsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic_set"
+	preventlists "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/custom_prevent_list"
+	exceptionsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/exception_set"
+	plans "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+	usbcontrolsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/removable_storage_control_set"
+	telemetryv2 "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	unifiedloggingfilters "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/unified_logging_filter"
+)
+
+// ImportMode selects what ImportBundle does with objects that already exist in the tenant
+type ImportMode string
+
+const (
+	// ImportCreateOnly creates the objects the tenant lacks and leaves existing objects of
+	// the same name untouched
+	ImportCreateOnly ImportMode = "create-only"
+
+	// ImportUpsert creates missing objects and updates existing ones to match the bundle
+	ImportUpsert ImportMode = "upsert"
+
+	// ImportMirror upserts like ImportUpsert, then deletes the tenant's own objects that the
+	// bundle does not contain, so the tenant ends up matching the bundle exactly.
+	// Jamf-managed objects and telemetry v1 configurations are never deleted. Nothing is
+	// deleted when any object fails to import, as the objects it would have replaced may
+	// still be in use.
+	ImportMirror ImportMode = "mirror"
+)
+
+// ImportOptions configures ImportBundle
+type ImportOptions struct {
+	// Mode defaults to ImportUpsert
+	Mode ImportMode
+}
+
+// ImportOption configures ImportBundle
+type ImportOption func(*ImportOptions)
+
+// WithImportMode sets how ImportBundle treats existing objects
+func WithImportMode(mode ImportMode) ImportOption {
+	return func(o *ImportOptions) {
+		o.Mode = mode
+	}
+}
+
+// ImportAction reports what ImportBundle did with one object
+type ImportAction string
+
+const (
+	ImportCreated   ImportAction = "created"
+	ImportUpdated   ImportAction = "updated"
+	ImportUnchanged ImportAction = "unchanged"
+	ImportSkipped   ImportAction = "skipped"
+	ImportDeleted   ImportAction = "deleted"
+	ImportFailed    ImportAction = "failed"
+)
+
+// ImportResult is the outcome of importing, skipping or deleting one object. Resource.ID is
+// the object's ID in the destination tenant, if it has one.
+type ImportResult struct {
+	Resource ResourceRef
+	Action   ImportAction
+
+	// Reason explains a skipped object
+	Reason string
+
+	// Err is set when Action is ImportFailed
+	Err error
+}
+
+// ImportReport lists the outcome for every object in a bundle, in the order they were
+// processed, followed by any deletions made in ImportMirror mode
+type ImportReport struct {
+	Results []ImportResult
+}
+
+// IDs maps the names of the bundle's objects of one kind to their IDs in the destination
+// tenant, for every object that was created, updated, left unchanged or skipped because
+// it already existed
+func (r *ImportReport) IDs(kind ResourceKind) map[string]string {
+	ids := make(map[string]string)
+	for _, result := range r.Results {
+		if result.Resource.Kind == kind && result.Resource.ID != "" && result.Action != ImportDeleted && result.Action != ImportFailed {
+			ids[result.Resource.Name] = result.Resource.ID
+		}
+	}
+	return ids
+}
+
+// Failed returns the results of the objects that could not be imported or deleted
+func (r *ImportReport) Failed() []ImportResult {
+	var failed []ImportResult
+	for _, result := range r.Results {
+		if result.Action == ImportFailed {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Count returns the number of results with the given action
+func (r *ImportReport) Count(action ImportAction) int {
+	n := 0
+	for _, result := range r.Results {
+		if result.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// Err joins the errors of the failed results, or returns nil when nothing failed
+func (r *ImportReport) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s: %w", result.Resource, result.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// ImportBundle restores a bundle into the tenant. Objects are created or updated in
+// dependency order (analytics, analytic sets, exception sets, action configurations,
+// telemetry, USB control sets, prevent lists, unified logging filters, then plans), and
+// names in references are resolved to the IDs of the objects in this tenant, whether
+// they already existed or were just created. Objects are matched to existing ones by name.
+//
+// A bundle marked Redacted is refused, and an object holding client.RedactedValue in any
+// field fails rather than overwriting the tenant's secret with it.
+//
+// Jamf-managed objects in the bundle are skipped and referenced by name; they must
+// already exist in the tenant. Telemetry v1 configurations cannot be created, so they are
+// only resolved. An object that fails, including one whose references cannot be
+// resolved, is recorded in the report and the import continues; the returned error
+// joins all failures. The report is returned even when err is non-nil.
+//
+// Example:
+//
+//	bundle, err := jamfprotect.ReadBundleDir("tenant-backup")
+//	report, err := c.ImportBundle(ctx, bundle, jamfprotect.WithImportMode(jamfprotect.ImportCreateOnly))
+//	planIDs := report.IDs(jamfprotect.ResourcePlan)
+func (c *Client) ImportBundle(ctx context.Context, b *Bundle, opts ...ImportOption) (*ImportReport, error) {
+	options := ImportOptions{Mode: ImportUpsert}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+	switch options.Mode {
+	case ImportCreateOnly, ImportUpsert, ImportMirror:
+	default:
+		return nil, fmt.Errorf("%w: unknown import mode %q", client.ErrInvalidInput, options.Mode)
+	}
+	if b == nil {
+		return nil, fmt.Errorf("%w: bundle cannot be nil", client.ErrInvalidInput)
+	}
+	if b.Version > BundleVersion {
+		return nil, fmt.Errorf("%w: unsupported bundle version %d (supported: 1 to %d)",
+			client.ErrInvalidInput, b.Version, BundleVersion)
+	}
+	if b.Redacted {
+		return nil, fmt.Errorf("%w: the bundle's secrets were redacted on export; fill them in and clear its redacted flag",
+			client.ErrInvalidInput)
+	}
+
+	im := &importer{c: c, mode: options.Mode, report: &ImportReport{}}
+	if err := im.index(ctx); err != nil {
+		return nil, err
+	}
+
+	importDocs(ctx, im, ResourceAnalytic, b.Analytics, func(d AnalyticDocument) (string, bool) { return d.Name, d.Managed },
+		func(ctx context.Context, d AnalyticDocument, opts []client.UpsertOption) (string, client.UpsertAction, error) {
+			a, action, _, err := c.Analytic.UpsertAnalytic(ctx, analyticRequest(d), opts...)
+			if err != nil {
+				return "", "", err
+			}
+			return a.UUID, action, nil
+		})
+
+	importDocs(ctx, im, ResourceAnalyticSet, b.AnalyticSets, func(d AnalyticSetDocument) (string, bool) { return d.Name, d.Managed },
+		func(ctx context.Context, d AnalyticSetDocument, opts []client.UpsertOption) (string, client.UpsertAction, error) {
+			req, err := im.analyticSetRequest(d)
+			if err != nil {
+				return "", "", err
+			}
+			s, action, _, err := c.AnalyticSet.UpsertAnalyticSet(ctx, req, opts...)
+			if err != nil {
+				return "", "", err
+			}
+			return s.UUID, action, nil
+		})
+
+	importDocs(ctx, im, ResourceExceptionSet, b.ExceptionSets, func(d ExceptionSetDocument) (string, bool) { return d.Name, d.Managed },
+		func(ctx context.Context, d ExceptionSetDocument, opts []client.UpsertOption) (string, client.UpsertAction, error) {
+			req, err := im.exceptionSetRequest(d)
+			if err != nil {
+				return "", "", err
+			}
+			s, action, _, err := c.ExceptionSet.UpsertExceptionSet(ctx, req, opts...)
+			if err != nil {
+				return "", "", err
+			}
+			return s.UUID, action, nil
+		})
+
+	importDocs(ctx, im, ResourceActionConfig, b.ActionConfigs, func(d ActionConfigDocument) (string, bool) { return d.Name, false },
+		func(ctx context.Context, d ActionConfigDocument, opts []client.UpsertOption) (string, client.UpsertAction, error) {
+			config, action, _, err := c.ActionConfig.UpsertActionConfig(ctx, actionConfigRequest(d), opts...)
+			if err != nil {
+				return "", "", err
+			}
+			return config.ID, action, nil
+		})
+
+	for _, d := range b.Telemetries {
+		im.resolveOnly(ResourceTelemetry, d.Name, "telemetry v1 configurations cannot be created")
+	}
+
+	importDocs(ctx, im, ResourceTelemetryV2, b.TelemetriesV2, func(d TelemetryV2Document) (string, bool) { return d.Name, false },
+		func(ctx context.Context, d TelemetryV2Document, opts []client.UpsertOption) (string, client.UpsertAction, error) {
+			t, action, _, err := c.TelemetryV2.UpsertTelemetryV2(ctx, telemetryV2Request(d), opts...)
+			if err != nil {
+				return "", "", err
+			}
+			return t.ID, action, nil
+		})
+
+	importDocs(ctx, im, ResourceUSBControlSet, b.USBControlSets, func(d USBControlSetDocument) (string, bool) { return d.Name, false },
+		func(ctx context.Context, d USBControlSetDocument, opts []client.UpsertOption) (string, client.UpsertAction, error) {
+			req, err := usbControlSetRequest(d)
+			if err != nil {
+				return "", "", err
+			}
+			s, action, _, err := c.USBControlSet.UpsertUSBControlSet(ctx, req, opts...)
+			if err != nil {
+				return "", "", err
+			}
+			return s.ID, action, nil
+		})
+
+	importDocs(ctx, im, ResourcePreventList, b.PreventLists, func(d PreventListDocument) (string, bool) { return d.Name, false },
+		func(ctx context.Context, d PreventListDocument, opts []client.UpsertOption) (string, client.UpsertAction, error) {
+			l, action, _, err := c.PreventList.UpsertPreventList(ctx, &preventlists.CreatePreventListRequest{
+				Name:        d.Name,
+				Description: d.Description,
+				Type:        d.Type,
+				Tags:        d.Tags,
+				List:        d.List,
+			}, opts...)
+			if err != nil {
+				return "", "", err
+			}
+			return l.ID, action, nil
+		})
+
+	importDocs(ctx, im, ResourceUnifiedLoggingFilter, b.UnifiedLoggingFilters, func(d UnifiedLoggingFilterDocument) (string, bool) { return d.Name, false },
+		func(ctx context.Context, d UnifiedLoggingFilterDocument, opts []client.UpsertOption) (string, client.UpsertAction, error) {
+			f, action, _, err := c.UnifiedLoggingFilter.UpsertUnifiedLoggingFilter(ctx, &unifiedloggingfilters.CreateUnifiedLoggingFilterRequest{
+				Name:        d.Name,
+				Description: d.Description,
+				Tags:        d.Tags,
+				Filter:      d.Filter,
+				Enabled:     d.Enabled,
+			}, opts...)
+			if err != nil {
+				return "", "", err
+			}
+			return f.UUID, action, nil
+		})
+
+	importDocs(ctx, im, ResourcePlan, b.Plans, func(d PlanDocument) (string, bool) { return d.Name, false },
+		func(ctx context.Context, d PlanDocument, opts []client.UpsertOption) (string, client.UpsertAction, error) {
+			req, err := im.planRequest(d)
+			if err != nil {
+				return "", "", err
+			}
+			p, action, _, err := c.Plan.UpsertPlan(ctx, req, opts...)
+			if err != nil {
+				return "", "", err
+			}
+			return p.ID, action, nil
+		})
+
+	if options.Mode == ImportMirror && len(im.report.Failed()) == 0 {
+		im.prune(ctx, b)
+	}
+	return im.report, im.report.Err()
+}
+
+// tenantObject is an object that existed in the destination tenant before the import
+type tenantObject struct {
+	id      string
+	managed bool
+}
+
+// importer holds the state of one ImportBundle call
+type importer struct {
+	c      *Client
+	mode   ImportMode
+	report *ImportReport
+
+	// existing lists the tenant's objects by kind and name before the import
+	existing map[ResourceKind]map[string][]tenantObject
+
+	// ids maps names to the IDs references resolve to, updated as objects are imported
+	ids map[ResourceKind]map[string]string
+}
+
+// index lists the tenant's objects of every kind
+func (im *importer) index(ctx context.Context) error {
+	im.existing = make(map[ResourceKind]map[string][]tenantObject)
+	im.ids = make(map[ResourceKind]map[string]string)
+	add := func(kind ResourceKind, name, id string, managed bool) {
+		if im.existing[kind] == nil {
+			im.existing[kind] = make(map[string][]tenantObject)
+			im.ids[kind] = make(map[string]string)
+		}
+		im.existing[kind][name] = append(im.existing[kind][name], tenantObject{id: id, managed: managed})
+		if len(im.existing[kind][name]) == 1 {
+			im.ids[kind][name] = id
+		} else {
+			delete(im.ids[kind], name)
+		}
+	}
+	c := im.c
+
+	analyticList, _, err := c.Analytic.ListAnalytics(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list analytics: %w", err)
+	}
+	for _, a := range analyticList {
+		add(ResourceAnalytic, a.Name, a.UUID, a.Jamf)
+	}
+	analyticSets, _, err := c.AnalyticSet.ListAnalyticSets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list analytic sets: %w", err)
+	}
+	for _, s := range analyticSets {
+		add(ResourceAnalyticSet, s.Name, s.UUID, s.Managed)
+	}
+	exceptionSets, _, err := c.ExceptionSet.ListExceptionSets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list exception sets: %w", err)
+	}
+	for _, s := range exceptionSets {
+		add(ResourceExceptionSet, s.Name, s.UUID, s.Managed)
+	}
+	actionConfigs, _, err := c.ActionConfig.ListActionConfigs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list action configurations: %w", err)
+	}
+	for _, a := range actionConfigs {
+		add(ResourceActionConfig, a.Name, a.ID, false)
+	}
+	telemetries, _, err := c.TelemetryV2.ListTelemetriesCombined(ctx, false)
+	if err != nil {
+		return fmt.Errorf("failed to list telemetry configurations: %w", err)
+	}
+	for _, t := range telemetries.Telemetries {
+		add(ResourceTelemetry, t.Name, t.ID, false)
+	}
+	for _, t := range telemetries.TelemetriesV2 {
+		add(ResourceTelemetryV2, t.Name, t.ID, false)
+	}
+	usbSets, _, err := c.USBControlSet.ListUSBControlSets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list USB control sets: %w", err)
+	}
+	for _, s := range usbSets {
+		add(ResourceUSBControlSet, s.Name, s.ID, false)
+	}
+	preventLists, _, err := c.PreventList.ListPreventLists(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list prevent lists: %w", err)
+	}
+	for _, l := range preventLists {
+		add(ResourcePreventList, l.Name, l.ID, false)
+	}
+	filters, _, err := c.UnifiedLoggingFilter.ListUnifiedLoggingFilters(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list unified logging filters: %w", err)
+	}
+	for _, f := range filters {
+		add(ResourceUnifiedLoggingFilter, f.Name, f.UUID, false)
+	}
+	planList, _, err := c.Plan.ListPlans(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list plans: %w", err)
+	}
+	for _, p := range planList {
+		add(ResourcePlan, p.Name, p.ID, false)
+	}
+	return nil
+}
+
+// lookup returns the tenant's object of the given kind and name, if there is exactly one
+func (im *importer) lookup(kind ResourceKind, name string) (tenantObject, bool, error) {
+	matches := im.existing[kind][name]
+	switch len(matches) {
+	case 0:
+		return tenantObject{}, false, nil
+	case 1:
+		return matches[0], true, nil
+	}
+	return tenantObject{}, false, fmt.Errorf("%w: the tenant has %d objects of this kind with this name", client.ErrAmbiguousName, len(matches))
+}
+
+// resolve returns the ID a reference by name resolves to
+func (im *importer) resolve(kind ResourceKind, name string) (string, error) {
+	if id := im.ids[kind][name]; id != "" {
+		return id, nil
+	}
+	if len(im.existing[kind][name]) > 1 {
+		return "", fmt.Errorf("%w: %s %q matches %d objects", client.ErrAmbiguousName, kind, name, len(im.existing[kind][name]))
+	}
+	return "", fmt.Errorf("%w: %s %q", client.ErrNotFound, kind, name)
+}
+
+// record adds a result to the report and remembers the ID of an imported object
+func (im *importer) record(result ImportResult) {
+	if result.Action != ImportFailed && result.Action != ImportDeleted && result.Resource.ID != "" {
+		if im.ids[result.Resource.Kind] == nil {
+			im.ids[result.Resource.Kind] = make(map[string]string)
+		}
+		im.ids[result.Resource.Kind][result.Resource.Name] = result.Resource.ID
+	}
+	im.report.Results = append(im.report.Results, result)
+}
+
+// resolveOnly records an object that is never written, only looked up in the tenant
+func (im *importer) resolveOnly(kind ResourceKind, name, reason string) {
+	ref := ResourceRef{Kind: kind, Name: name}
+	existing, found, err := im.lookup(kind, name)
+	switch {
+	case err != nil:
+		im.record(ImportResult{Resource: ref, Action: ImportFailed, Err: err})
+	case !found:
+		im.record(ImportResult{Resource: ref, Action: ImportFailed, Err: fmt.Errorf("%w: not in the tenant, and %s", client.ErrNotFound, reason)})
+	default:
+		ref.ID = existing.id
+		im.record(ImportResult{Resource: ref, Action: ImportSkipped, Reason: reason})
+	}
+}
+
+// importDocs imports the documents of one kind. nameOf returns a document's name and
+// whether it describes a Jamf-managed object; upsert writes it, keyed on the existing
+// object's ID when there is one.
+func importDocs[T any](ctx context.Context, im *importer, kind ResourceKind, docs []T, nameOf func(T) (string, bool),
+	upsert func(ctx context.Context, doc T, opts []client.UpsertOption) (string, client.UpsertAction, error)) {
+	for _, doc := range docs {
+		name, managed := nameOf(doc)
+		if managed {
+			im.resolveOnly(kind, name, "Jamf-managed objects are not imported")
+			continue
+		}
+
+		ref := ResourceRef{Kind: kind, Name: name}
+		existing, found, err := im.lookup(kind, name)
+		if err != nil {
+			im.record(ImportResult{Resource: ref, Action: ImportFailed, Err: err})
+			continue
+		}
+		var opts []client.UpsertOption
+		if found {
+			ref.ID = existing.id
+			if existing.managed {
+				im.record(ImportResult{Resource: ref, Action: ImportFailed,
+					Err: fmt.Errorf("%w: the name belongs to a Jamf-managed object", client.ErrConflict)})
+				continue
+			}
+			if im.mode == ImportCreateOnly {
+				im.record(ImportResult{Resource: ref, Action: ImportSkipped, Reason: "already exists"})
+				continue
+			}
+			opts = append(opts, client.UpsertByID(existing.id))
+		}
+
+		if fields := redactedFields(doc); len(fields) > 0 {
+			im.record(ImportResult{Resource: ref, Action: ImportFailed, Err: fmt.Errorf("%w: %s must be filled in, not %s",
+				client.ErrInvalidInput, strings.Join(fields, ", "), client.RedactedValue)})
+			continue
+		}
+		id, upserted, err := upsert(ctx, doc, opts)
+		if err != nil {
+			im.record(ImportResult{Resource: ref, Action: ImportFailed, Err: err})
+			continue
+		}
+		ref.ID = id
+		action, err := importAction(upserted)
+		if err != nil {
+			im.record(ImportResult{Resource: ref, Action: ImportFailed, Err: err})
+			continue
+		}
+		im.record(ImportResult{Resource: ref, Action: action})
+	}
+}
+
+// importAction returns the report action for the outcome of an upsert
+func importAction(action client.UpsertAction) (ImportAction, error) {
+	switch action {
+	case client.UpsertCreated:
+		return ImportCreated, nil
+	case client.UpsertUpdated:
+		return ImportUpdated, nil
+	case client.UpsertUnchanged:
+		return ImportUnchanged, nil
+	}
+	return "", fmt.Errorf("unknown upsert action %q", action)
+}
+
+// prune deletes the tenant's own objects that the bundle does not contain, dependents first
+func (im *importer) prune(ctx context.Context, b *Bundle) {
+	inBundle := make(map[ResourceKind]map[string]bool)
+	for _, s := range bundleSections {
+		inBundle[s.kind] = make(map[string]bool)
+		for _, name := range s.names(b) {
+			inBundle[s.kind][name] = true
+		}
+	}
+
+	c := im.c
+	deletes := []struct {
+		kind   ResourceKind
+		delete func(ctx context.Context, id string) (*interfaces.Response, error)
+	}{
+		{ResourcePlan, c.Plan.DeletePlan},
+		{ResourceUnifiedLoggingFilter, c.UnifiedLoggingFilter.DeleteUnifiedLoggingFilter},
+		{ResourcePreventList, c.PreventList.DeletePreventList},
+		{ResourceUSBControlSet, c.USBControlSet.DeleteUSBControlSet},
+		{ResourceTelemetryV2, c.TelemetryV2.DeleteTelemetryV2},
+		{ResourceActionConfig, c.ActionConfig.DeleteActionConfig},
+		{ResourceExceptionSet, c.ExceptionSet.DeleteExceptionSet},
+		{ResourceAnalyticSet, c.AnalyticSet.DeleteAnalyticSet},
+		{ResourceAnalytic, c.Analytic.DeleteAnalytic},
+	}
+	for _, d := range deletes {
+		for _, name := range slices.Sorted(maps.Keys(im.existing[d.kind])) {
+			if inBundle[d.kind][name] {
+				continue
+			}
+			for _, obj := range im.existing[d.kind][name] {
+				if obj.managed {
+					continue
+				}
+				ref := ResourceRef{Kind: d.kind, ID: obj.id, Name: name}
+				if _, err := d.delete(ctx, obj.id); err != nil {
+					im.record(ImportResult{Resource: ref, Action: ImportFailed, Err: fmt.Errorf("failed to delete: %w", err)})
+					continue
+				}
+				im.record(ImportResult{Resource: ref, Action: ImportDeleted})
+			}
+		}
+	}
+}
+
+func analyticRequest(d AnalyticDocument) *analytics.CreateAnalyticRequest {
+	req := &analytics.CreateAnalyticRequest{
+		Name:          d.Name,
+		InputType:     d.InputType,
+		Description:   d.Description,
+		Actions:       d.Actions,
+		Tags:          d.Tags,
+		Categories:    d.Categories,
+		Filter:        d.Filter,
+		Level:         d.Level,
+		Severity:      d.Severity,
+		SnapshotFiles: d.SnapshotFiles,
+	}
+	for _, a := range d.AnalyticActions {
+		req.AnalyticActions = append(req.AnalyticActions, analytics.AnalyticActionInput{Name: a.Name, Parameters: a.Parameters})
+	}
+	for _, c := range d.Context {
+		req.Context = append(req.Context, analytics.AnalyticContextInput{Name: c.Name, Type: c.Type, Exprs: c.Exprs})
+	}
+	return req
+}
+
+func (im *importer) analyticSetRequest(d AnalyticSetDocument) (*analyticsets.CreateAnalyticSetRequest, error) {
+	req := &analyticsets.CreateAnalyticSetRequest{
+		Name:        d.Name,
+		Description: d.Description,
+		Types:       d.Types,
+		Analytics:   make([]string, 0, len(d.Analytics)),
+	}
+	for _, name := range d.Analytics {
+		id, err := im.resolve(ResourceAnalytic, name)
+		if err != nil {
+			return nil, err
+		}
+		req.Analytics = append(req.Analytics, id)
+	}
+	return req, nil
+}
+
+func (im *importer) exceptionSetRequest(d ExceptionSetDocument) (*exceptionsets.CreateExceptionSetRequest, error) {
+	req := &exceptionsets.CreateExceptionSetRequest{
+		Name:        d.Name,
+		Description: d.Description,
+	}
+	for _, e := range d.Exceptions {
+		input := exceptionsets.ExceptionInput{
+			Type:           e.Type,
+			Value:          e.Value,
+			AppSigningInfo: appSigningInfoInput(e.AppSigningInfo),
+			IgnoreActivity: e.IgnoreActivity,
+			AnalyticTypes:  e.AnalyticTypes,
+		}
+		if e.Analytic != "" {
+			id, err := im.resolve(ResourceAnalytic, e.Analytic)
+			if err != nil {
+				return nil, err
+			}
+			input.AnalyticUuid = id
+		}
+		req.Exceptions = append(req.Exceptions, input)
+	}
+	for _, e := range d.EsExceptions {
+		req.EsExceptions = append(req.EsExceptions, exceptionsets.EsExceptionInput{
+			Type:              e.Type,
+			Value:             e.Value,
+			AppSigningInfo:    appSigningInfoInput(e.AppSigningInfo),
+			IgnoreActivity:    e.IgnoreActivity,
+			IgnoreListType:    e.IgnoreListType,
+			IgnoreListSubType: e.IgnoreListSubType,
+			EventType:         e.EventType,
+		})
+	}
+	return req, nil
+}
+
+func appSigningInfoInput(info *AppSigningInfoDocument) *exceptionsets.AppSigningInfoInput {
+	if info == nil {
+		return nil
+	}
+	return &exceptionsets.AppSigningInfoInput{AppId: info.AppID, TeamId: info.TeamID}
+}
+
+func actionConfigRequest(d ActionConfigDocument) *actionconfigs.CreateActionConfigRequest {
+	req := &actionconfigs.CreateActionConfigRequest{
+		Name:        d.Name,
+		Description: d.Description,
+		AlertConfig: d.AlertConfig,
+		Clients:     d.Clients,
+	}
+	if req.AlertConfig == nil {
+		req.AlertConfig = map[string]any{}
+	}
+	if req.Clients == nil {
+		req.Clients = []map[string]any{}
+	}
+	return req
+}
+
+func telemetryV2Request(d TelemetryV2Document) *telemetryv2.CreateTelemetryV2Request {
+	return &telemetryv2.CreateTelemetryV2Request{
+		Name:               d.Name,
+		Description:        d.Description,
+		LogFiles:           d.LogFiles,
+		LogFileCollection:  d.LogFileCollection,
+		PerformanceMetrics: d.PerformanceMetrics,
+		Events:             d.Events,
+		FileHashing:        d.FileHashing,
+	}
+}
+
+func usbControlSetRequest(d USBControlSetDocument) (*usbcontrolsets.CreateUSBControlSetRequest, error) {
+	req := &usbcontrolsets.CreateUSBControlSetRequest{
+		Name:                 d.Name,
+		Description:          d.Description,
+		DefaultMountAction:   d.DefaultMountAction,
+		DefaultMessageAction: d.DefaultMessageAction,
+		Rules:                make([]usbcontrolsets.USBControlRuleInput, 0, len(d.Rules)),
+	}
+	for _, r := range d.Rules {
+		rule := usbcontrolsets.USBControlRule{
+			Type:          r.Type,
+			MountAction:   r.MountAction,
+			MessageAction: r.MessageAction,
+			ApplyTo:       r.ApplyTo,
+			Vendors:       r.Vendors,
+			Serials:       r.Serials,
+		}
+		for _, p := range r.Products {
+			rule.Products = append(rule.Products, usbcontrolsets.USBControlProductPair{Vendor: p.Vendor, Product: p.Product})
+		}
+		input, err := rule.ToInput()
+		if err != nil {
+			return nil, err
+		}
+		req.Rules = append(req.Rules, input)
+	}
+	return req, nil
+}
+
+func (im *importer) planRequest(d PlanDocument) (*plans.CreatePlanRequest, error) {
+	req := &plans.CreatePlanRequest{
+		Name:          d.Name,
+		Description:   d.Description,
+		AutoUpdate:    d.AutoUpdate,
+		ExceptionSets: make([]string, 0, len(d.ExceptionSets)),
+		AnalyticSets:  make([]plans.AnalyticSetInput, 0, len(d.AnalyticSets)),
+	}
+	if d.LogLevel != "" {
+		logLevel := d.LogLevel
+		req.LogLevel = &logLevel
+	}
+
+	var err error
+	if req.ActionConfigs, err = im.resolve(ResourceActionConfig, d.ActionConfig); err != nil {
+		return nil, err
+	}
+	for _, name := range d.ExceptionSets {
+		id, err := im.resolve(ResourceExceptionSet, name)
+		if err != nil {
+			return nil, err
+		}
+		req.ExceptionSets = append(req.ExceptionSets, id)
+	}
+	for _, set := range d.AnalyticSets {
+		id, err := im.resolve(ResourceAnalyticSet, set.Name)
+		if err != nil {
+			return nil, err
+		}
+		req.AnalyticSets = append(req.AnalyticSets, plans.AnalyticSetInput{Type: set.Type, UUID: id})
+	}
+
+	optional := []struct {
+		kind ResourceKind
+		name string
+		id   **string
+		null *bool
+	}{
+		{ResourceTelemetry, d.Telemetry, &req.Telemetry, nil},
+		{ResourceTelemetryV2, d.TelemetryV2, &req.TelemetryV2, &req.TelemetryV2Null},
+		{ResourceUSBControlSet, d.USBControlSet, &req.USBControlSet, &req.USBControlSetNull},
+	}
+	for _, ref := range optional {
+		if ref.name == "" {
+			if ref.null != nil {
+				*ref.null = true
+			}
+			continue
+		}
+		id, err := im.resolve(ref.kind, ref.name)
+		if err != nil {
+			return nil, err
+		}
+		*ref.id = &id
+	}
+
+	if d.CommsConfig != nil {
+		req.CommsConfig = plans.CommsConfigInput{FQDN: d.CommsConfig.FQDN, Protocol: d.CommsConfig.Protocol}
+	}
+	if d.InfoSync != nil {
+		req.InfoSync = plans.InfoSyncInput{Attrs: d.InfoSync.Attrs, InsightsSyncInterval: d.InfoSync.InsightsSyncInterval}
+	}
+	if d.SignaturesFeedConfig != nil {
+		req.SignaturesFeedConfig = plans.SignaturesFeedConfigInput{Mode: d.SignaturesFeedConfig.Mode}
+	}
+	return req, nil
+}
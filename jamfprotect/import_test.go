@@ -0,0 +1,201 @@
+package jamfprotect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/jamfprotecttest"
+	actionconfigs "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	usbcontrolsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/removable_storage_control_set"
+	telemetryv2 "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newEmptyTenant returns a fake tenant holding only the Jamf-managed analytic set that
+// newProductionPlan's plan uses
+func newEmptyTenant(t *testing.T) (*jamfprotecttest.Server, *jamfprotect.Client, string) {
+	t.Helper()
+	server := jamfprotecttest.NewServer()
+	t.Cleanup(server.Close)
+	c, err := server.NewClient(client.WithLogger(zap.NewNop()))
+	require.NoError(t, err)
+	managedSet, err := server.Put(jamfprotecttest.KindAnalyticSet, map[string]any{
+		"name":      "Jamf Baseline",
+		"managed":   true,
+		"types":     []string{"Report"},
+		"analytics": []string{},
+	})
+	require.NoError(t, err)
+	return server, c, managedSet
+}
+
+func TestImportBundle_RestoresIntoEmptyTenant(t *testing.T) {
+	source := newProductionTenant(t)
+	ctx := context.Background()
+	_, _, err := source.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "SIEM Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+		Clients: []map[string]any{{
+			"type":             "Http",
+			"supportedReports": []string{"AlertReport"},
+			"params": map[string]any{
+				"url":     "https://siem.example.com",
+				"method":  "POST",
+				"headers": []any{map[string]any{"header": "X-Api-Key", "value": "secret"}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	redacted, err := source.ExportTenant(ctx)
+	require.NoError(t, err)
+	server, dest, managedSet := newEmptyTenant(t)
+	_, err = dest.ImportBundle(ctx, redacted)
+	require.ErrorIs(t, err, client.ErrInvalidInput, "redacted bundles are refused")
+	assert.Equal(t, 0, server.OperationCount("createActionConfigs"))
+
+	redacted.Redacted = false
+	report, err := dest.ImportBundle(ctx, redacted, jamfprotect.WithImportMode(jamfprotect.ImportCreateOnly))
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+	require.Len(t, report.Failed(), 1)
+	assert.Equal(t, "SIEM Actions", report.Failed()[0].Resource.Name)
+	assert.Contains(t, err.Error(), "clients[0].params.headers[0].value must be filled in")
+	_, _, err = dest.ActionConfig.GetActionConfigByName(ctx, "SIEM Actions")
+	require.ErrorIs(t, err, client.ErrNotFound, "the redacted value is never written")
+
+	bundle, err := source.ExportTenant(ctx, jamfprotect.ExportIncludeSecrets())
+	require.NoError(t, err)
+	server, dest, managedSet = newEmptyTenant(t)
+	report, err = dest.ImportBundle(ctx, bundle)
+	require.NoError(t, err)
+	assert.Equal(t, 10, report.Count(jamfprotect.ImportCreated))
+	assert.Empty(t, report.Failed())
+
+	planID := report.IDs(jamfprotect.ResourcePlan)["Production"]
+	require.NotEmpty(t, planID)
+	plan, _, err := dest.Plan.GetPlan(ctx, planID)
+	require.NoError(t, err)
+	assert.Equal(t, report.IDs(jamfprotect.ResourceActionConfig)["Prod Actions"], plan.ActionConfigs.ID)
+	assert.Equal(t, report.IDs(jamfprotect.ResourceUSBControlSet)["Prod USB"], plan.USBControlSet.ID)
+	require.Len(t, plan.AnalyticSets, 2)
+	var setIDs []string
+	for _, set := range plan.AnalyticSets {
+		setIDs = append(setIDs, set.AnalyticSet.UUID)
+	}
+	assert.ElementsMatch(t, []string{managedSet, report.IDs(jamfprotect.ResourceAnalyticSet)["Prod Analytics"]}, setIDs)
+
+	restored, err := dest.ExportTenant(ctx, jamfprotect.ExportIncludeSecrets())
+	require.NoError(t, err)
+	want, err := bundle.Files(jamfprotect.BundleFormatYAML)
+	require.NoError(t, err)
+	got, err := restored.Files(jamfprotect.BundleFormatYAML)
+	require.NoError(t, err)
+	assert.Equal(t, want, got, "the restored tenant exports to the same bundle")
+
+	// importing again changes nothing
+	report, err = dest.ImportBundle(ctx, bundle)
+	require.NoError(t, err)
+	assert.Equal(t, 10, report.Count(jamfprotect.ImportUnchanged))
+	assert.Equal(t, 0, server.OperationCount("updatePlan"))
+	assert.Equal(t, 0, server.OperationCount("updateActionConfigs"))
+}
+
+func TestImportBundle_Modes(t *testing.T) {
+	source := newProductionTenant(t)
+	ctx := context.Background()
+	bundle, err := source.ExportTenant(ctx)
+	require.NoError(t, err)
+
+	server, dest, _ := newEmptyTenant(t)
+	_, err = dest.ImportBundle(ctx, bundle)
+	require.NoError(t, err)
+	_, _, err = dest.USBControlSet.CreateUSBControlSet(ctx, &usbcontrolsets.CreateUSBControlSetRequest{
+		Name:               "Old USB",
+		DefaultMountAction: usbcontrolsets.MountActionReadOnly,
+		Rules: []usbcontrolsets.USBControlRuleInput{{
+			Type:       usbcontrolsets.RuleTypeVendor,
+			VendorRule: &usbcontrolsets.USBControlRuleDetails{MountAction: usbcontrolsets.MountActionPrevented, Vendors: []string{"0x9999"}},
+		}},
+	})
+	require.NoError(t, err)
+
+	bundle.TelemetriesV2[0].Description = "changed"
+
+	report, err := dest.ImportBundle(ctx, bundle, jamfprotect.WithImportMode(jamfprotect.ImportCreateOnly))
+	require.NoError(t, err)
+	assert.Equal(t, 9, report.Count(jamfprotect.ImportSkipped))
+	assert.Equal(t, 0, server.OperationCount("updateTelemetryV2"))
+
+	report, err = dest.ImportBundle(ctx, bundle, jamfprotect.WithImportMode(jamfprotect.ImportUpsert))
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Count(jamfprotect.ImportUpdated))
+	assert.Equal(t, 2, server.Len(jamfprotecttest.KindUSBControlSet), "upsert leaves other objects alone")
+
+	report, err = dest.ImportBundle(ctx, bundle, jamfprotect.WithImportMode(jamfprotect.ImportMirror))
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Count(jamfprotect.ImportDeleted))
+	deleted := report.Results[len(report.Results)-1]
+	assert.Equal(t, jamfprotect.ResourceUSBControlSet, deleted.Resource.Kind)
+	assert.Equal(t, "Old USB", deleted.Resource.Name)
+	assert.Equal(t, 1, server.Len(jamfprotecttest.KindUSBControlSet))
+	assert.Equal(t, 2, server.Len(jamfprotecttest.KindAnalyticSet), "Jamf-managed sets are kept")
+}
+
+func TestImportBundle_ReportsFailures(t *testing.T) {
+	ctx := context.Background()
+	_, dest, _ := newEmptyTenant(t)
+
+	bundle := &jamfprotect.Bundle{
+		Version: jamfprotect.BundleVersion,
+		TelemetriesV2: []jamfprotect.TelemetryV2Document{
+			{Name: "Telemetry", LogFiles: []string{"/var/log/system.log"}},
+		},
+		Telemetries: []jamfprotect.TelemetryDocument{{Name: "Legacy"}},
+		Plans: []jamfprotect.PlanDocument{{
+			Name:         "Orphan",
+			ActionConfig: "Missing Actions",
+			TelemetryV2:  "Telemetry",
+			CommsConfig:  &jamfprotect.CommsConfigDocument{FQDN: "protect.example.com", Protocol: "mqtt"},
+		}},
+	}
+
+	report, err := dest.ImportBundle(ctx, bundle)
+	require.ErrorIs(t, err, client.ErrNotFound)
+	assert.Contains(t, err.Error(), `plan "Orphan": resource not found: action configuration "Missing Actions"`)
+	assert.Equal(t, 1, report.Count(jamfprotect.ImportCreated), "other objects are still imported")
+	require.Len(t, report.Failed(), 2)
+	assert.Equal(t, jamfprotect.ResourceTelemetry, report.Failed()[0].Resource.Kind, "telemetry v1 cannot be created")
+	assert.NotEmpty(t, report.IDs(jamfprotect.ResourceTelemetryV2)["Telemetry"])
+
+	_, err = dest.ImportBundle(ctx, bundle, jamfprotect.WithImportMode("replace"))
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+}
+
+func TestImportBundle_MirrorKeepsObjectsAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	server, dest, _ := newEmptyTenant(t)
+	_, _, err := dest.TelemetryV2.CreateTelemetryV2(ctx, &telemetryv2.CreateTelemetryV2Request{
+		Name:     "Old Telemetry",
+		LogFiles: []string{"/var/log/system.log"},
+	})
+	require.NoError(t, err)
+
+	bundle := &jamfprotect.Bundle{
+		Version: jamfprotect.BundleVersion,
+		Plans: []jamfprotect.PlanDocument{{
+			Name:         "Orphan",
+			ActionConfig: "Missing Actions",
+			CommsConfig:  &jamfprotect.CommsConfigDocument{FQDN: "protect.example.com", Protocol: "mqtt"},
+		}},
+	}
+
+	report, err := dest.ImportBundle(ctx, bundle, nil, jamfprotect.WithImportMode(jamfprotect.ImportMirror))
+	require.ErrorIs(t, err, client.ErrNotFound)
+	require.Len(t, report.Failed(), 1)
+	assert.Equal(t, 0, report.Count(jamfprotect.ImportDeleted))
+	assert.Equal(t, 1, server.Len(jamfprotecttest.KindTelemetryV2))
+}
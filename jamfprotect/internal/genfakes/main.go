@@ -0,0 +1,373 @@
+// Command genfakes generates the service fakes in package jamfprotecttest from the
+// service interfaces declared in each services/<name>/interface.go.
+//
+// It is run through go generate from the jamfprotecttest package:
+//
+//	go generate ./jamfprotect/jamfprotecttest
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const modulePath = "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect"
+
+// sdkPackages are the SDK packages interface methods may refer to, by package name
+var sdkPackages = map[string]string{
+	"client":     modulePath + "/client",
+	"interfaces": modulePath + "/interfaces",
+}
+
+// usedPackages records the sdkPackages referenced by the parsed interfaces
+var usedPackages = map[string]bool{}
+
+// service is one service interface to fake
+type service struct {
+	dir       string
+	pkg       string
+	iface     string
+	methods   []method
+	importDir string
+}
+
+// method is one interface method
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+// param is a named method parameter
+type param struct {
+	name string
+	typ  string
+}
+
+// storedType is the type a parameter is recorded as; variadic parameters are slices
+func (p param) storedType() string {
+	if elem, ok := strings.CutPrefix(p.typ, "..."); ok {
+		return "[]" + elem
+	}
+	return p.typ
+}
+
+// arg is the parameter as an argument when forwarding a call
+func (p param) arg() string {
+	if strings.HasPrefix(p.typ, "...") {
+		return p.name + "..."
+	}
+	return p.name
+}
+
+func main() {
+	servicesDir := flag.String("services", "../services", "directory containing the service packages")
+	out := flag.String("out", "fakes_gen.go", "output file")
+	pkg := flag.String("package", "jamfprotecttest", "package name of the generated file")
+	flag.Parse()
+
+	services, err := loadServices(*servicesDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := generate(*pkg, services)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadServices parses the interface.go of every service package
+func loadServices(dir string) ([]service, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*", "interface.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var services []service
+	fset := token.NewFileSet()
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		svc := service{
+			dir: filepath.Base(filepath.Dir(file)),
+			pkg: f.Name.Name,
+		}
+		svc.importDir = "services/" + svc.dir
+
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				it, ok := ts.Type.(*ast.InterfaceType)
+				if !ok || !strings.HasSuffix(ts.Name.Name, "Service") {
+					continue
+				}
+				svc.iface = ts.Name.Name
+				for _, field := range it.Methods.List {
+					fn, ok := field.Type.(*ast.FuncType)
+					if !ok {
+						return nil, fmt.Errorf("%s: embedded interfaces are not supported", file)
+					}
+					svc.methods = append(svc.methods, newMethod(field.Names[0].Name, fn, svc.pkg))
+				}
+			}
+		}
+		if svc.iface == "" {
+			return nil, fmt.Errorf("%s: no service interface found", file)
+		}
+		services = append(services, svc)
+	}
+	return services, nil
+}
+
+func newMethod(name string, fn *ast.FuncType, pkg string) method {
+	m := method{name: name}
+	for i, field := range fn.Params.List {
+		typ := typeString(field.Type, pkg)
+		if len(field.Names) == 0 {
+			m.params = append(m.params, param{name: fmt.Sprintf("arg%d", i), typ: typ})
+			continue
+		}
+		for _, n := range field.Names {
+			m.params = append(m.params, param{name: n.Name, typ: typ})
+		}
+	}
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			count := max(len(field.Names), 1)
+			for range count {
+				m.results = append(m.results, typeString(field.Type, pkg))
+			}
+		}
+	}
+	return m
+}
+
+// typeString renders a type expression, qualifying identifiers declared in the service package
+func typeString(expr ast.Expr, pkg string) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if isPredeclared(t.Name) {
+			return t.Name
+		}
+		return pkg + "." + t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X, pkg)
+	case *ast.SelectorExpr:
+		usedPackages[t.X.(*ast.Ident).Name] = true
+		return t.X.(*ast.Ident).Name + "." + t.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt, pkg)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key, pkg) + "]" + typeString(t.Value, pkg)
+	case *ast.Ellipsis:
+		return "..." + typeString(t.Elt, pkg)
+	case *ast.FuncType:
+		var params, results []string
+		for _, field := range t.Params.List {
+			for range max(len(field.Names), 1) {
+				params = append(params, typeString(field.Type, pkg))
+			}
+		}
+		if t.Results != nil {
+			for _, field := range t.Results.List {
+				for range max(len(field.Names), 1) {
+					results = append(results, typeString(field.Type, pkg))
+				}
+			}
+		}
+		s := "func(" + strings.Join(params, ", ") + ")"
+		switch len(results) {
+		case 0:
+		case 1:
+			s += " " + results[0]
+		default:
+			s += " (" + strings.Join(results, ", ") + ")"
+		}
+		return s
+	default:
+		panic(fmt.Sprintf("unsupported type expression %T", expr))
+	}
+}
+
+func isPredeclared(name string) bool {
+	switch name {
+	case "any", "bool", "byte", "error", "float32", "float64", "int", "int8", "int16",
+		"int32", "int64", "rune", "string", "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
+func generate(pkg string, services []service) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by genfakes. DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg)
+	b.WriteString("\t\"context\"\n\t\"sync\"\n\n")
+	names := make([]string, 0, len(usedPackages))
+	for name := range usedPackages {
+		if sdkPackages[name] != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "\t\"%s\"\n", sdkPackages[name])
+	}
+	for _, svc := range services {
+		fmt.Fprintf(&b, "\t%s \"%s/%s\"\n", svc.pkg, modulePath, svc.importDir)
+	}
+	b.WriteString(")\n")
+
+	for _, svc := range services {
+		writeFake(&b, svc)
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, b.String())
+	}
+	return src, nil
+}
+
+func writeFake(b *bytes.Buffer, svc service) {
+	fake := "Fake" + svc.iface
+	fmt.Fprintf(b, "\n// %s is a fake %s.%s.\n", fake, svc.pkg, svc.iface)
+	b.WriteString("// Each method records its arguments, then calls the method's Stub when set,\n")
+	b.WriteString("// otherwise Impl, otherwise returns zero values.\n")
+	fmt.Fprintf(b, "type %s struct {\n", fake)
+	fmt.Fprintf(b, "\t// Impl handles calls without a stub\n\tImpl %s.%s\n\n", svc.pkg, svc.iface)
+	for _, m := range svc.methods {
+		fmt.Fprintf(b, "\t%sStub %s\n", m.name, m.funcType())
+	}
+	b.WriteString("\n\tmu sync.Mutex\n")
+	for _, m := range svc.methods {
+		fmt.Fprintf(b, "\t%s []%s\n", m.callsField(), m.argsType(fake))
+	}
+	b.WriteString("}\n")
+
+	fmt.Fprintf(b, "\nvar _ %s.%s = (*%s)(nil)\n", svc.pkg, svc.iface, fake)
+
+	for _, m := range svc.methods {
+		argsType := m.argsType(fake)
+
+		fmt.Fprintf(b, "\ntype %s struct {\n", argsType)
+		for _, p := range m.params {
+			fmt.Fprintf(b, "\t%s %s\n", p.name, p.storedType())
+		}
+		b.WriteString("}\n")
+
+		names := m.paramNames()
+		fmt.Fprintf(b, "\n// %s implements %s.%s\n", m.name, svc.pkg, svc.iface)
+		fmt.Fprintf(b, "func (f *%s) %s(%s) %s {\n", fake, m.name, m.paramList(), m.resultList())
+		fmt.Fprintf(b, "\tf.mu.Lock()\n\tf.%s = append(f.%s, %s{%s})\n\tstub := f.%sStub\n\tf.mu.Unlock()\n",
+			m.callsField(), m.callsField(), argsType, strings.Join(names, ", "), m.name)
+		args := strings.Join(m.args(), ", ")
+		fmt.Fprintf(b, "\tif stub != nil {\n\t\treturn stub(%s)\n\t}\n", args)
+		fmt.Fprintf(b, "\tif f.Impl != nil {\n\t\treturn f.Impl.%s(%s)\n\t}\n", m.name, args)
+		b.WriteString(m.zeroReturn())
+		b.WriteString("}\n")
+
+		fmt.Fprintf(b, "\n// %sCallCount returns the number of %s calls\n", m.name, m.name)
+		fmt.Fprintf(b, "func (f *%s) %sCallCount() int {\n\tf.mu.Lock()\n\tdefer f.mu.Unlock()\n\treturn len(f.%s)\n}\n",
+			fake, m.name, m.callsField())
+
+		fmt.Fprintf(b, "\n// %sArgsForCall returns the arguments of the i-th %s call\n", m.name, m.name)
+		fmt.Fprintf(b, "func (f *%s) %sArgsForCall(i int) (%s) {\n\tf.mu.Lock()\n\tdefer f.mu.Unlock()\n", fake, m.name, m.paramTypes())
+		fmt.Fprintf(b, "\tcall := f.%s[i]\n\treturn ", m.callsField())
+		for i, name := range names {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString("call." + name)
+		}
+		b.WriteString("\n}\n")
+	}
+}
+
+func (m method) funcType() string {
+	return "func(" + m.paramList() + ") " + m.resultList()
+}
+
+func (m method) paramList() string {
+	parts := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		parts = append(parts, p.name+" "+p.typ)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (m method) paramTypes() string {
+	parts := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		parts = append(parts, p.storedType())
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (m method) paramNames() []string {
+	names := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		names = append(names, p.name)
+	}
+	return names
+}
+
+func (m method) args() []string {
+	args := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		args = append(args, p.arg())
+	}
+	return args
+}
+
+func (m method) resultList() string {
+	if len(m.results) == 1 {
+		return m.results[0]
+	}
+	return "(" + strings.Join(m.results, ", ") + ")"
+}
+
+func (m method) zeroReturn() string {
+	var b strings.Builder
+	names := make([]string, 0, len(m.results))
+	for i, typ := range m.results {
+		name := fmt.Sprintf("r%d", i)
+		fmt.Fprintf(&b, "\tvar %s %s\n", name, typ)
+		names = append(names, name)
+	}
+	fmt.Fprintf(&b, "\treturn %s\n", strings.Join(names, ", "))
+	return b.String()
+}
+
+func (m method) callsField() string {
+	r := []rune(m.name)
+	r[0] = unicode.ToLower(r[0])
+	return string(r) + "Calls"
+}
+
+func (m method) argsType(fake string) string {
+	r := []rune(fake)
+	r[0] = unicode.ToLower(r[0])
+	return string(r) + m.name + "Args"
+}
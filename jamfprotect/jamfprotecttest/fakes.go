@@ -0,0 +1,76 @@
+package jamfprotecttest
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect"
+	actionconfiguration "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic"
+	analyticset "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic_set"
+	custompreventlist "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/custom_prevent_list"
+	exceptionset "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/exception_set"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+	removablestoragecontrolset "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/removable_storage_control_set"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	unifiedloggingfilter "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/unified_logging_filter"
+)
+
+//go:generate go run ../internal/genfakes -services ../services -out fakes_gen.go
+
+// Fakes holds the service fakes of a client created by NewFakeClient
+type Fakes struct {
+	// Server holds the in-memory stores backing the fakes. It has no HTTP listener,
+	// so its URL is empty, but Put, Get, Len and OperationCount may be used to seed
+	// and inspect state.
+	Server *Server
+
+	ActionConfig         *FakeActionConfigService
+	Analytic             *FakeAnalyticService
+	AnalyticSet          *FakeAnalyticSetService
+	ExceptionSet         *FakeExceptionSetService
+	PreventList          *FakePreventListService
+	Plan                 *FakePlanService
+	TelemetryV2          *FakeTelemetryV2Service
+	USBControlSet        *FakeUSBControlSetService
+	UnifiedLoggingFilter *FakeUnifiedLoggingFilterService
+}
+
+// NewFakeClient returns a jamfprotect.Client whose services are in-memory fakes.
+//
+// By default each fake delegates to the real service implementation executing
+// directly against the stores of an unstarted Server, so the client is stateful
+// without HTTP, tokens or a listener. Set a method's Stub on the returned Fakes
+// to override it, and use its CallCount and ArgsForCall to assert on calls.
+//
+//	c, fakes := jamfprotecttest.NewFakeClient()
+//	fakes.Plan.DeletePlanStub = func(ctx context.Context, id string) (*interfaces.Response, error) {
+//	    return nil, errors.New("boom")
+//	}
+func NewFakeClient(opts ...Option) (*jamfprotect.Client, *Fakes) {
+	server := newServer(opts...)
+	gql := &inProcessClient{server: server}
+
+	fakes := &Fakes{
+		Server:               server,
+		ActionConfig:         &FakeActionConfigService{Impl: actionconfiguration.NewService(gql)},
+		Analytic:             &FakeAnalyticService{Impl: analytic.NewService(gql)},
+		AnalyticSet:          &FakeAnalyticSetService{Impl: analyticset.NewService(gql)},
+		ExceptionSet:         &FakeExceptionSetService{Impl: exceptionset.NewService(gql)},
+		PreventList:          &FakePreventListService{Impl: custompreventlist.NewService(gql)},
+		Plan:                 &FakePlanService{Impl: plan.NewService(gql)},
+		TelemetryV2:          &FakeTelemetryV2Service{Impl: telemetry.NewService(gql)},
+		USBControlSet:        &FakeUSBControlSetService{Impl: removablestoragecontrolset.NewService(gql)},
+		UnifiedLoggingFilter: &FakeUnifiedLoggingFilterService{Impl: unifiedloggingfilter.NewService(gql)},
+	}
+
+	c := &jamfprotect.Client{
+		ActionConfig:         fakes.ActionConfig,
+		Analytic:             fakes.Analytic,
+		AnalyticSet:          fakes.AnalyticSet,
+		ExceptionSet:         fakes.ExceptionSet,
+		PreventList:          fakes.PreventList,
+		Plan:                 fakes.Plan,
+		TelemetryV2:          fakes.TelemetryV2,
+		USBControlSet:        fakes.USBControlSet,
+		UnifiedLoggingFilter: fakes.UnifiedLoggingFilter,
+	}
+	return c, fakes
+}
@@ -0,0 +1,3794 @@
+// Code generated by genfakes. DO NOT EDIT.
+
+package jamfprotecttest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+	actionconfiguration "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	analytic "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic"
+	analyticset "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic_set"
+	custompreventlist "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/custom_prevent_list"
+	exceptionset "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/exception_set"
+	plan "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+	removablestoragecontrolset "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/removable_storage_control_set"
+	telemetry "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	unifiedloggingfilter "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/unified_logging_filter"
+)
+
+// FakeActionConfigService is a fake actionconfiguration.ActionConfigService.
+// Each method records its arguments, then calls the method's Stub when set,
+// otherwise Impl, otherwise returns zero values.
+type FakeActionConfigService struct {
+	// Impl handles calls without a stub
+	Impl actionconfiguration.ActionConfigService
+
+	CreateActionConfigStub        func(ctx context.Context, req *actionconfiguration.CreateActionConfigRequest) (*actionconfiguration.ActionConfig, *interfaces.Response, error)
+	GetActionConfigStub           func(ctx context.Context, id string) (*actionconfiguration.ActionConfig, *interfaces.Response, error)
+	GetActionConfigByNameStub     func(ctx context.Context, name string) (*actionconfiguration.ActionConfig, *interfaces.Response, error)
+	UpdateActionConfigStub        func(ctx context.Context, id string, req *actionconfiguration.UpdateActionConfigRequest) (*actionconfiguration.ActionConfig, *interfaces.Response, error)
+	UpdateActionConfigIfMatchStub func(ctx context.Context, id string, expectedHash string, req *actionconfiguration.UpdateActionConfigRequest) (*actionconfiguration.ActionConfig, *interfaces.Response, error)
+	ModifyActionConfigStub        func(ctx context.Context, id string, merge func(*actionconfiguration.ActionConfig, *actionconfiguration.UpdateActionConfigRequest) error, attempts int) (*actionconfiguration.ActionConfig, *interfaces.Response, error)
+	UpsertActionConfigStub        func(ctx context.Context, req *actionconfiguration.CreateActionConfigRequest, opts ...client.UpsertOption) (*actionconfiguration.ActionConfig, client.UpsertAction, *interfaces.Response, error)
+	CloneActionConfigStub         func(ctx context.Context, id string, newName string) (*actionconfiguration.ActionConfig, *interfaces.Response, error)
+	DeleteActionConfigStub        func(ctx context.Context, id string) (*interfaces.Response, error)
+	ListActionConfigsStub         func(ctx context.Context) ([]actionconfiguration.ActionConfigListItem, *interfaces.Response, error)
+	ListActionConfigNamesStub     func(ctx context.Context) ([]string, *interfaces.Response, error)
+
+	mu                             sync.Mutex
+	createActionConfigCalls        []fakeActionConfigServiceCreateActionConfigArgs
+	getActionConfigCalls           []fakeActionConfigServiceGetActionConfigArgs
+	getActionConfigByNameCalls     []fakeActionConfigServiceGetActionConfigByNameArgs
+	updateActionConfigCalls        []fakeActionConfigServiceUpdateActionConfigArgs
+	updateActionConfigIfMatchCalls []fakeActionConfigServiceUpdateActionConfigIfMatchArgs
+	modifyActionConfigCalls        []fakeActionConfigServiceModifyActionConfigArgs
+	upsertActionConfigCalls        []fakeActionConfigServiceUpsertActionConfigArgs
+	cloneActionConfigCalls         []fakeActionConfigServiceCloneActionConfigArgs
+	deleteActionConfigCalls        []fakeActionConfigServiceDeleteActionConfigArgs
+	listActionConfigsCalls         []fakeActionConfigServiceListActionConfigsArgs
+	listActionConfigNamesCalls     []fakeActionConfigServiceListActionConfigNamesArgs
+}
+
+var _ actionconfiguration.ActionConfigService = (*FakeActionConfigService)(nil)
+
+type fakeActionConfigServiceCreateActionConfigArgs struct {
+	ctx context.Context
+	req *actionconfiguration.CreateActionConfigRequest
+}
+
+// CreateActionConfig implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) CreateActionConfig(ctx context.Context, req *actionconfiguration.CreateActionConfigRequest) (*actionconfiguration.ActionConfig, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.createActionConfigCalls = append(f.createActionConfigCalls, fakeActionConfigServiceCreateActionConfigArgs{ctx, req})
+	stub := f.CreateActionConfigStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.CreateActionConfig(ctx, req)
+	}
+	var r0 *actionconfiguration.ActionConfig
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateActionConfigCallCount returns the number of CreateActionConfig calls
+func (f *FakeActionConfigService) CreateActionConfigCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.createActionConfigCalls)
+}
+
+// CreateActionConfigArgsForCall returns the arguments of the i-th CreateActionConfig call
+func (f *FakeActionConfigService) CreateActionConfigArgsForCall(i int) (context.Context, *actionconfiguration.CreateActionConfigRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.createActionConfigCalls[i]
+	return call.ctx, call.req
+}
+
+type fakeActionConfigServiceGetActionConfigArgs struct {
+	ctx context.Context
+	id  string
+}
+
+// GetActionConfig implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) GetActionConfig(ctx context.Context, id string) (*actionconfiguration.ActionConfig, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getActionConfigCalls = append(f.getActionConfigCalls, fakeActionConfigServiceGetActionConfigArgs{ctx, id})
+	stub := f.GetActionConfigStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetActionConfig(ctx, id)
+	}
+	var r0 *actionconfiguration.ActionConfig
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetActionConfigCallCount returns the number of GetActionConfig calls
+func (f *FakeActionConfigService) GetActionConfigCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getActionConfigCalls)
+}
+
+// GetActionConfigArgsForCall returns the arguments of the i-th GetActionConfig call
+func (f *FakeActionConfigService) GetActionConfigArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getActionConfigCalls[i]
+	return call.ctx, call.id
+}
+
+type fakeActionConfigServiceGetActionConfigByNameArgs struct {
+	ctx  context.Context
+	name string
+}
+
+// GetActionConfigByName implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) GetActionConfigByName(ctx context.Context, name string) (*actionconfiguration.ActionConfig, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getActionConfigByNameCalls = append(f.getActionConfigByNameCalls, fakeActionConfigServiceGetActionConfigByNameArgs{ctx, name})
+	stub := f.GetActionConfigByNameStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetActionConfigByName(ctx, name)
+	}
+	var r0 *actionconfiguration.ActionConfig
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetActionConfigByNameCallCount returns the number of GetActionConfigByName calls
+func (f *FakeActionConfigService) GetActionConfigByNameCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getActionConfigByNameCalls)
+}
+
+// GetActionConfigByNameArgsForCall returns the arguments of the i-th GetActionConfigByName call
+func (f *FakeActionConfigService) GetActionConfigByNameArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getActionConfigByNameCalls[i]
+	return call.ctx, call.name
+}
+
+type fakeActionConfigServiceUpdateActionConfigArgs struct {
+	ctx context.Context
+	id  string
+	req *actionconfiguration.UpdateActionConfigRequest
+}
+
+// UpdateActionConfig implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) UpdateActionConfig(ctx context.Context, id string, req *actionconfiguration.UpdateActionConfigRequest) (*actionconfiguration.ActionConfig, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updateActionConfigCalls = append(f.updateActionConfigCalls, fakeActionConfigServiceUpdateActionConfigArgs{ctx, id, req})
+	stub := f.UpdateActionConfigStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdateActionConfig(ctx, id, req)
+	}
+	var r0 *actionconfiguration.ActionConfig
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateActionConfigCallCount returns the number of UpdateActionConfig calls
+func (f *FakeActionConfigService) UpdateActionConfigCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updateActionConfigCalls)
+}
+
+// UpdateActionConfigArgsForCall returns the arguments of the i-th UpdateActionConfig call
+func (f *FakeActionConfigService) UpdateActionConfigArgsForCall(i int) (context.Context, string, *actionconfiguration.UpdateActionConfigRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updateActionConfigCalls[i]
+	return call.ctx, call.id, call.req
+}
+
+type fakeActionConfigServiceUpdateActionConfigIfMatchArgs struct {
+	ctx          context.Context
+	id           string
+	expectedHash string
+	req          *actionconfiguration.UpdateActionConfigRequest
+}
+
+// UpdateActionConfigIfMatch implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) UpdateActionConfigIfMatch(ctx context.Context, id string, expectedHash string, req *actionconfiguration.UpdateActionConfigRequest) (*actionconfiguration.ActionConfig, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updateActionConfigIfMatchCalls = append(f.updateActionConfigIfMatchCalls, fakeActionConfigServiceUpdateActionConfigIfMatchArgs{ctx, id, expectedHash, req})
+	stub := f.UpdateActionConfigIfMatchStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, expectedHash, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdateActionConfigIfMatch(ctx, id, expectedHash, req)
+	}
+	var r0 *actionconfiguration.ActionConfig
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateActionConfigIfMatchCallCount returns the number of UpdateActionConfigIfMatch calls
+func (f *FakeActionConfigService) UpdateActionConfigIfMatchCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updateActionConfigIfMatchCalls)
+}
+
+// UpdateActionConfigIfMatchArgsForCall returns the arguments of the i-th UpdateActionConfigIfMatch call
+func (f *FakeActionConfigService) UpdateActionConfigIfMatchArgsForCall(i int) (context.Context, string, string, *actionconfiguration.UpdateActionConfigRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updateActionConfigIfMatchCalls[i]
+	return call.ctx, call.id, call.expectedHash, call.req
+}
+
+type fakeActionConfigServiceModifyActionConfigArgs struct {
+	ctx      context.Context
+	id       string
+	merge    func(*actionconfiguration.ActionConfig, *actionconfiguration.UpdateActionConfigRequest) error
+	attempts int
+}
+
+// ModifyActionConfig implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) ModifyActionConfig(ctx context.Context, id string, merge func(*actionconfiguration.ActionConfig, *actionconfiguration.UpdateActionConfigRequest) error, attempts int) (*actionconfiguration.ActionConfig, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.modifyActionConfigCalls = append(f.modifyActionConfigCalls, fakeActionConfigServiceModifyActionConfigArgs{ctx, id, merge, attempts})
+	stub := f.ModifyActionConfigStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, merge, attempts)
+	}
+	if f.Impl != nil {
+		return f.Impl.ModifyActionConfig(ctx, id, merge, attempts)
+	}
+	var r0 *actionconfiguration.ActionConfig
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ModifyActionConfigCallCount returns the number of ModifyActionConfig calls
+func (f *FakeActionConfigService) ModifyActionConfigCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.modifyActionConfigCalls)
+}
+
+// ModifyActionConfigArgsForCall returns the arguments of the i-th ModifyActionConfig call
+func (f *FakeActionConfigService) ModifyActionConfigArgsForCall(i int) (context.Context, string, func(*actionconfiguration.ActionConfig, *actionconfiguration.UpdateActionConfigRequest) error, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.modifyActionConfigCalls[i]
+	return call.ctx, call.id, call.merge, call.attempts
+}
+
+type fakeActionConfigServiceUpsertActionConfigArgs struct {
+	ctx  context.Context
+	req  *actionconfiguration.CreateActionConfigRequest
+	opts []client.UpsertOption
+}
+
+// UpsertActionConfig implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) UpsertActionConfig(ctx context.Context, req *actionconfiguration.CreateActionConfigRequest, opts ...client.UpsertOption) (*actionconfiguration.ActionConfig, client.UpsertAction, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.upsertActionConfigCalls = append(f.upsertActionConfigCalls, fakeActionConfigServiceUpsertActionConfigArgs{ctx, req, opts})
+	stub := f.UpsertActionConfigStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req, opts...)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpsertActionConfig(ctx, req, opts...)
+	}
+	var r0 *actionconfiguration.ActionConfig
+	var r1 client.UpsertAction
+	var r2 *interfaces.Response
+	var r3 error
+	return r0, r1, r2, r3
+}
+
+// UpsertActionConfigCallCount returns the number of UpsertActionConfig calls
+func (f *FakeActionConfigService) UpsertActionConfigCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.upsertActionConfigCalls)
+}
+
+// UpsertActionConfigArgsForCall returns the arguments of the i-th UpsertActionConfig call
+func (f *FakeActionConfigService) UpsertActionConfigArgsForCall(i int) (context.Context, *actionconfiguration.CreateActionConfigRequest, []client.UpsertOption) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.upsertActionConfigCalls[i]
+	return call.ctx, call.req, call.opts
+}
+
+type fakeActionConfigServiceCloneActionConfigArgs struct {
+	ctx     context.Context
+	id      string
+	newName string
+}
+
+// CloneActionConfig implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) CloneActionConfig(ctx context.Context, id string, newName string) (*actionconfiguration.ActionConfig, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.cloneActionConfigCalls = append(f.cloneActionConfigCalls, fakeActionConfigServiceCloneActionConfigArgs{ctx, id, newName})
+	stub := f.CloneActionConfigStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, newName)
+	}
+	if f.Impl != nil {
+		return f.Impl.CloneActionConfig(ctx, id, newName)
+	}
+	var r0 *actionconfiguration.ActionConfig
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CloneActionConfigCallCount returns the number of CloneActionConfig calls
+func (f *FakeActionConfigService) CloneActionConfigCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.cloneActionConfigCalls)
+}
+
+// CloneActionConfigArgsForCall returns the arguments of the i-th CloneActionConfig call
+func (f *FakeActionConfigService) CloneActionConfigArgsForCall(i int) (context.Context, string, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.cloneActionConfigCalls[i]
+	return call.ctx, call.id, call.newName
+}
+
+type fakeActionConfigServiceDeleteActionConfigArgs struct {
+	ctx context.Context
+	id  string
+}
+
+// DeleteActionConfig implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) DeleteActionConfig(ctx context.Context, id string) (*interfaces.Response, error) {
+	f.mu.Lock()
+	f.deleteActionConfigCalls = append(f.deleteActionConfigCalls, fakeActionConfigServiceDeleteActionConfigArgs{ctx, id})
+	stub := f.DeleteActionConfigStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.DeleteActionConfig(ctx, id)
+	}
+	var r0 *interfaces.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteActionConfigCallCount returns the number of DeleteActionConfig calls
+func (f *FakeActionConfigService) DeleteActionConfigCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deleteActionConfigCalls)
+}
+
+// DeleteActionConfigArgsForCall returns the arguments of the i-th DeleteActionConfig call
+func (f *FakeActionConfigService) DeleteActionConfigArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.deleteActionConfigCalls[i]
+	return call.ctx, call.id
+}
+
+type fakeActionConfigServiceListActionConfigsArgs struct {
+	ctx context.Context
+}
+
+// ListActionConfigs implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) ListActionConfigs(ctx context.Context) ([]actionconfiguration.ActionConfigListItem, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listActionConfigsCalls = append(f.listActionConfigsCalls, fakeActionConfigServiceListActionConfigsArgs{ctx})
+	stub := f.ListActionConfigsStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListActionConfigs(ctx)
+	}
+	var r0 []actionconfiguration.ActionConfigListItem
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListActionConfigsCallCount returns the number of ListActionConfigs calls
+func (f *FakeActionConfigService) ListActionConfigsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listActionConfigsCalls)
+}
+
+// ListActionConfigsArgsForCall returns the arguments of the i-th ListActionConfigs call
+func (f *FakeActionConfigService) ListActionConfigsArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listActionConfigsCalls[i]
+	return call.ctx
+}
+
+type fakeActionConfigServiceListActionConfigNamesArgs struct {
+	ctx context.Context
+}
+
+// ListActionConfigNames implements actionconfiguration.ActionConfigService
+func (f *FakeActionConfigService) ListActionConfigNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listActionConfigNamesCalls = append(f.listActionConfigNamesCalls, fakeActionConfigServiceListActionConfigNamesArgs{ctx})
+	stub := f.ListActionConfigNamesStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListActionConfigNames(ctx)
+	}
+	var r0 []string
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListActionConfigNamesCallCount returns the number of ListActionConfigNames calls
+func (f *FakeActionConfigService) ListActionConfigNamesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listActionConfigNamesCalls)
+}
+
+// ListActionConfigNamesArgsForCall returns the arguments of the i-th ListActionConfigNames call
+func (f *FakeActionConfigService) ListActionConfigNamesArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listActionConfigNamesCalls[i]
+	return call.ctx
+}
+
+// FakeAnalyticService is a fake analytic.AnalyticService.
+// Each method records its arguments, then calls the method's Stub when set,
+// otherwise Impl, otherwise returns zero values.
+type FakeAnalyticService struct {
+	// Impl handles calls without a stub
+	Impl analytic.AnalyticService
+
+	CreateAnalyticStub             func(ctx context.Context, req *analytic.CreateAnalyticRequest) (*analytic.Analytic, *interfaces.Response, error)
+	GetAnalyticStub                func(ctx context.Context, uuid string) (*analytic.Analytic, *interfaces.Response, error)
+	GetAnalyticByNameStub          func(ctx context.Context, name string) (*analytic.Analytic, *interfaces.Response, error)
+	UpdateAnalyticStub             func(ctx context.Context, uuid string, req *analytic.UpdateAnalyticRequest) (*analytic.Analytic, *interfaces.Response, error)
+	PatchAnalyticStub              func(ctx context.Context, uuid string, patch func(*analytic.UpdateAnalyticRequest)) (*analytic.Analytic, *interfaces.Response, error)
+	UpsertAnalyticStub             func(ctx context.Context, req *analytic.CreateAnalyticRequest, opts ...client.UpsertOption) (*analytic.Analytic, client.UpsertAction, *interfaces.Response, error)
+	DeleteAnalyticStub             func(ctx context.Context, uuid string) (*interfaces.Response, error)
+	ListAnalyticsStub              func(ctx context.Context) ([]analytic.Analytic, *interfaces.Response, error)
+	ListAnalyticsLiteStub          func(ctx context.Context) ([]analytic.AnalyticLite, *interfaces.Response, error)
+	ListAnalyticsNamesStub         func(ctx context.Context) ([]string, *interfaces.Response, error)
+	ListAnalyticsCategoriesStub    func(ctx context.Context) ([]analytic.AnalyticCategory, *interfaces.Response, error)
+	ListAnalyticsTagsStub          func(ctx context.Context) ([]analytic.AnalyticTag, *interfaces.Response, error)
+	ListAnalyticsFilterOptionsStub func(ctx context.Context) (*analytic.AnalyticsFilterOptions, *interfaces.Response, error)
+
+	mu                              sync.Mutex
+	createAnalyticCalls             []fakeAnalyticServiceCreateAnalyticArgs
+	getAnalyticCalls                []fakeAnalyticServiceGetAnalyticArgs
+	getAnalyticByNameCalls          []fakeAnalyticServiceGetAnalyticByNameArgs
+	updateAnalyticCalls             []fakeAnalyticServiceUpdateAnalyticArgs
+	patchAnalyticCalls              []fakeAnalyticServicePatchAnalyticArgs
+	upsertAnalyticCalls             []fakeAnalyticServiceUpsertAnalyticArgs
+	deleteAnalyticCalls             []fakeAnalyticServiceDeleteAnalyticArgs
+	listAnalyticsCalls              []fakeAnalyticServiceListAnalyticsArgs
+	listAnalyticsLiteCalls          []fakeAnalyticServiceListAnalyticsLiteArgs
+	listAnalyticsNamesCalls         []fakeAnalyticServiceListAnalyticsNamesArgs
+	listAnalyticsCategoriesCalls    []fakeAnalyticServiceListAnalyticsCategoriesArgs
+	listAnalyticsTagsCalls          []fakeAnalyticServiceListAnalyticsTagsArgs
+	listAnalyticsFilterOptionsCalls []fakeAnalyticServiceListAnalyticsFilterOptionsArgs
+}
+
+var _ analytic.AnalyticService = (*FakeAnalyticService)(nil)
+
+type fakeAnalyticServiceCreateAnalyticArgs struct {
+	ctx context.Context
+	req *analytic.CreateAnalyticRequest
+}
+
+// CreateAnalytic implements analytic.AnalyticService
+func (f *FakeAnalyticService) CreateAnalytic(ctx context.Context, req *analytic.CreateAnalyticRequest) (*analytic.Analytic, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.createAnalyticCalls = append(f.createAnalyticCalls, fakeAnalyticServiceCreateAnalyticArgs{ctx, req})
+	stub := f.CreateAnalyticStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.CreateAnalytic(ctx, req)
+	}
+	var r0 *analytic.Analytic
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateAnalyticCallCount returns the number of CreateAnalytic calls
+func (f *FakeAnalyticService) CreateAnalyticCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.createAnalyticCalls)
+}
+
+// CreateAnalyticArgsForCall returns the arguments of the i-th CreateAnalytic call
+func (f *FakeAnalyticService) CreateAnalyticArgsForCall(i int) (context.Context, *analytic.CreateAnalyticRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.createAnalyticCalls[i]
+	return call.ctx, call.req
+}
+
+type fakeAnalyticServiceGetAnalyticArgs struct {
+	ctx  context.Context
+	uuid string
+}
+
+// GetAnalytic implements analytic.AnalyticService
+func (f *FakeAnalyticService) GetAnalytic(ctx context.Context, uuid string) (*analytic.Analytic, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getAnalyticCalls = append(f.getAnalyticCalls, fakeAnalyticServiceGetAnalyticArgs{ctx, uuid})
+	stub := f.GetAnalyticStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetAnalytic(ctx, uuid)
+	}
+	var r0 *analytic.Analytic
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetAnalyticCallCount returns the number of GetAnalytic calls
+func (f *FakeAnalyticService) GetAnalyticCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAnalyticCalls)
+}
+
+// GetAnalyticArgsForCall returns the arguments of the i-th GetAnalytic call
+func (f *FakeAnalyticService) GetAnalyticArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getAnalyticCalls[i]
+	return call.ctx, call.uuid
+}
+
+type fakeAnalyticServiceGetAnalyticByNameArgs struct {
+	ctx  context.Context
+	name string
+}
+
+// GetAnalyticByName implements analytic.AnalyticService
+func (f *FakeAnalyticService) GetAnalyticByName(ctx context.Context, name string) (*analytic.Analytic, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getAnalyticByNameCalls = append(f.getAnalyticByNameCalls, fakeAnalyticServiceGetAnalyticByNameArgs{ctx, name})
+	stub := f.GetAnalyticByNameStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetAnalyticByName(ctx, name)
+	}
+	var r0 *analytic.Analytic
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetAnalyticByNameCallCount returns the number of GetAnalyticByName calls
+func (f *FakeAnalyticService) GetAnalyticByNameCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAnalyticByNameCalls)
+}
+
+// GetAnalyticByNameArgsForCall returns the arguments of the i-th GetAnalyticByName call
+func (f *FakeAnalyticService) GetAnalyticByNameArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getAnalyticByNameCalls[i]
+	return call.ctx, call.name
+}
+
+type fakeAnalyticServiceUpdateAnalyticArgs struct {
+	ctx  context.Context
+	uuid string
+	req  *analytic.UpdateAnalyticRequest
+}
+
+// UpdateAnalytic implements analytic.AnalyticService
+func (f *FakeAnalyticService) UpdateAnalytic(ctx context.Context, uuid string, req *analytic.UpdateAnalyticRequest) (*analytic.Analytic, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updateAnalyticCalls = append(f.updateAnalyticCalls, fakeAnalyticServiceUpdateAnalyticArgs{ctx, uuid, req})
+	stub := f.UpdateAnalyticStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdateAnalytic(ctx, uuid, req)
+	}
+	var r0 *analytic.Analytic
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateAnalyticCallCount returns the number of UpdateAnalytic calls
+func (f *FakeAnalyticService) UpdateAnalyticCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updateAnalyticCalls)
+}
+
+// UpdateAnalyticArgsForCall returns the arguments of the i-th UpdateAnalytic call
+func (f *FakeAnalyticService) UpdateAnalyticArgsForCall(i int) (context.Context, string, *analytic.UpdateAnalyticRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updateAnalyticCalls[i]
+	return call.ctx, call.uuid, call.req
+}
+
+type fakeAnalyticServicePatchAnalyticArgs struct {
+	ctx   context.Context
+	uuid  string
+	patch func(*analytic.UpdateAnalyticRequest)
+}
+
+// PatchAnalytic implements analytic.AnalyticService
+func (f *FakeAnalyticService) PatchAnalytic(ctx context.Context, uuid string, patch func(*analytic.UpdateAnalyticRequest)) (*analytic.Analytic, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.patchAnalyticCalls = append(f.patchAnalyticCalls, fakeAnalyticServicePatchAnalyticArgs{ctx, uuid, patch})
+	stub := f.PatchAnalyticStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid, patch)
+	}
+	if f.Impl != nil {
+		return f.Impl.PatchAnalytic(ctx, uuid, patch)
+	}
+	var r0 *analytic.Analytic
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// PatchAnalyticCallCount returns the number of PatchAnalytic calls
+func (f *FakeAnalyticService) PatchAnalyticCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.patchAnalyticCalls)
+}
+
+// PatchAnalyticArgsForCall returns the arguments of the i-th PatchAnalytic call
+func (f *FakeAnalyticService) PatchAnalyticArgsForCall(i int) (context.Context, string, func(*analytic.UpdateAnalyticRequest)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.patchAnalyticCalls[i]
+	return call.ctx, call.uuid, call.patch
+}
+
+type fakeAnalyticServiceUpsertAnalyticArgs struct {
+	ctx  context.Context
+	req  *analytic.CreateAnalyticRequest
+	opts []client.UpsertOption
+}
+
+// UpsertAnalytic implements analytic.AnalyticService
+func (f *FakeAnalyticService) UpsertAnalytic(ctx context.Context, req *analytic.CreateAnalyticRequest, opts ...client.UpsertOption) (*analytic.Analytic, client.UpsertAction, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.upsertAnalyticCalls = append(f.upsertAnalyticCalls, fakeAnalyticServiceUpsertAnalyticArgs{ctx, req, opts})
+	stub := f.UpsertAnalyticStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req, opts...)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpsertAnalytic(ctx, req, opts...)
+	}
+	var r0 *analytic.Analytic
+	var r1 client.UpsertAction
+	var r2 *interfaces.Response
+	var r3 error
+	return r0, r1, r2, r3
+}
+
+// UpsertAnalyticCallCount returns the number of UpsertAnalytic calls
+func (f *FakeAnalyticService) UpsertAnalyticCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.upsertAnalyticCalls)
+}
+
+// UpsertAnalyticArgsForCall returns the arguments of the i-th UpsertAnalytic call
+func (f *FakeAnalyticService) UpsertAnalyticArgsForCall(i int) (context.Context, *analytic.CreateAnalyticRequest, []client.UpsertOption) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.upsertAnalyticCalls[i]
+	return call.ctx, call.req, call.opts
+}
+
+type fakeAnalyticServiceDeleteAnalyticArgs struct {
+	ctx  context.Context
+	uuid string
+}
+
+// DeleteAnalytic implements analytic.AnalyticService
+func (f *FakeAnalyticService) DeleteAnalytic(ctx context.Context, uuid string) (*interfaces.Response, error) {
+	f.mu.Lock()
+	f.deleteAnalyticCalls = append(f.deleteAnalyticCalls, fakeAnalyticServiceDeleteAnalyticArgs{ctx, uuid})
+	stub := f.DeleteAnalyticStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid)
+	}
+	if f.Impl != nil {
+		return f.Impl.DeleteAnalytic(ctx, uuid)
+	}
+	var r0 *interfaces.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteAnalyticCallCount returns the number of DeleteAnalytic calls
+func (f *FakeAnalyticService) DeleteAnalyticCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deleteAnalyticCalls)
+}
+
+// DeleteAnalyticArgsForCall returns the arguments of the i-th DeleteAnalytic call
+func (f *FakeAnalyticService) DeleteAnalyticArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.deleteAnalyticCalls[i]
+	return call.ctx, call.uuid
+}
+
+type fakeAnalyticServiceListAnalyticsArgs struct {
+	ctx context.Context
+}
+
+// ListAnalytics implements analytic.AnalyticService
+func (f *FakeAnalyticService) ListAnalytics(ctx context.Context) ([]analytic.Analytic, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listAnalyticsCalls = append(f.listAnalyticsCalls, fakeAnalyticServiceListAnalyticsArgs{ctx})
+	stub := f.ListAnalyticsStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListAnalytics(ctx)
+	}
+	var r0 []analytic.Analytic
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListAnalyticsCallCount returns the number of ListAnalytics calls
+func (f *FakeAnalyticService) ListAnalyticsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listAnalyticsCalls)
+}
+
+// ListAnalyticsArgsForCall returns the arguments of the i-th ListAnalytics call
+func (f *FakeAnalyticService) ListAnalyticsArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listAnalyticsCalls[i]
+	return call.ctx
+}
+
+type fakeAnalyticServiceListAnalyticsLiteArgs struct {
+	ctx context.Context
+}
+
+// ListAnalyticsLite implements analytic.AnalyticService
+func (f *FakeAnalyticService) ListAnalyticsLite(ctx context.Context) ([]analytic.AnalyticLite, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listAnalyticsLiteCalls = append(f.listAnalyticsLiteCalls, fakeAnalyticServiceListAnalyticsLiteArgs{ctx})
+	stub := f.ListAnalyticsLiteStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListAnalyticsLite(ctx)
+	}
+	var r0 []analytic.AnalyticLite
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListAnalyticsLiteCallCount returns the number of ListAnalyticsLite calls
+func (f *FakeAnalyticService) ListAnalyticsLiteCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listAnalyticsLiteCalls)
+}
+
+// ListAnalyticsLiteArgsForCall returns the arguments of the i-th ListAnalyticsLite call
+func (f *FakeAnalyticService) ListAnalyticsLiteArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listAnalyticsLiteCalls[i]
+	return call.ctx
+}
+
+type fakeAnalyticServiceListAnalyticsNamesArgs struct {
+	ctx context.Context
+}
+
+// ListAnalyticsNames implements analytic.AnalyticService
+func (f *FakeAnalyticService) ListAnalyticsNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listAnalyticsNamesCalls = append(f.listAnalyticsNamesCalls, fakeAnalyticServiceListAnalyticsNamesArgs{ctx})
+	stub := f.ListAnalyticsNamesStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListAnalyticsNames(ctx)
+	}
+	var r0 []string
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListAnalyticsNamesCallCount returns the number of ListAnalyticsNames calls
+func (f *FakeAnalyticService) ListAnalyticsNamesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listAnalyticsNamesCalls)
+}
+
+// ListAnalyticsNamesArgsForCall returns the arguments of the i-th ListAnalyticsNames call
+func (f *FakeAnalyticService) ListAnalyticsNamesArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listAnalyticsNamesCalls[i]
+	return call.ctx
+}
+
+type fakeAnalyticServiceListAnalyticsCategoriesArgs struct {
+	ctx context.Context
+}
+
+// ListAnalyticsCategories implements analytic.AnalyticService
+func (f *FakeAnalyticService) ListAnalyticsCategories(ctx context.Context) ([]analytic.AnalyticCategory, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listAnalyticsCategoriesCalls = append(f.listAnalyticsCategoriesCalls, fakeAnalyticServiceListAnalyticsCategoriesArgs{ctx})
+	stub := f.ListAnalyticsCategoriesStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListAnalyticsCategories(ctx)
+	}
+	var r0 []analytic.AnalyticCategory
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListAnalyticsCategoriesCallCount returns the number of ListAnalyticsCategories calls
+func (f *FakeAnalyticService) ListAnalyticsCategoriesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listAnalyticsCategoriesCalls)
+}
+
+// ListAnalyticsCategoriesArgsForCall returns the arguments of the i-th ListAnalyticsCategories call
+func (f *FakeAnalyticService) ListAnalyticsCategoriesArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listAnalyticsCategoriesCalls[i]
+	return call.ctx
+}
+
+type fakeAnalyticServiceListAnalyticsTagsArgs struct {
+	ctx context.Context
+}
+
+// ListAnalyticsTags implements analytic.AnalyticService
+func (f *FakeAnalyticService) ListAnalyticsTags(ctx context.Context) ([]analytic.AnalyticTag, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listAnalyticsTagsCalls = append(f.listAnalyticsTagsCalls, fakeAnalyticServiceListAnalyticsTagsArgs{ctx})
+	stub := f.ListAnalyticsTagsStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListAnalyticsTags(ctx)
+	}
+	var r0 []analytic.AnalyticTag
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListAnalyticsTagsCallCount returns the number of ListAnalyticsTags calls
+func (f *FakeAnalyticService) ListAnalyticsTagsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listAnalyticsTagsCalls)
+}
+
+// ListAnalyticsTagsArgsForCall returns the arguments of the i-th ListAnalyticsTags call
+func (f *FakeAnalyticService) ListAnalyticsTagsArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listAnalyticsTagsCalls[i]
+	return call.ctx
+}
+
+type fakeAnalyticServiceListAnalyticsFilterOptionsArgs struct {
+	ctx context.Context
+}
+
+// ListAnalyticsFilterOptions implements analytic.AnalyticService
+func (f *FakeAnalyticService) ListAnalyticsFilterOptions(ctx context.Context) (*analytic.AnalyticsFilterOptions, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listAnalyticsFilterOptionsCalls = append(f.listAnalyticsFilterOptionsCalls, fakeAnalyticServiceListAnalyticsFilterOptionsArgs{ctx})
+	stub := f.ListAnalyticsFilterOptionsStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListAnalyticsFilterOptions(ctx)
+	}
+	var r0 *analytic.AnalyticsFilterOptions
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListAnalyticsFilterOptionsCallCount returns the number of ListAnalyticsFilterOptions calls
+func (f *FakeAnalyticService) ListAnalyticsFilterOptionsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listAnalyticsFilterOptionsCalls)
+}
+
+// ListAnalyticsFilterOptionsArgsForCall returns the arguments of the i-th ListAnalyticsFilterOptions call
+func (f *FakeAnalyticService) ListAnalyticsFilterOptionsArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listAnalyticsFilterOptionsCalls[i]
+	return call.ctx
+}
+
+// FakeAnalyticSetService is a fake analyticset.AnalyticSetService.
+// Each method records its arguments, then calls the method's Stub when set,
+// otherwise Impl, otherwise returns zero values.
+type FakeAnalyticSetService struct {
+	// Impl handles calls without a stub
+	Impl analyticset.AnalyticSetService
+
+	CreateAnalyticSetStub    func(ctx context.Context, req *analyticset.CreateAnalyticSetRequest) (*analyticset.AnalyticSet, *interfaces.Response, error)
+	GetAnalyticSetStub       func(ctx context.Context, uuid string) (*analyticset.AnalyticSet, *interfaces.Response, error)
+	GetAnalyticSetByNameStub func(ctx context.Context, name string) (*analyticset.AnalyticSet, *interfaces.Response, error)
+	UpdateAnalyticSetStub    func(ctx context.Context, uuid string, req *analyticset.UpdateAnalyticSetRequest) (*analyticset.AnalyticSet, *interfaces.Response, error)
+	UpsertAnalyticSetStub    func(ctx context.Context, req *analyticset.CreateAnalyticSetRequest, opts ...client.UpsertOption) (*analyticset.AnalyticSet, client.UpsertAction, *interfaces.Response, error)
+	CloneAnalyticSetStub     func(ctx context.Context, uuid string, newName string) (*analyticset.AnalyticSet, *interfaces.Response, error)
+	DeleteAnalyticSetStub    func(ctx context.Context, uuid string) (*interfaces.Response, error)
+	ListAnalyticSetsStub     func(ctx context.Context) ([]analyticset.AnalyticSet, *interfaces.Response, error)
+
+	mu                        sync.Mutex
+	createAnalyticSetCalls    []fakeAnalyticSetServiceCreateAnalyticSetArgs
+	getAnalyticSetCalls       []fakeAnalyticSetServiceGetAnalyticSetArgs
+	getAnalyticSetByNameCalls []fakeAnalyticSetServiceGetAnalyticSetByNameArgs
+	updateAnalyticSetCalls    []fakeAnalyticSetServiceUpdateAnalyticSetArgs
+	upsertAnalyticSetCalls    []fakeAnalyticSetServiceUpsertAnalyticSetArgs
+	cloneAnalyticSetCalls     []fakeAnalyticSetServiceCloneAnalyticSetArgs
+	deleteAnalyticSetCalls    []fakeAnalyticSetServiceDeleteAnalyticSetArgs
+	listAnalyticSetsCalls     []fakeAnalyticSetServiceListAnalyticSetsArgs
+}
+
+var _ analyticset.AnalyticSetService = (*FakeAnalyticSetService)(nil)
+
+type fakeAnalyticSetServiceCreateAnalyticSetArgs struct {
+	ctx context.Context
+	req *analyticset.CreateAnalyticSetRequest
+}
+
+// CreateAnalyticSet implements analyticset.AnalyticSetService
+func (f *FakeAnalyticSetService) CreateAnalyticSet(ctx context.Context, req *analyticset.CreateAnalyticSetRequest) (*analyticset.AnalyticSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.createAnalyticSetCalls = append(f.createAnalyticSetCalls, fakeAnalyticSetServiceCreateAnalyticSetArgs{ctx, req})
+	stub := f.CreateAnalyticSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.CreateAnalyticSet(ctx, req)
+	}
+	var r0 *analyticset.AnalyticSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateAnalyticSetCallCount returns the number of CreateAnalyticSet calls
+func (f *FakeAnalyticSetService) CreateAnalyticSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.createAnalyticSetCalls)
+}
+
+// CreateAnalyticSetArgsForCall returns the arguments of the i-th CreateAnalyticSet call
+func (f *FakeAnalyticSetService) CreateAnalyticSetArgsForCall(i int) (context.Context, *analyticset.CreateAnalyticSetRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.createAnalyticSetCalls[i]
+	return call.ctx, call.req
+}
+
+type fakeAnalyticSetServiceGetAnalyticSetArgs struct {
+	ctx  context.Context
+	uuid string
+}
+
+// GetAnalyticSet implements analyticset.AnalyticSetService
+func (f *FakeAnalyticSetService) GetAnalyticSet(ctx context.Context, uuid string) (*analyticset.AnalyticSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getAnalyticSetCalls = append(f.getAnalyticSetCalls, fakeAnalyticSetServiceGetAnalyticSetArgs{ctx, uuid})
+	stub := f.GetAnalyticSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetAnalyticSet(ctx, uuid)
+	}
+	var r0 *analyticset.AnalyticSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetAnalyticSetCallCount returns the number of GetAnalyticSet calls
+func (f *FakeAnalyticSetService) GetAnalyticSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAnalyticSetCalls)
+}
+
+// GetAnalyticSetArgsForCall returns the arguments of the i-th GetAnalyticSet call
+func (f *FakeAnalyticSetService) GetAnalyticSetArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getAnalyticSetCalls[i]
+	return call.ctx, call.uuid
+}
+
+type fakeAnalyticSetServiceGetAnalyticSetByNameArgs struct {
+	ctx  context.Context
+	name string
+}
+
+// GetAnalyticSetByName implements analyticset.AnalyticSetService
+func (f *FakeAnalyticSetService) GetAnalyticSetByName(ctx context.Context, name string) (*analyticset.AnalyticSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getAnalyticSetByNameCalls = append(f.getAnalyticSetByNameCalls, fakeAnalyticSetServiceGetAnalyticSetByNameArgs{ctx, name})
+	stub := f.GetAnalyticSetByNameStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetAnalyticSetByName(ctx, name)
+	}
+	var r0 *analyticset.AnalyticSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetAnalyticSetByNameCallCount returns the number of GetAnalyticSetByName calls
+func (f *FakeAnalyticSetService) GetAnalyticSetByNameCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAnalyticSetByNameCalls)
+}
+
+// GetAnalyticSetByNameArgsForCall returns the arguments of the i-th GetAnalyticSetByName call
+func (f *FakeAnalyticSetService) GetAnalyticSetByNameArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getAnalyticSetByNameCalls[i]
+	return call.ctx, call.name
+}
+
+type fakeAnalyticSetServiceUpdateAnalyticSetArgs struct {
+	ctx  context.Context
+	uuid string
+	req  *analyticset.UpdateAnalyticSetRequest
+}
+
+// UpdateAnalyticSet implements analyticset.AnalyticSetService
+func (f *FakeAnalyticSetService) UpdateAnalyticSet(ctx context.Context, uuid string, req *analyticset.UpdateAnalyticSetRequest) (*analyticset.AnalyticSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updateAnalyticSetCalls = append(f.updateAnalyticSetCalls, fakeAnalyticSetServiceUpdateAnalyticSetArgs{ctx, uuid, req})
+	stub := f.UpdateAnalyticSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdateAnalyticSet(ctx, uuid, req)
+	}
+	var r0 *analyticset.AnalyticSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateAnalyticSetCallCount returns the number of UpdateAnalyticSet calls
+func (f *FakeAnalyticSetService) UpdateAnalyticSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updateAnalyticSetCalls)
+}
+
+// UpdateAnalyticSetArgsForCall returns the arguments of the i-th UpdateAnalyticSet call
+func (f *FakeAnalyticSetService) UpdateAnalyticSetArgsForCall(i int) (context.Context, string, *analyticset.UpdateAnalyticSetRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updateAnalyticSetCalls[i]
+	return call.ctx, call.uuid, call.req
+}
+
+type fakeAnalyticSetServiceUpsertAnalyticSetArgs struct {
+	ctx  context.Context
+	req  *analyticset.CreateAnalyticSetRequest
+	opts []client.UpsertOption
+}
+
+// UpsertAnalyticSet implements analyticset.AnalyticSetService
+func (f *FakeAnalyticSetService) UpsertAnalyticSet(ctx context.Context, req *analyticset.CreateAnalyticSetRequest, opts ...client.UpsertOption) (*analyticset.AnalyticSet, client.UpsertAction, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.upsertAnalyticSetCalls = append(f.upsertAnalyticSetCalls, fakeAnalyticSetServiceUpsertAnalyticSetArgs{ctx, req, opts})
+	stub := f.UpsertAnalyticSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req, opts...)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpsertAnalyticSet(ctx, req, opts...)
+	}
+	var r0 *analyticset.AnalyticSet
+	var r1 client.UpsertAction
+	var r2 *interfaces.Response
+	var r3 error
+	return r0, r1, r2, r3
+}
+
+// UpsertAnalyticSetCallCount returns the number of UpsertAnalyticSet calls
+func (f *FakeAnalyticSetService) UpsertAnalyticSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.upsertAnalyticSetCalls)
+}
+
+// UpsertAnalyticSetArgsForCall returns the arguments of the i-th UpsertAnalyticSet call
+func (f *FakeAnalyticSetService) UpsertAnalyticSetArgsForCall(i int) (context.Context, *analyticset.CreateAnalyticSetRequest, []client.UpsertOption) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.upsertAnalyticSetCalls[i]
+	return call.ctx, call.req, call.opts
+}
+
+type fakeAnalyticSetServiceCloneAnalyticSetArgs struct {
+	ctx     context.Context
+	uuid    string
+	newName string
+}
+
+// CloneAnalyticSet implements analyticset.AnalyticSetService
+func (f *FakeAnalyticSetService) CloneAnalyticSet(ctx context.Context, uuid string, newName string) (*analyticset.AnalyticSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.cloneAnalyticSetCalls = append(f.cloneAnalyticSetCalls, fakeAnalyticSetServiceCloneAnalyticSetArgs{ctx, uuid, newName})
+	stub := f.CloneAnalyticSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid, newName)
+	}
+	if f.Impl != nil {
+		return f.Impl.CloneAnalyticSet(ctx, uuid, newName)
+	}
+	var r0 *analyticset.AnalyticSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CloneAnalyticSetCallCount returns the number of CloneAnalyticSet calls
+func (f *FakeAnalyticSetService) CloneAnalyticSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.cloneAnalyticSetCalls)
+}
+
+// CloneAnalyticSetArgsForCall returns the arguments of the i-th CloneAnalyticSet call
+func (f *FakeAnalyticSetService) CloneAnalyticSetArgsForCall(i int) (context.Context, string, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.cloneAnalyticSetCalls[i]
+	return call.ctx, call.uuid, call.newName
+}
+
+type fakeAnalyticSetServiceDeleteAnalyticSetArgs struct {
+	ctx  context.Context
+	uuid string
+}
+
+// DeleteAnalyticSet implements analyticset.AnalyticSetService
+func (f *FakeAnalyticSetService) DeleteAnalyticSet(ctx context.Context, uuid string) (*interfaces.Response, error) {
+	f.mu.Lock()
+	f.deleteAnalyticSetCalls = append(f.deleteAnalyticSetCalls, fakeAnalyticSetServiceDeleteAnalyticSetArgs{ctx, uuid})
+	stub := f.DeleteAnalyticSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid)
+	}
+	if f.Impl != nil {
+		return f.Impl.DeleteAnalyticSet(ctx, uuid)
+	}
+	var r0 *interfaces.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteAnalyticSetCallCount returns the number of DeleteAnalyticSet calls
+func (f *FakeAnalyticSetService) DeleteAnalyticSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deleteAnalyticSetCalls)
+}
+
+// DeleteAnalyticSetArgsForCall returns the arguments of the i-th DeleteAnalyticSet call
+func (f *FakeAnalyticSetService) DeleteAnalyticSetArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.deleteAnalyticSetCalls[i]
+	return call.ctx, call.uuid
+}
+
+type fakeAnalyticSetServiceListAnalyticSetsArgs struct {
+	ctx context.Context
+}
+
+// ListAnalyticSets implements analyticset.AnalyticSetService
+func (f *FakeAnalyticSetService) ListAnalyticSets(ctx context.Context) ([]analyticset.AnalyticSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listAnalyticSetsCalls = append(f.listAnalyticSetsCalls, fakeAnalyticSetServiceListAnalyticSetsArgs{ctx})
+	stub := f.ListAnalyticSetsStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListAnalyticSets(ctx)
+	}
+	var r0 []analyticset.AnalyticSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListAnalyticSetsCallCount returns the number of ListAnalyticSets calls
+func (f *FakeAnalyticSetService) ListAnalyticSetsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listAnalyticSetsCalls)
+}
+
+// ListAnalyticSetsArgsForCall returns the arguments of the i-th ListAnalyticSets call
+func (f *FakeAnalyticSetService) ListAnalyticSetsArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listAnalyticSetsCalls[i]
+	return call.ctx
+}
+
+// FakePreventListService is a fake custompreventlist.PreventListService.
+// Each method records its arguments, then calls the method's Stub when set,
+// otherwise Impl, otherwise returns zero values.
+type FakePreventListService struct {
+	// Impl handles calls without a stub
+	Impl custompreventlist.PreventListService
+
+	CreatePreventListStub    func(ctx context.Context, req *custompreventlist.CreatePreventListRequest) (*custompreventlist.PreventList, *interfaces.Response, error)
+	GetPreventListStub       func(ctx context.Context, id string) (*custompreventlist.PreventList, *interfaces.Response, error)
+	GetPreventListByNameStub func(ctx context.Context, name string) (*custompreventlist.PreventList, *interfaces.Response, error)
+	UpdatePreventListStub    func(ctx context.Context, id string, req *custompreventlist.UpdatePreventListRequest) (*custompreventlist.PreventList, *interfaces.Response, error)
+	UpsertPreventListStub    func(ctx context.Context, req *custompreventlist.CreatePreventListRequest, opts ...client.UpsertOption) (*custompreventlist.PreventList, client.UpsertAction, *interfaces.Response, error)
+	ClonePreventListStub     func(ctx context.Context, id string, newName string) (*custompreventlist.PreventList, *interfaces.Response, error)
+	DeletePreventListStub    func(ctx context.Context, id string) (*interfaces.Response, error)
+	ListPreventListsStub     func(ctx context.Context) ([]custompreventlist.PreventList, *interfaces.Response, error)
+	ListPreventListNamesStub func(ctx context.Context) ([]string, *interfaces.Response, error)
+
+	mu                        sync.Mutex
+	createPreventListCalls    []fakePreventListServiceCreatePreventListArgs
+	getPreventListCalls       []fakePreventListServiceGetPreventListArgs
+	getPreventListByNameCalls []fakePreventListServiceGetPreventListByNameArgs
+	updatePreventListCalls    []fakePreventListServiceUpdatePreventListArgs
+	upsertPreventListCalls    []fakePreventListServiceUpsertPreventListArgs
+	clonePreventListCalls     []fakePreventListServiceClonePreventListArgs
+	deletePreventListCalls    []fakePreventListServiceDeletePreventListArgs
+	listPreventListsCalls     []fakePreventListServiceListPreventListsArgs
+	listPreventListNamesCalls []fakePreventListServiceListPreventListNamesArgs
+}
+
+var _ custompreventlist.PreventListService = (*FakePreventListService)(nil)
+
+type fakePreventListServiceCreatePreventListArgs struct {
+	ctx context.Context
+	req *custompreventlist.CreatePreventListRequest
+}
+
+// CreatePreventList implements custompreventlist.PreventListService
+func (f *FakePreventListService) CreatePreventList(ctx context.Context, req *custompreventlist.CreatePreventListRequest) (*custompreventlist.PreventList, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.createPreventListCalls = append(f.createPreventListCalls, fakePreventListServiceCreatePreventListArgs{ctx, req})
+	stub := f.CreatePreventListStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.CreatePreventList(ctx, req)
+	}
+	var r0 *custompreventlist.PreventList
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreatePreventListCallCount returns the number of CreatePreventList calls
+func (f *FakePreventListService) CreatePreventListCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.createPreventListCalls)
+}
+
+// CreatePreventListArgsForCall returns the arguments of the i-th CreatePreventList call
+func (f *FakePreventListService) CreatePreventListArgsForCall(i int) (context.Context, *custompreventlist.CreatePreventListRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.createPreventListCalls[i]
+	return call.ctx, call.req
+}
+
+type fakePreventListServiceGetPreventListArgs struct {
+	ctx context.Context
+	id  string
+}
+
+// GetPreventList implements custompreventlist.PreventListService
+func (f *FakePreventListService) GetPreventList(ctx context.Context, id string) (*custompreventlist.PreventList, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getPreventListCalls = append(f.getPreventListCalls, fakePreventListServiceGetPreventListArgs{ctx, id})
+	stub := f.GetPreventListStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetPreventList(ctx, id)
+	}
+	var r0 *custompreventlist.PreventList
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetPreventListCallCount returns the number of GetPreventList calls
+func (f *FakePreventListService) GetPreventListCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getPreventListCalls)
+}
+
+// GetPreventListArgsForCall returns the arguments of the i-th GetPreventList call
+func (f *FakePreventListService) GetPreventListArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getPreventListCalls[i]
+	return call.ctx, call.id
+}
+
+type fakePreventListServiceGetPreventListByNameArgs struct {
+	ctx  context.Context
+	name string
+}
+
+// GetPreventListByName implements custompreventlist.PreventListService
+func (f *FakePreventListService) GetPreventListByName(ctx context.Context, name string) (*custompreventlist.PreventList, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getPreventListByNameCalls = append(f.getPreventListByNameCalls, fakePreventListServiceGetPreventListByNameArgs{ctx, name})
+	stub := f.GetPreventListByNameStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetPreventListByName(ctx, name)
+	}
+	var r0 *custompreventlist.PreventList
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetPreventListByNameCallCount returns the number of GetPreventListByName calls
+func (f *FakePreventListService) GetPreventListByNameCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getPreventListByNameCalls)
+}
+
+// GetPreventListByNameArgsForCall returns the arguments of the i-th GetPreventListByName call
+func (f *FakePreventListService) GetPreventListByNameArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getPreventListByNameCalls[i]
+	return call.ctx, call.name
+}
+
+type fakePreventListServiceUpdatePreventListArgs struct {
+	ctx context.Context
+	id  string
+	req *custompreventlist.UpdatePreventListRequest
+}
+
+// UpdatePreventList implements custompreventlist.PreventListService
+func (f *FakePreventListService) UpdatePreventList(ctx context.Context, id string, req *custompreventlist.UpdatePreventListRequest) (*custompreventlist.PreventList, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updatePreventListCalls = append(f.updatePreventListCalls, fakePreventListServiceUpdatePreventListArgs{ctx, id, req})
+	stub := f.UpdatePreventListStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdatePreventList(ctx, id, req)
+	}
+	var r0 *custompreventlist.PreventList
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdatePreventListCallCount returns the number of UpdatePreventList calls
+func (f *FakePreventListService) UpdatePreventListCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updatePreventListCalls)
+}
+
+// UpdatePreventListArgsForCall returns the arguments of the i-th UpdatePreventList call
+func (f *FakePreventListService) UpdatePreventListArgsForCall(i int) (context.Context, string, *custompreventlist.UpdatePreventListRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updatePreventListCalls[i]
+	return call.ctx, call.id, call.req
+}
+
+type fakePreventListServiceUpsertPreventListArgs struct {
+	ctx  context.Context
+	req  *custompreventlist.CreatePreventListRequest
+	opts []client.UpsertOption
+}
+
+// UpsertPreventList implements custompreventlist.PreventListService
+func (f *FakePreventListService) UpsertPreventList(ctx context.Context, req *custompreventlist.CreatePreventListRequest, opts ...client.UpsertOption) (*custompreventlist.PreventList, client.UpsertAction, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.upsertPreventListCalls = append(f.upsertPreventListCalls, fakePreventListServiceUpsertPreventListArgs{ctx, req, opts})
+	stub := f.UpsertPreventListStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req, opts...)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpsertPreventList(ctx, req, opts...)
+	}
+	var r0 *custompreventlist.PreventList
+	var r1 client.UpsertAction
+	var r2 *interfaces.Response
+	var r3 error
+	return r0, r1, r2, r3
+}
+
+// UpsertPreventListCallCount returns the number of UpsertPreventList calls
+func (f *FakePreventListService) UpsertPreventListCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.upsertPreventListCalls)
+}
+
+// UpsertPreventListArgsForCall returns the arguments of the i-th UpsertPreventList call
+func (f *FakePreventListService) UpsertPreventListArgsForCall(i int) (context.Context, *custompreventlist.CreatePreventListRequest, []client.UpsertOption) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.upsertPreventListCalls[i]
+	return call.ctx, call.req, call.opts
+}
+
+type fakePreventListServiceClonePreventListArgs struct {
+	ctx     context.Context
+	id      string
+	newName string
+}
+
+// ClonePreventList implements custompreventlist.PreventListService
+func (f *FakePreventListService) ClonePreventList(ctx context.Context, id string, newName string) (*custompreventlist.PreventList, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.clonePreventListCalls = append(f.clonePreventListCalls, fakePreventListServiceClonePreventListArgs{ctx, id, newName})
+	stub := f.ClonePreventListStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, newName)
+	}
+	if f.Impl != nil {
+		return f.Impl.ClonePreventList(ctx, id, newName)
+	}
+	var r0 *custompreventlist.PreventList
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ClonePreventListCallCount returns the number of ClonePreventList calls
+func (f *FakePreventListService) ClonePreventListCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.clonePreventListCalls)
+}
+
+// ClonePreventListArgsForCall returns the arguments of the i-th ClonePreventList call
+func (f *FakePreventListService) ClonePreventListArgsForCall(i int) (context.Context, string, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.clonePreventListCalls[i]
+	return call.ctx, call.id, call.newName
+}
+
+type fakePreventListServiceDeletePreventListArgs struct {
+	ctx context.Context
+	id  string
+}
+
+// DeletePreventList implements custompreventlist.PreventListService
+func (f *FakePreventListService) DeletePreventList(ctx context.Context, id string) (*interfaces.Response, error) {
+	f.mu.Lock()
+	f.deletePreventListCalls = append(f.deletePreventListCalls, fakePreventListServiceDeletePreventListArgs{ctx, id})
+	stub := f.DeletePreventListStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.DeletePreventList(ctx, id)
+	}
+	var r0 *interfaces.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeletePreventListCallCount returns the number of DeletePreventList calls
+func (f *FakePreventListService) DeletePreventListCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deletePreventListCalls)
+}
+
+// DeletePreventListArgsForCall returns the arguments of the i-th DeletePreventList call
+func (f *FakePreventListService) DeletePreventListArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.deletePreventListCalls[i]
+	return call.ctx, call.id
+}
+
+type fakePreventListServiceListPreventListsArgs struct {
+	ctx context.Context
+}
+
+// ListPreventLists implements custompreventlist.PreventListService
+func (f *FakePreventListService) ListPreventLists(ctx context.Context) ([]custompreventlist.PreventList, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listPreventListsCalls = append(f.listPreventListsCalls, fakePreventListServiceListPreventListsArgs{ctx})
+	stub := f.ListPreventListsStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListPreventLists(ctx)
+	}
+	var r0 []custompreventlist.PreventList
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListPreventListsCallCount returns the number of ListPreventLists calls
+func (f *FakePreventListService) ListPreventListsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listPreventListsCalls)
+}
+
+// ListPreventListsArgsForCall returns the arguments of the i-th ListPreventLists call
+func (f *FakePreventListService) ListPreventListsArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listPreventListsCalls[i]
+	return call.ctx
+}
+
+type fakePreventListServiceListPreventListNamesArgs struct {
+	ctx context.Context
+}
+
+// ListPreventListNames implements custompreventlist.PreventListService
+func (f *FakePreventListService) ListPreventListNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listPreventListNamesCalls = append(f.listPreventListNamesCalls, fakePreventListServiceListPreventListNamesArgs{ctx})
+	stub := f.ListPreventListNamesStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListPreventListNames(ctx)
+	}
+	var r0 []string
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListPreventListNamesCallCount returns the number of ListPreventListNames calls
+func (f *FakePreventListService) ListPreventListNamesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listPreventListNamesCalls)
+}
+
+// ListPreventListNamesArgsForCall returns the arguments of the i-th ListPreventListNames call
+func (f *FakePreventListService) ListPreventListNamesArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listPreventListNamesCalls[i]
+	return call.ctx
+}
+
+// FakeExceptionSetService is a fake exceptionset.ExceptionSetService.
+// Each method records its arguments, then calls the method's Stub when set,
+// otherwise Impl, otherwise returns zero values.
+type FakeExceptionSetService struct {
+	// Impl handles calls without a stub
+	Impl exceptionset.ExceptionSetService
+
+	CreateExceptionSetStub    func(ctx context.Context, req *exceptionset.CreateExceptionSetRequest) (*exceptionset.ExceptionSet, *interfaces.Response, error)
+	GetExceptionSetStub       func(ctx context.Context, uuid string) (*exceptionset.ExceptionSet, *interfaces.Response, error)
+	GetExceptionSetByNameStub func(ctx context.Context, name string) (*exceptionset.ExceptionSet, *interfaces.Response, error)
+	UpdateExceptionSetStub    func(ctx context.Context, uuid string, req *exceptionset.UpdateExceptionSetRequest) (*exceptionset.ExceptionSet, *interfaces.Response, error)
+	PatchExceptionSetStub     func(ctx context.Context, uuid string, patch func(*exceptionset.UpdateExceptionSetRequest)) (*exceptionset.ExceptionSet, *interfaces.Response, error)
+	UpsertExceptionSetStub    func(ctx context.Context, req *exceptionset.CreateExceptionSetRequest, opts ...client.UpsertOption) (*exceptionset.ExceptionSet, client.UpsertAction, *interfaces.Response, error)
+	CloneExceptionSetStub     func(ctx context.Context, uuid string, newName string) (*exceptionset.ExceptionSet, *interfaces.Response, error)
+	DeleteExceptionSetStub    func(ctx context.Context, uuid string) (*interfaces.Response, error)
+	ListExceptionSetsStub     func(ctx context.Context) ([]exceptionset.ExceptionSetListItem, *interfaces.Response, error)
+	ListExceptionSetNamesStub func(ctx context.Context) ([]string, *interfaces.Response, error)
+
+	mu                         sync.Mutex
+	createExceptionSetCalls    []fakeExceptionSetServiceCreateExceptionSetArgs
+	getExceptionSetCalls       []fakeExceptionSetServiceGetExceptionSetArgs
+	getExceptionSetByNameCalls []fakeExceptionSetServiceGetExceptionSetByNameArgs
+	updateExceptionSetCalls    []fakeExceptionSetServiceUpdateExceptionSetArgs
+	patchExceptionSetCalls     []fakeExceptionSetServicePatchExceptionSetArgs
+	upsertExceptionSetCalls    []fakeExceptionSetServiceUpsertExceptionSetArgs
+	cloneExceptionSetCalls     []fakeExceptionSetServiceCloneExceptionSetArgs
+	deleteExceptionSetCalls    []fakeExceptionSetServiceDeleteExceptionSetArgs
+	listExceptionSetsCalls     []fakeExceptionSetServiceListExceptionSetsArgs
+	listExceptionSetNamesCalls []fakeExceptionSetServiceListExceptionSetNamesArgs
+}
+
+var _ exceptionset.ExceptionSetService = (*FakeExceptionSetService)(nil)
+
+type fakeExceptionSetServiceCreateExceptionSetArgs struct {
+	ctx context.Context
+	req *exceptionset.CreateExceptionSetRequest
+}
+
+// CreateExceptionSet implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) CreateExceptionSet(ctx context.Context, req *exceptionset.CreateExceptionSetRequest) (*exceptionset.ExceptionSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.createExceptionSetCalls = append(f.createExceptionSetCalls, fakeExceptionSetServiceCreateExceptionSetArgs{ctx, req})
+	stub := f.CreateExceptionSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.CreateExceptionSet(ctx, req)
+	}
+	var r0 *exceptionset.ExceptionSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateExceptionSetCallCount returns the number of CreateExceptionSet calls
+func (f *FakeExceptionSetService) CreateExceptionSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.createExceptionSetCalls)
+}
+
+// CreateExceptionSetArgsForCall returns the arguments of the i-th CreateExceptionSet call
+func (f *FakeExceptionSetService) CreateExceptionSetArgsForCall(i int) (context.Context, *exceptionset.CreateExceptionSetRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.createExceptionSetCalls[i]
+	return call.ctx, call.req
+}
+
+type fakeExceptionSetServiceGetExceptionSetArgs struct {
+	ctx  context.Context
+	uuid string
+}
+
+// GetExceptionSet implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) GetExceptionSet(ctx context.Context, uuid string) (*exceptionset.ExceptionSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getExceptionSetCalls = append(f.getExceptionSetCalls, fakeExceptionSetServiceGetExceptionSetArgs{ctx, uuid})
+	stub := f.GetExceptionSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetExceptionSet(ctx, uuid)
+	}
+	var r0 *exceptionset.ExceptionSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetExceptionSetCallCount returns the number of GetExceptionSet calls
+func (f *FakeExceptionSetService) GetExceptionSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getExceptionSetCalls)
+}
+
+// GetExceptionSetArgsForCall returns the arguments of the i-th GetExceptionSet call
+func (f *FakeExceptionSetService) GetExceptionSetArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getExceptionSetCalls[i]
+	return call.ctx, call.uuid
+}
+
+type fakeExceptionSetServiceGetExceptionSetByNameArgs struct {
+	ctx  context.Context
+	name string
+}
+
+// GetExceptionSetByName implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) GetExceptionSetByName(ctx context.Context, name string) (*exceptionset.ExceptionSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getExceptionSetByNameCalls = append(f.getExceptionSetByNameCalls, fakeExceptionSetServiceGetExceptionSetByNameArgs{ctx, name})
+	stub := f.GetExceptionSetByNameStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetExceptionSetByName(ctx, name)
+	}
+	var r0 *exceptionset.ExceptionSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetExceptionSetByNameCallCount returns the number of GetExceptionSetByName calls
+func (f *FakeExceptionSetService) GetExceptionSetByNameCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getExceptionSetByNameCalls)
+}
+
+// GetExceptionSetByNameArgsForCall returns the arguments of the i-th GetExceptionSetByName call
+func (f *FakeExceptionSetService) GetExceptionSetByNameArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getExceptionSetByNameCalls[i]
+	return call.ctx, call.name
+}
+
+type fakeExceptionSetServiceUpdateExceptionSetArgs struct {
+	ctx  context.Context
+	uuid string
+	req  *exceptionset.UpdateExceptionSetRequest
+}
+
+// UpdateExceptionSet implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) UpdateExceptionSet(ctx context.Context, uuid string, req *exceptionset.UpdateExceptionSetRequest) (*exceptionset.ExceptionSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updateExceptionSetCalls = append(f.updateExceptionSetCalls, fakeExceptionSetServiceUpdateExceptionSetArgs{ctx, uuid, req})
+	stub := f.UpdateExceptionSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdateExceptionSet(ctx, uuid, req)
+	}
+	var r0 *exceptionset.ExceptionSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateExceptionSetCallCount returns the number of UpdateExceptionSet calls
+func (f *FakeExceptionSetService) UpdateExceptionSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updateExceptionSetCalls)
+}
+
+// UpdateExceptionSetArgsForCall returns the arguments of the i-th UpdateExceptionSet call
+func (f *FakeExceptionSetService) UpdateExceptionSetArgsForCall(i int) (context.Context, string, *exceptionset.UpdateExceptionSetRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updateExceptionSetCalls[i]
+	return call.ctx, call.uuid, call.req
+}
+
+type fakeExceptionSetServicePatchExceptionSetArgs struct {
+	ctx   context.Context
+	uuid  string
+	patch func(*exceptionset.UpdateExceptionSetRequest)
+}
+
+// PatchExceptionSet implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) PatchExceptionSet(ctx context.Context, uuid string, patch func(*exceptionset.UpdateExceptionSetRequest)) (*exceptionset.ExceptionSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.patchExceptionSetCalls = append(f.patchExceptionSetCalls, fakeExceptionSetServicePatchExceptionSetArgs{ctx, uuid, patch})
+	stub := f.PatchExceptionSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid, patch)
+	}
+	if f.Impl != nil {
+		return f.Impl.PatchExceptionSet(ctx, uuid, patch)
+	}
+	var r0 *exceptionset.ExceptionSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// PatchExceptionSetCallCount returns the number of PatchExceptionSet calls
+func (f *FakeExceptionSetService) PatchExceptionSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.patchExceptionSetCalls)
+}
+
+// PatchExceptionSetArgsForCall returns the arguments of the i-th PatchExceptionSet call
+func (f *FakeExceptionSetService) PatchExceptionSetArgsForCall(i int) (context.Context, string, func(*exceptionset.UpdateExceptionSetRequest)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.patchExceptionSetCalls[i]
+	return call.ctx, call.uuid, call.patch
+}
+
+type fakeExceptionSetServiceUpsertExceptionSetArgs struct {
+	ctx  context.Context
+	req  *exceptionset.CreateExceptionSetRequest
+	opts []client.UpsertOption
+}
+
+// UpsertExceptionSet implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) UpsertExceptionSet(ctx context.Context, req *exceptionset.CreateExceptionSetRequest, opts ...client.UpsertOption) (*exceptionset.ExceptionSet, client.UpsertAction, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.upsertExceptionSetCalls = append(f.upsertExceptionSetCalls, fakeExceptionSetServiceUpsertExceptionSetArgs{ctx, req, opts})
+	stub := f.UpsertExceptionSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req, opts...)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpsertExceptionSet(ctx, req, opts...)
+	}
+	var r0 *exceptionset.ExceptionSet
+	var r1 client.UpsertAction
+	var r2 *interfaces.Response
+	var r3 error
+	return r0, r1, r2, r3
+}
+
+// UpsertExceptionSetCallCount returns the number of UpsertExceptionSet calls
+func (f *FakeExceptionSetService) UpsertExceptionSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.upsertExceptionSetCalls)
+}
+
+// UpsertExceptionSetArgsForCall returns the arguments of the i-th UpsertExceptionSet call
+func (f *FakeExceptionSetService) UpsertExceptionSetArgsForCall(i int) (context.Context, *exceptionset.CreateExceptionSetRequest, []client.UpsertOption) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.upsertExceptionSetCalls[i]
+	return call.ctx, call.req, call.opts
+}
+
+type fakeExceptionSetServiceCloneExceptionSetArgs struct {
+	ctx     context.Context
+	uuid    string
+	newName string
+}
+
+// CloneExceptionSet implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) CloneExceptionSet(ctx context.Context, uuid string, newName string) (*exceptionset.ExceptionSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.cloneExceptionSetCalls = append(f.cloneExceptionSetCalls, fakeExceptionSetServiceCloneExceptionSetArgs{ctx, uuid, newName})
+	stub := f.CloneExceptionSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid, newName)
+	}
+	if f.Impl != nil {
+		return f.Impl.CloneExceptionSet(ctx, uuid, newName)
+	}
+	var r0 *exceptionset.ExceptionSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CloneExceptionSetCallCount returns the number of CloneExceptionSet calls
+func (f *FakeExceptionSetService) CloneExceptionSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.cloneExceptionSetCalls)
+}
+
+// CloneExceptionSetArgsForCall returns the arguments of the i-th CloneExceptionSet call
+func (f *FakeExceptionSetService) CloneExceptionSetArgsForCall(i int) (context.Context, string, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.cloneExceptionSetCalls[i]
+	return call.ctx, call.uuid, call.newName
+}
+
+type fakeExceptionSetServiceDeleteExceptionSetArgs struct {
+	ctx  context.Context
+	uuid string
+}
+
+// DeleteExceptionSet implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) DeleteExceptionSet(ctx context.Context, uuid string) (*interfaces.Response, error) {
+	f.mu.Lock()
+	f.deleteExceptionSetCalls = append(f.deleteExceptionSetCalls, fakeExceptionSetServiceDeleteExceptionSetArgs{ctx, uuid})
+	stub := f.DeleteExceptionSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid)
+	}
+	if f.Impl != nil {
+		return f.Impl.DeleteExceptionSet(ctx, uuid)
+	}
+	var r0 *interfaces.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteExceptionSetCallCount returns the number of DeleteExceptionSet calls
+func (f *FakeExceptionSetService) DeleteExceptionSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deleteExceptionSetCalls)
+}
+
+// DeleteExceptionSetArgsForCall returns the arguments of the i-th DeleteExceptionSet call
+func (f *FakeExceptionSetService) DeleteExceptionSetArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.deleteExceptionSetCalls[i]
+	return call.ctx, call.uuid
+}
+
+type fakeExceptionSetServiceListExceptionSetsArgs struct {
+	ctx context.Context
+}
+
+// ListExceptionSets implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) ListExceptionSets(ctx context.Context) ([]exceptionset.ExceptionSetListItem, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listExceptionSetsCalls = append(f.listExceptionSetsCalls, fakeExceptionSetServiceListExceptionSetsArgs{ctx})
+	stub := f.ListExceptionSetsStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListExceptionSets(ctx)
+	}
+	var r0 []exceptionset.ExceptionSetListItem
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListExceptionSetsCallCount returns the number of ListExceptionSets calls
+func (f *FakeExceptionSetService) ListExceptionSetsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listExceptionSetsCalls)
+}
+
+// ListExceptionSetsArgsForCall returns the arguments of the i-th ListExceptionSets call
+func (f *FakeExceptionSetService) ListExceptionSetsArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listExceptionSetsCalls[i]
+	return call.ctx
+}
+
+type fakeExceptionSetServiceListExceptionSetNamesArgs struct {
+	ctx context.Context
+}
+
+// ListExceptionSetNames implements exceptionset.ExceptionSetService
+func (f *FakeExceptionSetService) ListExceptionSetNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listExceptionSetNamesCalls = append(f.listExceptionSetNamesCalls, fakeExceptionSetServiceListExceptionSetNamesArgs{ctx})
+	stub := f.ListExceptionSetNamesStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListExceptionSetNames(ctx)
+	}
+	var r0 []string
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListExceptionSetNamesCallCount returns the number of ListExceptionSetNames calls
+func (f *FakeExceptionSetService) ListExceptionSetNamesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listExceptionSetNamesCalls)
+}
+
+// ListExceptionSetNamesArgsForCall returns the arguments of the i-th ListExceptionSetNames call
+func (f *FakeExceptionSetService) ListExceptionSetNamesArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listExceptionSetNamesCalls[i]
+	return call.ctx
+}
+
+// FakePlanService is a fake plan.PlanService.
+// Each method records its arguments, then calls the method's Stub when set,
+// otherwise Impl, otherwise returns zero values.
+type FakePlanService struct {
+	// Impl handles calls without a stub
+	Impl plan.PlanService
+
+	CreatePlanStub                        func(ctx context.Context, req *plan.CreatePlanRequest) (*plan.Plan, *interfaces.Response, error)
+	GetPlanStub                           func(ctx context.Context, id string) (*plan.Plan, *interfaces.Response, error)
+	GetPlanByNameStub                     func(ctx context.Context, name string) (*plan.Plan, *interfaces.Response, error)
+	UpdatePlanStub                        func(ctx context.Context, id string, req *plan.UpdatePlanRequest) (*plan.Plan, *interfaces.Response, error)
+	UpdatePlanIfMatchStub                 func(ctx context.Context, id string, expectedHash string, req *plan.UpdatePlanRequest) (*plan.Plan, *interfaces.Response, error)
+	ModifyPlanStub                        func(ctx context.Context, id string, merge func(*plan.Plan, *plan.UpdatePlanRequest) error, attempts int) (*plan.Plan, *interfaces.Response, error)
+	PatchPlanStub                         func(ctx context.Context, id string, patch func(*plan.UpdatePlanRequest)) (*plan.Plan, *interfaces.Response, error)
+	UpsertPlanStub                        func(ctx context.Context, req *plan.CreatePlanRequest, opts ...client.UpsertOption) (*plan.Plan, client.UpsertAction, *interfaces.Response, error)
+	DeletePlanStub                        func(ctx context.Context, id string) (*interfaces.Response, error)
+	ListPlansStub                         func(ctx context.Context) ([]plan.Plan, *interfaces.Response, error)
+	ListPlanNamesStub                     func(ctx context.Context) ([]string, *interfaces.Response, error)
+	GetPlanConfigurationAndSetOptionsStub func(ctx context.Context, req *plan.GetPlanConfigurationAndSetOptionsRequest) (*plan.PlanConfigurationAndSetOptions, *interfaces.Response, error)
+
+	mu                                     sync.Mutex
+	createPlanCalls                        []fakePlanServiceCreatePlanArgs
+	getPlanCalls                           []fakePlanServiceGetPlanArgs
+	getPlanByNameCalls                     []fakePlanServiceGetPlanByNameArgs
+	updatePlanCalls                        []fakePlanServiceUpdatePlanArgs
+	updatePlanIfMatchCalls                 []fakePlanServiceUpdatePlanIfMatchArgs
+	modifyPlanCalls                        []fakePlanServiceModifyPlanArgs
+	patchPlanCalls                         []fakePlanServicePatchPlanArgs
+	upsertPlanCalls                        []fakePlanServiceUpsertPlanArgs
+	deletePlanCalls                        []fakePlanServiceDeletePlanArgs
+	listPlansCalls                         []fakePlanServiceListPlansArgs
+	listPlanNamesCalls                     []fakePlanServiceListPlanNamesArgs
+	getPlanConfigurationAndSetOptionsCalls []fakePlanServiceGetPlanConfigurationAndSetOptionsArgs
+}
+
+var _ plan.PlanService = (*FakePlanService)(nil)
+
+type fakePlanServiceCreatePlanArgs struct {
+	ctx context.Context
+	req *plan.CreatePlanRequest
+}
+
+// CreatePlan implements plan.PlanService
+func (f *FakePlanService) CreatePlan(ctx context.Context, req *plan.CreatePlanRequest) (*plan.Plan, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.createPlanCalls = append(f.createPlanCalls, fakePlanServiceCreatePlanArgs{ctx, req})
+	stub := f.CreatePlanStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.CreatePlan(ctx, req)
+	}
+	var r0 *plan.Plan
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreatePlanCallCount returns the number of CreatePlan calls
+func (f *FakePlanService) CreatePlanCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.createPlanCalls)
+}
+
+// CreatePlanArgsForCall returns the arguments of the i-th CreatePlan call
+func (f *FakePlanService) CreatePlanArgsForCall(i int) (context.Context, *plan.CreatePlanRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.createPlanCalls[i]
+	return call.ctx, call.req
+}
+
+type fakePlanServiceGetPlanArgs struct {
+	ctx context.Context
+	id  string
+}
+
+// GetPlan implements plan.PlanService
+func (f *FakePlanService) GetPlan(ctx context.Context, id string) (*plan.Plan, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getPlanCalls = append(f.getPlanCalls, fakePlanServiceGetPlanArgs{ctx, id})
+	stub := f.GetPlanStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetPlan(ctx, id)
+	}
+	var r0 *plan.Plan
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetPlanCallCount returns the number of GetPlan calls
+func (f *FakePlanService) GetPlanCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getPlanCalls)
+}
+
+// GetPlanArgsForCall returns the arguments of the i-th GetPlan call
+func (f *FakePlanService) GetPlanArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getPlanCalls[i]
+	return call.ctx, call.id
+}
+
+type fakePlanServiceGetPlanByNameArgs struct {
+	ctx  context.Context
+	name string
+}
+
+// GetPlanByName implements plan.PlanService
+func (f *FakePlanService) GetPlanByName(ctx context.Context, name string) (*plan.Plan, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getPlanByNameCalls = append(f.getPlanByNameCalls, fakePlanServiceGetPlanByNameArgs{ctx, name})
+	stub := f.GetPlanByNameStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetPlanByName(ctx, name)
+	}
+	var r0 *plan.Plan
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetPlanByNameCallCount returns the number of GetPlanByName calls
+func (f *FakePlanService) GetPlanByNameCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getPlanByNameCalls)
+}
+
+// GetPlanByNameArgsForCall returns the arguments of the i-th GetPlanByName call
+func (f *FakePlanService) GetPlanByNameArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getPlanByNameCalls[i]
+	return call.ctx, call.name
+}
+
+type fakePlanServiceUpdatePlanArgs struct {
+	ctx context.Context
+	id  string
+	req *plan.UpdatePlanRequest
+}
+
+// UpdatePlan implements plan.PlanService
+func (f *FakePlanService) UpdatePlan(ctx context.Context, id string, req *plan.UpdatePlanRequest) (*plan.Plan, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updatePlanCalls = append(f.updatePlanCalls, fakePlanServiceUpdatePlanArgs{ctx, id, req})
+	stub := f.UpdatePlanStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdatePlan(ctx, id, req)
+	}
+	var r0 *plan.Plan
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdatePlanCallCount returns the number of UpdatePlan calls
+func (f *FakePlanService) UpdatePlanCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updatePlanCalls)
+}
+
+// UpdatePlanArgsForCall returns the arguments of the i-th UpdatePlan call
+func (f *FakePlanService) UpdatePlanArgsForCall(i int) (context.Context, string, *plan.UpdatePlanRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updatePlanCalls[i]
+	return call.ctx, call.id, call.req
+}
+
+type fakePlanServiceUpdatePlanIfMatchArgs struct {
+	ctx          context.Context
+	id           string
+	expectedHash string
+	req          *plan.UpdatePlanRequest
+}
+
+// UpdatePlanIfMatch implements plan.PlanService
+func (f *FakePlanService) UpdatePlanIfMatch(ctx context.Context, id string, expectedHash string, req *plan.UpdatePlanRequest) (*plan.Plan, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updatePlanIfMatchCalls = append(f.updatePlanIfMatchCalls, fakePlanServiceUpdatePlanIfMatchArgs{ctx, id, expectedHash, req})
+	stub := f.UpdatePlanIfMatchStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, expectedHash, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdatePlanIfMatch(ctx, id, expectedHash, req)
+	}
+	var r0 *plan.Plan
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdatePlanIfMatchCallCount returns the number of UpdatePlanIfMatch calls
+func (f *FakePlanService) UpdatePlanIfMatchCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updatePlanIfMatchCalls)
+}
+
+// UpdatePlanIfMatchArgsForCall returns the arguments of the i-th UpdatePlanIfMatch call
+func (f *FakePlanService) UpdatePlanIfMatchArgsForCall(i int) (context.Context, string, string, *plan.UpdatePlanRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updatePlanIfMatchCalls[i]
+	return call.ctx, call.id, call.expectedHash, call.req
+}
+
+type fakePlanServiceModifyPlanArgs struct {
+	ctx      context.Context
+	id       string
+	merge    func(*plan.Plan, *plan.UpdatePlanRequest) error
+	attempts int
+}
+
+// ModifyPlan implements plan.PlanService
+func (f *FakePlanService) ModifyPlan(ctx context.Context, id string, merge func(*plan.Plan, *plan.UpdatePlanRequest) error, attempts int) (*plan.Plan, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.modifyPlanCalls = append(f.modifyPlanCalls, fakePlanServiceModifyPlanArgs{ctx, id, merge, attempts})
+	stub := f.ModifyPlanStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, merge, attempts)
+	}
+	if f.Impl != nil {
+		return f.Impl.ModifyPlan(ctx, id, merge, attempts)
+	}
+	var r0 *plan.Plan
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ModifyPlanCallCount returns the number of ModifyPlan calls
+func (f *FakePlanService) ModifyPlanCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.modifyPlanCalls)
+}
+
+// ModifyPlanArgsForCall returns the arguments of the i-th ModifyPlan call
+func (f *FakePlanService) ModifyPlanArgsForCall(i int) (context.Context, string, func(*plan.Plan, *plan.UpdatePlanRequest) error, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.modifyPlanCalls[i]
+	return call.ctx, call.id, call.merge, call.attempts
+}
+
+type fakePlanServicePatchPlanArgs struct {
+	ctx   context.Context
+	id    string
+	patch func(*plan.UpdatePlanRequest)
+}
+
+// PatchPlan implements plan.PlanService
+func (f *FakePlanService) PatchPlan(ctx context.Context, id string, patch func(*plan.UpdatePlanRequest)) (*plan.Plan, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.patchPlanCalls = append(f.patchPlanCalls, fakePlanServicePatchPlanArgs{ctx, id, patch})
+	stub := f.PatchPlanStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, patch)
+	}
+	if f.Impl != nil {
+		return f.Impl.PatchPlan(ctx, id, patch)
+	}
+	var r0 *plan.Plan
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// PatchPlanCallCount returns the number of PatchPlan calls
+func (f *FakePlanService) PatchPlanCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.patchPlanCalls)
+}
+
+// PatchPlanArgsForCall returns the arguments of the i-th PatchPlan call
+func (f *FakePlanService) PatchPlanArgsForCall(i int) (context.Context, string, func(*plan.UpdatePlanRequest)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.patchPlanCalls[i]
+	return call.ctx, call.id, call.patch
+}
+
+type fakePlanServiceUpsertPlanArgs struct {
+	ctx  context.Context
+	req  *plan.CreatePlanRequest
+	opts []client.UpsertOption
+}
+
+// UpsertPlan implements plan.PlanService
+func (f *FakePlanService) UpsertPlan(ctx context.Context, req *plan.CreatePlanRequest, opts ...client.UpsertOption) (*plan.Plan, client.UpsertAction, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.upsertPlanCalls = append(f.upsertPlanCalls, fakePlanServiceUpsertPlanArgs{ctx, req, opts})
+	stub := f.UpsertPlanStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req, opts...)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpsertPlan(ctx, req, opts...)
+	}
+	var r0 *plan.Plan
+	var r1 client.UpsertAction
+	var r2 *interfaces.Response
+	var r3 error
+	return r0, r1, r2, r3
+}
+
+// UpsertPlanCallCount returns the number of UpsertPlan calls
+func (f *FakePlanService) UpsertPlanCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.upsertPlanCalls)
+}
+
+// UpsertPlanArgsForCall returns the arguments of the i-th UpsertPlan call
+func (f *FakePlanService) UpsertPlanArgsForCall(i int) (context.Context, *plan.CreatePlanRequest, []client.UpsertOption) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.upsertPlanCalls[i]
+	return call.ctx, call.req, call.opts
+}
+
+type fakePlanServiceDeletePlanArgs struct {
+	ctx context.Context
+	id  string
+}
+
+// DeletePlan implements plan.PlanService
+func (f *FakePlanService) DeletePlan(ctx context.Context, id string) (*interfaces.Response, error) {
+	f.mu.Lock()
+	f.deletePlanCalls = append(f.deletePlanCalls, fakePlanServiceDeletePlanArgs{ctx, id})
+	stub := f.DeletePlanStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.DeletePlan(ctx, id)
+	}
+	var r0 *interfaces.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeletePlanCallCount returns the number of DeletePlan calls
+func (f *FakePlanService) DeletePlanCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deletePlanCalls)
+}
+
+// DeletePlanArgsForCall returns the arguments of the i-th DeletePlan call
+func (f *FakePlanService) DeletePlanArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.deletePlanCalls[i]
+	return call.ctx, call.id
+}
+
+type fakePlanServiceListPlansArgs struct {
+	ctx context.Context
+}
+
+// ListPlans implements plan.PlanService
+func (f *FakePlanService) ListPlans(ctx context.Context) ([]plan.Plan, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listPlansCalls = append(f.listPlansCalls, fakePlanServiceListPlansArgs{ctx})
+	stub := f.ListPlansStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListPlans(ctx)
+	}
+	var r0 []plan.Plan
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListPlansCallCount returns the number of ListPlans calls
+func (f *FakePlanService) ListPlansCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listPlansCalls)
+}
+
+// ListPlansArgsForCall returns the arguments of the i-th ListPlans call
+func (f *FakePlanService) ListPlansArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listPlansCalls[i]
+	return call.ctx
+}
+
+type fakePlanServiceListPlanNamesArgs struct {
+	ctx context.Context
+}
+
+// ListPlanNames implements plan.PlanService
+func (f *FakePlanService) ListPlanNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listPlanNamesCalls = append(f.listPlanNamesCalls, fakePlanServiceListPlanNamesArgs{ctx})
+	stub := f.ListPlanNamesStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListPlanNames(ctx)
+	}
+	var r0 []string
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListPlanNamesCallCount returns the number of ListPlanNames calls
+func (f *FakePlanService) ListPlanNamesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listPlanNamesCalls)
+}
+
+// ListPlanNamesArgsForCall returns the arguments of the i-th ListPlanNames call
+func (f *FakePlanService) ListPlanNamesArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listPlanNamesCalls[i]
+	return call.ctx
+}
+
+type fakePlanServiceGetPlanConfigurationAndSetOptionsArgs struct {
+	ctx context.Context
+	req *plan.GetPlanConfigurationAndSetOptionsRequest
+}
+
+// GetPlanConfigurationAndSetOptions implements plan.PlanService
+func (f *FakePlanService) GetPlanConfigurationAndSetOptions(ctx context.Context, req *plan.GetPlanConfigurationAndSetOptionsRequest) (*plan.PlanConfigurationAndSetOptions, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getPlanConfigurationAndSetOptionsCalls = append(f.getPlanConfigurationAndSetOptionsCalls, fakePlanServiceGetPlanConfigurationAndSetOptionsArgs{ctx, req})
+	stub := f.GetPlanConfigurationAndSetOptionsStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetPlanConfigurationAndSetOptions(ctx, req)
+	}
+	var r0 *plan.PlanConfigurationAndSetOptions
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetPlanConfigurationAndSetOptionsCallCount returns the number of GetPlanConfigurationAndSetOptions calls
+func (f *FakePlanService) GetPlanConfigurationAndSetOptionsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getPlanConfigurationAndSetOptionsCalls)
+}
+
+// GetPlanConfigurationAndSetOptionsArgsForCall returns the arguments of the i-th GetPlanConfigurationAndSetOptions call
+func (f *FakePlanService) GetPlanConfigurationAndSetOptionsArgsForCall(i int) (context.Context, *plan.GetPlanConfigurationAndSetOptionsRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getPlanConfigurationAndSetOptionsCalls[i]
+	return call.ctx, call.req
+}
+
+// FakeUSBControlSetService is a fake removablestoragecontrolset.USBControlSetService.
+// Each method records its arguments, then calls the method's Stub when set,
+// otherwise Impl, otherwise returns zero values.
+type FakeUSBControlSetService struct {
+	// Impl handles calls without a stub
+	Impl removablestoragecontrolset.USBControlSetService
+
+	CreateUSBControlSetStub    func(ctx context.Context, req *removablestoragecontrolset.CreateUSBControlSetRequest) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error)
+	GetUSBControlSetStub       func(ctx context.Context, id string) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error)
+	GetUSBControlSetByNameStub func(ctx context.Context, name string) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error)
+	UpdateUSBControlSetStub    func(ctx context.Context, id string, req *removablestoragecontrolset.UpdateUSBControlSetRequest) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error)
+	PatchUSBControlSetStub     func(ctx context.Context, id string, patch func(*removablestoragecontrolset.UpdateUSBControlSetRequest)) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error)
+	UpsertUSBControlSetStub    func(ctx context.Context, req *removablestoragecontrolset.CreateUSBControlSetRequest, opts ...client.UpsertOption) (*removablestoragecontrolset.USBControlSet, client.UpsertAction, *interfaces.Response, error)
+	CloneUSBControlSetStub     func(ctx context.Context, id string, newName string) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error)
+	DeleteUSBControlSetStub    func(ctx context.Context, id string) (*interfaces.Response, error)
+	ListUSBControlSetsStub     func(ctx context.Context) ([]removablestoragecontrolset.USBControlSet, *interfaces.Response, error)
+	ListUSBControlSetNamesStub func(ctx context.Context) ([]string, *interfaces.Response, error)
+
+	mu                          sync.Mutex
+	createUSBControlSetCalls    []fakeUSBControlSetServiceCreateUSBControlSetArgs
+	getUSBControlSetCalls       []fakeUSBControlSetServiceGetUSBControlSetArgs
+	getUSBControlSetByNameCalls []fakeUSBControlSetServiceGetUSBControlSetByNameArgs
+	updateUSBControlSetCalls    []fakeUSBControlSetServiceUpdateUSBControlSetArgs
+	patchUSBControlSetCalls     []fakeUSBControlSetServicePatchUSBControlSetArgs
+	upsertUSBControlSetCalls    []fakeUSBControlSetServiceUpsertUSBControlSetArgs
+	cloneUSBControlSetCalls     []fakeUSBControlSetServiceCloneUSBControlSetArgs
+	deleteUSBControlSetCalls    []fakeUSBControlSetServiceDeleteUSBControlSetArgs
+	listUSBControlSetsCalls     []fakeUSBControlSetServiceListUSBControlSetsArgs
+	listUSBControlSetNamesCalls []fakeUSBControlSetServiceListUSBControlSetNamesArgs
+}
+
+var _ removablestoragecontrolset.USBControlSetService = (*FakeUSBControlSetService)(nil)
+
+type fakeUSBControlSetServiceCreateUSBControlSetArgs struct {
+	ctx context.Context
+	req *removablestoragecontrolset.CreateUSBControlSetRequest
+}
+
+// CreateUSBControlSet implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) CreateUSBControlSet(ctx context.Context, req *removablestoragecontrolset.CreateUSBControlSetRequest) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.createUSBControlSetCalls = append(f.createUSBControlSetCalls, fakeUSBControlSetServiceCreateUSBControlSetArgs{ctx, req})
+	stub := f.CreateUSBControlSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.CreateUSBControlSet(ctx, req)
+	}
+	var r0 *removablestoragecontrolset.USBControlSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateUSBControlSetCallCount returns the number of CreateUSBControlSet calls
+func (f *FakeUSBControlSetService) CreateUSBControlSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.createUSBControlSetCalls)
+}
+
+// CreateUSBControlSetArgsForCall returns the arguments of the i-th CreateUSBControlSet call
+func (f *FakeUSBControlSetService) CreateUSBControlSetArgsForCall(i int) (context.Context, *removablestoragecontrolset.CreateUSBControlSetRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.createUSBControlSetCalls[i]
+	return call.ctx, call.req
+}
+
+type fakeUSBControlSetServiceGetUSBControlSetArgs struct {
+	ctx context.Context
+	id  string
+}
+
+// GetUSBControlSet implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) GetUSBControlSet(ctx context.Context, id string) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getUSBControlSetCalls = append(f.getUSBControlSetCalls, fakeUSBControlSetServiceGetUSBControlSetArgs{ctx, id})
+	stub := f.GetUSBControlSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetUSBControlSet(ctx, id)
+	}
+	var r0 *removablestoragecontrolset.USBControlSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetUSBControlSetCallCount returns the number of GetUSBControlSet calls
+func (f *FakeUSBControlSetService) GetUSBControlSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getUSBControlSetCalls)
+}
+
+// GetUSBControlSetArgsForCall returns the arguments of the i-th GetUSBControlSet call
+func (f *FakeUSBControlSetService) GetUSBControlSetArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getUSBControlSetCalls[i]
+	return call.ctx, call.id
+}
+
+type fakeUSBControlSetServiceGetUSBControlSetByNameArgs struct {
+	ctx  context.Context
+	name string
+}
+
+// GetUSBControlSetByName implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) GetUSBControlSetByName(ctx context.Context, name string) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getUSBControlSetByNameCalls = append(f.getUSBControlSetByNameCalls, fakeUSBControlSetServiceGetUSBControlSetByNameArgs{ctx, name})
+	stub := f.GetUSBControlSetByNameStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetUSBControlSetByName(ctx, name)
+	}
+	var r0 *removablestoragecontrolset.USBControlSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetUSBControlSetByNameCallCount returns the number of GetUSBControlSetByName calls
+func (f *FakeUSBControlSetService) GetUSBControlSetByNameCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getUSBControlSetByNameCalls)
+}
+
+// GetUSBControlSetByNameArgsForCall returns the arguments of the i-th GetUSBControlSetByName call
+func (f *FakeUSBControlSetService) GetUSBControlSetByNameArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getUSBControlSetByNameCalls[i]
+	return call.ctx, call.name
+}
+
+type fakeUSBControlSetServiceUpdateUSBControlSetArgs struct {
+	ctx context.Context
+	id  string
+	req *removablestoragecontrolset.UpdateUSBControlSetRequest
+}
+
+// UpdateUSBControlSet implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) UpdateUSBControlSet(ctx context.Context, id string, req *removablestoragecontrolset.UpdateUSBControlSetRequest) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updateUSBControlSetCalls = append(f.updateUSBControlSetCalls, fakeUSBControlSetServiceUpdateUSBControlSetArgs{ctx, id, req})
+	stub := f.UpdateUSBControlSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdateUSBControlSet(ctx, id, req)
+	}
+	var r0 *removablestoragecontrolset.USBControlSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateUSBControlSetCallCount returns the number of UpdateUSBControlSet calls
+func (f *FakeUSBControlSetService) UpdateUSBControlSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updateUSBControlSetCalls)
+}
+
+// UpdateUSBControlSetArgsForCall returns the arguments of the i-th UpdateUSBControlSet call
+func (f *FakeUSBControlSetService) UpdateUSBControlSetArgsForCall(i int) (context.Context, string, *removablestoragecontrolset.UpdateUSBControlSetRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updateUSBControlSetCalls[i]
+	return call.ctx, call.id, call.req
+}
+
+type fakeUSBControlSetServicePatchUSBControlSetArgs struct {
+	ctx   context.Context
+	id    string
+	patch func(*removablestoragecontrolset.UpdateUSBControlSetRequest)
+}
+
+// PatchUSBControlSet implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) PatchUSBControlSet(ctx context.Context, id string, patch func(*removablestoragecontrolset.UpdateUSBControlSetRequest)) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.patchUSBControlSetCalls = append(f.patchUSBControlSetCalls, fakeUSBControlSetServicePatchUSBControlSetArgs{ctx, id, patch})
+	stub := f.PatchUSBControlSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, patch)
+	}
+	if f.Impl != nil {
+		return f.Impl.PatchUSBControlSet(ctx, id, patch)
+	}
+	var r0 *removablestoragecontrolset.USBControlSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// PatchUSBControlSetCallCount returns the number of PatchUSBControlSet calls
+func (f *FakeUSBControlSetService) PatchUSBControlSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.patchUSBControlSetCalls)
+}
+
+// PatchUSBControlSetArgsForCall returns the arguments of the i-th PatchUSBControlSet call
+func (f *FakeUSBControlSetService) PatchUSBControlSetArgsForCall(i int) (context.Context, string, func(*removablestoragecontrolset.UpdateUSBControlSetRequest)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.patchUSBControlSetCalls[i]
+	return call.ctx, call.id, call.patch
+}
+
+type fakeUSBControlSetServiceUpsertUSBControlSetArgs struct {
+	ctx  context.Context
+	req  *removablestoragecontrolset.CreateUSBControlSetRequest
+	opts []client.UpsertOption
+}
+
+// UpsertUSBControlSet implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) UpsertUSBControlSet(ctx context.Context, req *removablestoragecontrolset.CreateUSBControlSetRequest, opts ...client.UpsertOption) (*removablestoragecontrolset.USBControlSet, client.UpsertAction, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.upsertUSBControlSetCalls = append(f.upsertUSBControlSetCalls, fakeUSBControlSetServiceUpsertUSBControlSetArgs{ctx, req, opts})
+	stub := f.UpsertUSBControlSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req, opts...)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpsertUSBControlSet(ctx, req, opts...)
+	}
+	var r0 *removablestoragecontrolset.USBControlSet
+	var r1 client.UpsertAction
+	var r2 *interfaces.Response
+	var r3 error
+	return r0, r1, r2, r3
+}
+
+// UpsertUSBControlSetCallCount returns the number of UpsertUSBControlSet calls
+func (f *FakeUSBControlSetService) UpsertUSBControlSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.upsertUSBControlSetCalls)
+}
+
+// UpsertUSBControlSetArgsForCall returns the arguments of the i-th UpsertUSBControlSet call
+func (f *FakeUSBControlSetService) UpsertUSBControlSetArgsForCall(i int) (context.Context, *removablestoragecontrolset.CreateUSBControlSetRequest, []client.UpsertOption) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.upsertUSBControlSetCalls[i]
+	return call.ctx, call.req, call.opts
+}
+
+type fakeUSBControlSetServiceCloneUSBControlSetArgs struct {
+	ctx     context.Context
+	id      string
+	newName string
+}
+
+// CloneUSBControlSet implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) CloneUSBControlSet(ctx context.Context, id string, newName string) (*removablestoragecontrolset.USBControlSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.cloneUSBControlSetCalls = append(f.cloneUSBControlSetCalls, fakeUSBControlSetServiceCloneUSBControlSetArgs{ctx, id, newName})
+	stub := f.CloneUSBControlSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, newName)
+	}
+	if f.Impl != nil {
+		return f.Impl.CloneUSBControlSet(ctx, id, newName)
+	}
+	var r0 *removablestoragecontrolset.USBControlSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CloneUSBControlSetCallCount returns the number of CloneUSBControlSet calls
+func (f *FakeUSBControlSetService) CloneUSBControlSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.cloneUSBControlSetCalls)
+}
+
+// CloneUSBControlSetArgsForCall returns the arguments of the i-th CloneUSBControlSet call
+func (f *FakeUSBControlSetService) CloneUSBControlSetArgsForCall(i int) (context.Context, string, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.cloneUSBControlSetCalls[i]
+	return call.ctx, call.id, call.newName
+}
+
+type fakeUSBControlSetServiceDeleteUSBControlSetArgs struct {
+	ctx context.Context
+	id  string
+}
+
+// DeleteUSBControlSet implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) DeleteUSBControlSet(ctx context.Context, id string) (*interfaces.Response, error) {
+	f.mu.Lock()
+	f.deleteUSBControlSetCalls = append(f.deleteUSBControlSetCalls, fakeUSBControlSetServiceDeleteUSBControlSetArgs{ctx, id})
+	stub := f.DeleteUSBControlSetStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.DeleteUSBControlSet(ctx, id)
+	}
+	var r0 *interfaces.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteUSBControlSetCallCount returns the number of DeleteUSBControlSet calls
+func (f *FakeUSBControlSetService) DeleteUSBControlSetCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deleteUSBControlSetCalls)
+}
+
+// DeleteUSBControlSetArgsForCall returns the arguments of the i-th DeleteUSBControlSet call
+func (f *FakeUSBControlSetService) DeleteUSBControlSetArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.deleteUSBControlSetCalls[i]
+	return call.ctx, call.id
+}
+
+type fakeUSBControlSetServiceListUSBControlSetsArgs struct {
+	ctx context.Context
+}
+
+// ListUSBControlSets implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) ListUSBControlSets(ctx context.Context) ([]removablestoragecontrolset.USBControlSet, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listUSBControlSetsCalls = append(f.listUSBControlSetsCalls, fakeUSBControlSetServiceListUSBControlSetsArgs{ctx})
+	stub := f.ListUSBControlSetsStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListUSBControlSets(ctx)
+	}
+	var r0 []removablestoragecontrolset.USBControlSet
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListUSBControlSetsCallCount returns the number of ListUSBControlSets calls
+func (f *FakeUSBControlSetService) ListUSBControlSetsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listUSBControlSetsCalls)
+}
+
+// ListUSBControlSetsArgsForCall returns the arguments of the i-th ListUSBControlSets call
+func (f *FakeUSBControlSetService) ListUSBControlSetsArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listUSBControlSetsCalls[i]
+	return call.ctx
+}
+
+type fakeUSBControlSetServiceListUSBControlSetNamesArgs struct {
+	ctx context.Context
+}
+
+// ListUSBControlSetNames implements removablestoragecontrolset.USBControlSetService
+func (f *FakeUSBControlSetService) ListUSBControlSetNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listUSBControlSetNamesCalls = append(f.listUSBControlSetNamesCalls, fakeUSBControlSetServiceListUSBControlSetNamesArgs{ctx})
+	stub := f.ListUSBControlSetNamesStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListUSBControlSetNames(ctx)
+	}
+	var r0 []string
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListUSBControlSetNamesCallCount returns the number of ListUSBControlSetNames calls
+func (f *FakeUSBControlSetService) ListUSBControlSetNamesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listUSBControlSetNamesCalls)
+}
+
+// ListUSBControlSetNamesArgsForCall returns the arguments of the i-th ListUSBControlSetNames call
+func (f *FakeUSBControlSetService) ListUSBControlSetNamesArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listUSBControlSetNamesCalls[i]
+	return call.ctx
+}
+
+// FakeTelemetryV2Service is a fake telemetry.TelemetryV2Service.
+// Each method records its arguments, then calls the method's Stub when set,
+// otherwise Impl, otherwise returns zero values.
+type FakeTelemetryV2Service struct {
+	// Impl handles calls without a stub
+	Impl telemetry.TelemetryV2Service
+
+	CreateTelemetryV2Stub       func(ctx context.Context, req *telemetry.CreateTelemetryV2Request) (*telemetry.TelemetryV2, *interfaces.Response, error)
+	GetTelemetryV2Stub          func(ctx context.Context, id string) (*telemetry.TelemetryV2, *interfaces.Response, error)
+	GetTelemetryV2ByNameStub    func(ctx context.Context, name string) (*telemetry.TelemetryV2, *interfaces.Response, error)
+	UpdateTelemetryV2Stub       func(ctx context.Context, id string, req *telemetry.UpdateTelemetryV2Request) (*telemetry.TelemetryV2, *interfaces.Response, error)
+	PatchTelemetryV2Stub        func(ctx context.Context, id string, patch func(*telemetry.UpdateTelemetryV2Request)) (*telemetry.TelemetryV2, *interfaces.Response, error)
+	UpsertTelemetryV2Stub       func(ctx context.Context, req *telemetry.CreateTelemetryV2Request, opts ...client.UpsertOption) (*telemetry.TelemetryV2, client.UpsertAction, *interfaces.Response, error)
+	CloneTelemetryV2Stub        func(ctx context.Context, id string, newName string) (*telemetry.TelemetryV2, *interfaces.Response, error)
+	DeleteTelemetryV2Stub       func(ctx context.Context, id string) (*interfaces.Response, error)
+	ListTelemetriesV2Stub       func(ctx context.Context) ([]telemetry.TelemetryV2, *interfaces.Response, error)
+	ListTelemetriesCombinedStub func(ctx context.Context, includePlans bool) (*telemetry.TelemetriesCombinedResponse, *interfaces.Response, error)
+
+	mu                           sync.Mutex
+	createTelemetryV2Calls       []fakeTelemetryV2ServiceCreateTelemetryV2Args
+	getTelemetryV2Calls          []fakeTelemetryV2ServiceGetTelemetryV2Args
+	getTelemetryV2ByNameCalls    []fakeTelemetryV2ServiceGetTelemetryV2ByNameArgs
+	updateTelemetryV2Calls       []fakeTelemetryV2ServiceUpdateTelemetryV2Args
+	patchTelemetryV2Calls        []fakeTelemetryV2ServicePatchTelemetryV2Args
+	upsertTelemetryV2Calls       []fakeTelemetryV2ServiceUpsertTelemetryV2Args
+	cloneTelemetryV2Calls        []fakeTelemetryV2ServiceCloneTelemetryV2Args
+	deleteTelemetryV2Calls       []fakeTelemetryV2ServiceDeleteTelemetryV2Args
+	listTelemetriesV2Calls       []fakeTelemetryV2ServiceListTelemetriesV2Args
+	listTelemetriesCombinedCalls []fakeTelemetryV2ServiceListTelemetriesCombinedArgs
+}
+
+var _ telemetry.TelemetryV2Service = (*FakeTelemetryV2Service)(nil)
+
+type fakeTelemetryV2ServiceCreateTelemetryV2Args struct {
+	ctx context.Context
+	req *telemetry.CreateTelemetryV2Request
+}
+
+// CreateTelemetryV2 implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) CreateTelemetryV2(ctx context.Context, req *telemetry.CreateTelemetryV2Request) (*telemetry.TelemetryV2, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.createTelemetryV2Calls = append(f.createTelemetryV2Calls, fakeTelemetryV2ServiceCreateTelemetryV2Args{ctx, req})
+	stub := f.CreateTelemetryV2Stub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.CreateTelemetryV2(ctx, req)
+	}
+	var r0 *telemetry.TelemetryV2
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateTelemetryV2CallCount returns the number of CreateTelemetryV2 calls
+func (f *FakeTelemetryV2Service) CreateTelemetryV2CallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.createTelemetryV2Calls)
+}
+
+// CreateTelemetryV2ArgsForCall returns the arguments of the i-th CreateTelemetryV2 call
+func (f *FakeTelemetryV2Service) CreateTelemetryV2ArgsForCall(i int) (context.Context, *telemetry.CreateTelemetryV2Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.createTelemetryV2Calls[i]
+	return call.ctx, call.req
+}
+
+type fakeTelemetryV2ServiceGetTelemetryV2Args struct {
+	ctx context.Context
+	id  string
+}
+
+// GetTelemetryV2 implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) GetTelemetryV2(ctx context.Context, id string) (*telemetry.TelemetryV2, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getTelemetryV2Calls = append(f.getTelemetryV2Calls, fakeTelemetryV2ServiceGetTelemetryV2Args{ctx, id})
+	stub := f.GetTelemetryV2Stub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetTelemetryV2(ctx, id)
+	}
+	var r0 *telemetry.TelemetryV2
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetTelemetryV2CallCount returns the number of GetTelemetryV2 calls
+func (f *FakeTelemetryV2Service) GetTelemetryV2CallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTelemetryV2Calls)
+}
+
+// GetTelemetryV2ArgsForCall returns the arguments of the i-th GetTelemetryV2 call
+func (f *FakeTelemetryV2Service) GetTelemetryV2ArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getTelemetryV2Calls[i]
+	return call.ctx, call.id
+}
+
+type fakeTelemetryV2ServiceGetTelemetryV2ByNameArgs struct {
+	ctx  context.Context
+	name string
+}
+
+// GetTelemetryV2ByName implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) GetTelemetryV2ByName(ctx context.Context, name string) (*telemetry.TelemetryV2, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getTelemetryV2ByNameCalls = append(f.getTelemetryV2ByNameCalls, fakeTelemetryV2ServiceGetTelemetryV2ByNameArgs{ctx, name})
+	stub := f.GetTelemetryV2ByNameStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetTelemetryV2ByName(ctx, name)
+	}
+	var r0 *telemetry.TelemetryV2
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetTelemetryV2ByNameCallCount returns the number of GetTelemetryV2ByName calls
+func (f *FakeTelemetryV2Service) GetTelemetryV2ByNameCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTelemetryV2ByNameCalls)
+}
+
+// GetTelemetryV2ByNameArgsForCall returns the arguments of the i-th GetTelemetryV2ByName call
+func (f *FakeTelemetryV2Service) GetTelemetryV2ByNameArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getTelemetryV2ByNameCalls[i]
+	return call.ctx, call.name
+}
+
+type fakeTelemetryV2ServiceUpdateTelemetryV2Args struct {
+	ctx context.Context
+	id  string
+	req *telemetry.UpdateTelemetryV2Request
+}
+
+// UpdateTelemetryV2 implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) UpdateTelemetryV2(ctx context.Context, id string, req *telemetry.UpdateTelemetryV2Request) (*telemetry.TelemetryV2, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updateTelemetryV2Calls = append(f.updateTelemetryV2Calls, fakeTelemetryV2ServiceUpdateTelemetryV2Args{ctx, id, req})
+	stub := f.UpdateTelemetryV2Stub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdateTelemetryV2(ctx, id, req)
+	}
+	var r0 *telemetry.TelemetryV2
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateTelemetryV2CallCount returns the number of UpdateTelemetryV2 calls
+func (f *FakeTelemetryV2Service) UpdateTelemetryV2CallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updateTelemetryV2Calls)
+}
+
+// UpdateTelemetryV2ArgsForCall returns the arguments of the i-th UpdateTelemetryV2 call
+func (f *FakeTelemetryV2Service) UpdateTelemetryV2ArgsForCall(i int) (context.Context, string, *telemetry.UpdateTelemetryV2Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updateTelemetryV2Calls[i]
+	return call.ctx, call.id, call.req
+}
+
+type fakeTelemetryV2ServicePatchTelemetryV2Args struct {
+	ctx   context.Context
+	id    string
+	patch func(*telemetry.UpdateTelemetryV2Request)
+}
+
+// PatchTelemetryV2 implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) PatchTelemetryV2(ctx context.Context, id string, patch func(*telemetry.UpdateTelemetryV2Request)) (*telemetry.TelemetryV2, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.patchTelemetryV2Calls = append(f.patchTelemetryV2Calls, fakeTelemetryV2ServicePatchTelemetryV2Args{ctx, id, patch})
+	stub := f.PatchTelemetryV2Stub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, patch)
+	}
+	if f.Impl != nil {
+		return f.Impl.PatchTelemetryV2(ctx, id, patch)
+	}
+	var r0 *telemetry.TelemetryV2
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// PatchTelemetryV2CallCount returns the number of PatchTelemetryV2 calls
+func (f *FakeTelemetryV2Service) PatchTelemetryV2CallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.patchTelemetryV2Calls)
+}
+
+// PatchTelemetryV2ArgsForCall returns the arguments of the i-th PatchTelemetryV2 call
+func (f *FakeTelemetryV2Service) PatchTelemetryV2ArgsForCall(i int) (context.Context, string, func(*telemetry.UpdateTelemetryV2Request)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.patchTelemetryV2Calls[i]
+	return call.ctx, call.id, call.patch
+}
+
+type fakeTelemetryV2ServiceUpsertTelemetryV2Args struct {
+	ctx  context.Context
+	req  *telemetry.CreateTelemetryV2Request
+	opts []client.UpsertOption
+}
+
+// UpsertTelemetryV2 implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) UpsertTelemetryV2(ctx context.Context, req *telemetry.CreateTelemetryV2Request, opts ...client.UpsertOption) (*telemetry.TelemetryV2, client.UpsertAction, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.upsertTelemetryV2Calls = append(f.upsertTelemetryV2Calls, fakeTelemetryV2ServiceUpsertTelemetryV2Args{ctx, req, opts})
+	stub := f.UpsertTelemetryV2Stub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req, opts...)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpsertTelemetryV2(ctx, req, opts...)
+	}
+	var r0 *telemetry.TelemetryV2
+	var r1 client.UpsertAction
+	var r2 *interfaces.Response
+	var r3 error
+	return r0, r1, r2, r3
+}
+
+// UpsertTelemetryV2CallCount returns the number of UpsertTelemetryV2 calls
+func (f *FakeTelemetryV2Service) UpsertTelemetryV2CallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.upsertTelemetryV2Calls)
+}
+
+// UpsertTelemetryV2ArgsForCall returns the arguments of the i-th UpsertTelemetryV2 call
+func (f *FakeTelemetryV2Service) UpsertTelemetryV2ArgsForCall(i int) (context.Context, *telemetry.CreateTelemetryV2Request, []client.UpsertOption) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.upsertTelemetryV2Calls[i]
+	return call.ctx, call.req, call.opts
+}
+
+type fakeTelemetryV2ServiceCloneTelemetryV2Args struct {
+	ctx     context.Context
+	id      string
+	newName string
+}
+
+// CloneTelemetryV2 implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) CloneTelemetryV2(ctx context.Context, id string, newName string) (*telemetry.TelemetryV2, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.cloneTelemetryV2Calls = append(f.cloneTelemetryV2Calls, fakeTelemetryV2ServiceCloneTelemetryV2Args{ctx, id, newName})
+	stub := f.CloneTelemetryV2Stub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id, newName)
+	}
+	if f.Impl != nil {
+		return f.Impl.CloneTelemetryV2(ctx, id, newName)
+	}
+	var r0 *telemetry.TelemetryV2
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CloneTelemetryV2CallCount returns the number of CloneTelemetryV2 calls
+func (f *FakeTelemetryV2Service) CloneTelemetryV2CallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.cloneTelemetryV2Calls)
+}
+
+// CloneTelemetryV2ArgsForCall returns the arguments of the i-th CloneTelemetryV2 call
+func (f *FakeTelemetryV2Service) CloneTelemetryV2ArgsForCall(i int) (context.Context, string, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.cloneTelemetryV2Calls[i]
+	return call.ctx, call.id, call.newName
+}
+
+type fakeTelemetryV2ServiceDeleteTelemetryV2Args struct {
+	ctx context.Context
+	id  string
+}
+
+// DeleteTelemetryV2 implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) DeleteTelemetryV2(ctx context.Context, id string) (*interfaces.Response, error) {
+	f.mu.Lock()
+	f.deleteTelemetryV2Calls = append(f.deleteTelemetryV2Calls, fakeTelemetryV2ServiceDeleteTelemetryV2Args{ctx, id})
+	stub := f.DeleteTelemetryV2Stub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	if f.Impl != nil {
+		return f.Impl.DeleteTelemetryV2(ctx, id)
+	}
+	var r0 *interfaces.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteTelemetryV2CallCount returns the number of DeleteTelemetryV2 calls
+func (f *FakeTelemetryV2Service) DeleteTelemetryV2CallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deleteTelemetryV2Calls)
+}
+
+// DeleteTelemetryV2ArgsForCall returns the arguments of the i-th DeleteTelemetryV2 call
+func (f *FakeTelemetryV2Service) DeleteTelemetryV2ArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.deleteTelemetryV2Calls[i]
+	return call.ctx, call.id
+}
+
+type fakeTelemetryV2ServiceListTelemetriesV2Args struct {
+	ctx context.Context
+}
+
+// ListTelemetriesV2 implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) ListTelemetriesV2(ctx context.Context) ([]telemetry.TelemetryV2, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listTelemetriesV2Calls = append(f.listTelemetriesV2Calls, fakeTelemetryV2ServiceListTelemetriesV2Args{ctx})
+	stub := f.ListTelemetriesV2Stub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListTelemetriesV2(ctx)
+	}
+	var r0 []telemetry.TelemetryV2
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListTelemetriesV2CallCount returns the number of ListTelemetriesV2 calls
+func (f *FakeTelemetryV2Service) ListTelemetriesV2CallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listTelemetriesV2Calls)
+}
+
+// ListTelemetriesV2ArgsForCall returns the arguments of the i-th ListTelemetriesV2 call
+func (f *FakeTelemetryV2Service) ListTelemetriesV2ArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listTelemetriesV2Calls[i]
+	return call.ctx
+}
+
+type fakeTelemetryV2ServiceListTelemetriesCombinedArgs struct {
+	ctx          context.Context
+	includePlans bool
+}
+
+// ListTelemetriesCombined implements telemetry.TelemetryV2Service
+func (f *FakeTelemetryV2Service) ListTelemetriesCombined(ctx context.Context, includePlans bool) (*telemetry.TelemetriesCombinedResponse, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listTelemetriesCombinedCalls = append(f.listTelemetriesCombinedCalls, fakeTelemetryV2ServiceListTelemetriesCombinedArgs{ctx, includePlans})
+	stub := f.ListTelemetriesCombinedStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, includePlans)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListTelemetriesCombined(ctx, includePlans)
+	}
+	var r0 *telemetry.TelemetriesCombinedResponse
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListTelemetriesCombinedCallCount returns the number of ListTelemetriesCombined calls
+func (f *FakeTelemetryV2Service) ListTelemetriesCombinedCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listTelemetriesCombinedCalls)
+}
+
+// ListTelemetriesCombinedArgsForCall returns the arguments of the i-th ListTelemetriesCombined call
+func (f *FakeTelemetryV2Service) ListTelemetriesCombinedArgsForCall(i int) (context.Context, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listTelemetriesCombinedCalls[i]
+	return call.ctx, call.includePlans
+}
+
+// FakeUnifiedLoggingFilterService is a fake unifiedloggingfilter.UnifiedLoggingFilterService.
+// Each method records its arguments, then calls the method's Stub when set,
+// otherwise Impl, otherwise returns zero values.
+type FakeUnifiedLoggingFilterService struct {
+	// Impl handles calls without a stub
+	Impl unifiedloggingfilter.UnifiedLoggingFilterService
+
+	CreateUnifiedLoggingFilterStub    func(ctx context.Context, req *unifiedloggingfilter.CreateUnifiedLoggingFilterRequest) (*unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error)
+	GetUnifiedLoggingFilterStub       func(ctx context.Context, uuid string) (*unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error)
+	GetUnifiedLoggingFilterByNameStub func(ctx context.Context, name string) (*unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error)
+	UpdateUnifiedLoggingFilterStub    func(ctx context.Context, uuid string, req *unifiedloggingfilter.UpdateUnifiedLoggingFilterRequest) (*unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error)
+	UpsertUnifiedLoggingFilterStub    func(ctx context.Context, req *unifiedloggingfilter.CreateUnifiedLoggingFilterRequest, opts ...client.UpsertOption) (*unifiedloggingfilter.UnifiedLoggingFilter, client.UpsertAction, *interfaces.Response, error)
+	DeleteUnifiedLoggingFilterStub    func(ctx context.Context, uuid string) (*interfaces.Response, error)
+	ListUnifiedLoggingFiltersStub     func(ctx context.Context) ([]unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error)
+	ListUnifiedLoggingFilterNamesStub func(ctx context.Context) ([]string, *interfaces.Response, error)
+
+	mu                                 sync.Mutex
+	createUnifiedLoggingFilterCalls    []fakeUnifiedLoggingFilterServiceCreateUnifiedLoggingFilterArgs
+	getUnifiedLoggingFilterCalls       []fakeUnifiedLoggingFilterServiceGetUnifiedLoggingFilterArgs
+	getUnifiedLoggingFilterByNameCalls []fakeUnifiedLoggingFilterServiceGetUnifiedLoggingFilterByNameArgs
+	updateUnifiedLoggingFilterCalls    []fakeUnifiedLoggingFilterServiceUpdateUnifiedLoggingFilterArgs
+	upsertUnifiedLoggingFilterCalls    []fakeUnifiedLoggingFilterServiceUpsertUnifiedLoggingFilterArgs
+	deleteUnifiedLoggingFilterCalls    []fakeUnifiedLoggingFilterServiceDeleteUnifiedLoggingFilterArgs
+	listUnifiedLoggingFiltersCalls     []fakeUnifiedLoggingFilterServiceListUnifiedLoggingFiltersArgs
+	listUnifiedLoggingFilterNamesCalls []fakeUnifiedLoggingFilterServiceListUnifiedLoggingFilterNamesArgs
+}
+
+var _ unifiedloggingfilter.UnifiedLoggingFilterService = (*FakeUnifiedLoggingFilterService)(nil)
+
+type fakeUnifiedLoggingFilterServiceCreateUnifiedLoggingFilterArgs struct {
+	ctx context.Context
+	req *unifiedloggingfilter.CreateUnifiedLoggingFilterRequest
+}
+
+// CreateUnifiedLoggingFilter implements unifiedloggingfilter.UnifiedLoggingFilterService
+func (f *FakeUnifiedLoggingFilterService) CreateUnifiedLoggingFilter(ctx context.Context, req *unifiedloggingfilter.CreateUnifiedLoggingFilterRequest) (*unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.createUnifiedLoggingFilterCalls = append(f.createUnifiedLoggingFilterCalls, fakeUnifiedLoggingFilterServiceCreateUnifiedLoggingFilterArgs{ctx, req})
+	stub := f.CreateUnifiedLoggingFilterStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.CreateUnifiedLoggingFilter(ctx, req)
+	}
+	var r0 *unifiedloggingfilter.UnifiedLoggingFilter
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateUnifiedLoggingFilterCallCount returns the number of CreateUnifiedLoggingFilter calls
+func (f *FakeUnifiedLoggingFilterService) CreateUnifiedLoggingFilterCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.createUnifiedLoggingFilterCalls)
+}
+
+// CreateUnifiedLoggingFilterArgsForCall returns the arguments of the i-th CreateUnifiedLoggingFilter call
+func (f *FakeUnifiedLoggingFilterService) CreateUnifiedLoggingFilterArgsForCall(i int) (context.Context, *unifiedloggingfilter.CreateUnifiedLoggingFilterRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.createUnifiedLoggingFilterCalls[i]
+	return call.ctx, call.req
+}
+
+type fakeUnifiedLoggingFilterServiceGetUnifiedLoggingFilterArgs struct {
+	ctx  context.Context
+	uuid string
+}
+
+// GetUnifiedLoggingFilter implements unifiedloggingfilter.UnifiedLoggingFilterService
+func (f *FakeUnifiedLoggingFilterService) GetUnifiedLoggingFilter(ctx context.Context, uuid string) (*unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getUnifiedLoggingFilterCalls = append(f.getUnifiedLoggingFilterCalls, fakeUnifiedLoggingFilterServiceGetUnifiedLoggingFilterArgs{ctx, uuid})
+	stub := f.GetUnifiedLoggingFilterStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetUnifiedLoggingFilter(ctx, uuid)
+	}
+	var r0 *unifiedloggingfilter.UnifiedLoggingFilter
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetUnifiedLoggingFilterCallCount returns the number of GetUnifiedLoggingFilter calls
+func (f *FakeUnifiedLoggingFilterService) GetUnifiedLoggingFilterCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getUnifiedLoggingFilterCalls)
+}
+
+// GetUnifiedLoggingFilterArgsForCall returns the arguments of the i-th GetUnifiedLoggingFilter call
+func (f *FakeUnifiedLoggingFilterService) GetUnifiedLoggingFilterArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getUnifiedLoggingFilterCalls[i]
+	return call.ctx, call.uuid
+}
+
+type fakeUnifiedLoggingFilterServiceGetUnifiedLoggingFilterByNameArgs struct {
+	ctx  context.Context
+	name string
+}
+
+// GetUnifiedLoggingFilterByName implements unifiedloggingfilter.UnifiedLoggingFilterService
+func (f *FakeUnifiedLoggingFilterService) GetUnifiedLoggingFilterByName(ctx context.Context, name string) (*unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.getUnifiedLoggingFilterByNameCalls = append(f.getUnifiedLoggingFilterByNameCalls, fakeUnifiedLoggingFilterServiceGetUnifiedLoggingFilterByNameArgs{ctx, name})
+	stub := f.GetUnifiedLoggingFilterByNameStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	if f.Impl != nil {
+		return f.Impl.GetUnifiedLoggingFilterByName(ctx, name)
+	}
+	var r0 *unifiedloggingfilter.UnifiedLoggingFilter
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetUnifiedLoggingFilterByNameCallCount returns the number of GetUnifiedLoggingFilterByName calls
+func (f *FakeUnifiedLoggingFilterService) GetUnifiedLoggingFilterByNameCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getUnifiedLoggingFilterByNameCalls)
+}
+
+// GetUnifiedLoggingFilterByNameArgsForCall returns the arguments of the i-th GetUnifiedLoggingFilterByName call
+func (f *FakeUnifiedLoggingFilterService) GetUnifiedLoggingFilterByNameArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.getUnifiedLoggingFilterByNameCalls[i]
+	return call.ctx, call.name
+}
+
+type fakeUnifiedLoggingFilterServiceUpdateUnifiedLoggingFilterArgs struct {
+	ctx  context.Context
+	uuid string
+	req  *unifiedloggingfilter.UpdateUnifiedLoggingFilterRequest
+}
+
+// UpdateUnifiedLoggingFilter implements unifiedloggingfilter.UnifiedLoggingFilterService
+func (f *FakeUnifiedLoggingFilterService) UpdateUnifiedLoggingFilter(ctx context.Context, uuid string, req *unifiedloggingfilter.UpdateUnifiedLoggingFilterRequest) (*unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.updateUnifiedLoggingFilterCalls = append(f.updateUnifiedLoggingFilterCalls, fakeUnifiedLoggingFilterServiceUpdateUnifiedLoggingFilterArgs{ctx, uuid, req})
+	stub := f.UpdateUnifiedLoggingFilterStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid, req)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpdateUnifiedLoggingFilter(ctx, uuid, req)
+	}
+	var r0 *unifiedloggingfilter.UnifiedLoggingFilter
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateUnifiedLoggingFilterCallCount returns the number of UpdateUnifiedLoggingFilter calls
+func (f *FakeUnifiedLoggingFilterService) UpdateUnifiedLoggingFilterCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.updateUnifiedLoggingFilterCalls)
+}
+
+// UpdateUnifiedLoggingFilterArgsForCall returns the arguments of the i-th UpdateUnifiedLoggingFilter call
+func (f *FakeUnifiedLoggingFilterService) UpdateUnifiedLoggingFilterArgsForCall(i int) (context.Context, string, *unifiedloggingfilter.UpdateUnifiedLoggingFilterRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.updateUnifiedLoggingFilterCalls[i]
+	return call.ctx, call.uuid, call.req
+}
+
+type fakeUnifiedLoggingFilterServiceUpsertUnifiedLoggingFilterArgs struct {
+	ctx  context.Context
+	req  *unifiedloggingfilter.CreateUnifiedLoggingFilterRequest
+	opts []client.UpsertOption
+}
+
+// UpsertUnifiedLoggingFilter implements unifiedloggingfilter.UnifiedLoggingFilterService
+func (f *FakeUnifiedLoggingFilterService) UpsertUnifiedLoggingFilter(ctx context.Context, req *unifiedloggingfilter.CreateUnifiedLoggingFilterRequest, opts ...client.UpsertOption) (*unifiedloggingfilter.UnifiedLoggingFilter, client.UpsertAction, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.upsertUnifiedLoggingFilterCalls = append(f.upsertUnifiedLoggingFilterCalls, fakeUnifiedLoggingFilterServiceUpsertUnifiedLoggingFilterArgs{ctx, req, opts})
+	stub := f.UpsertUnifiedLoggingFilterStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, req, opts...)
+	}
+	if f.Impl != nil {
+		return f.Impl.UpsertUnifiedLoggingFilter(ctx, req, opts...)
+	}
+	var r0 *unifiedloggingfilter.UnifiedLoggingFilter
+	var r1 client.UpsertAction
+	var r2 *interfaces.Response
+	var r3 error
+	return r0, r1, r2, r3
+}
+
+// UpsertUnifiedLoggingFilterCallCount returns the number of UpsertUnifiedLoggingFilter calls
+func (f *FakeUnifiedLoggingFilterService) UpsertUnifiedLoggingFilterCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.upsertUnifiedLoggingFilterCalls)
+}
+
+// UpsertUnifiedLoggingFilterArgsForCall returns the arguments of the i-th UpsertUnifiedLoggingFilter call
+func (f *FakeUnifiedLoggingFilterService) UpsertUnifiedLoggingFilterArgsForCall(i int) (context.Context, *unifiedloggingfilter.CreateUnifiedLoggingFilterRequest, []client.UpsertOption) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.upsertUnifiedLoggingFilterCalls[i]
+	return call.ctx, call.req, call.opts
+}
+
+type fakeUnifiedLoggingFilterServiceDeleteUnifiedLoggingFilterArgs struct {
+	ctx  context.Context
+	uuid string
+}
+
+// DeleteUnifiedLoggingFilter implements unifiedloggingfilter.UnifiedLoggingFilterService
+func (f *FakeUnifiedLoggingFilterService) DeleteUnifiedLoggingFilter(ctx context.Context, uuid string) (*interfaces.Response, error) {
+	f.mu.Lock()
+	f.deleteUnifiedLoggingFilterCalls = append(f.deleteUnifiedLoggingFilterCalls, fakeUnifiedLoggingFilterServiceDeleteUnifiedLoggingFilterArgs{ctx, uuid})
+	stub := f.DeleteUnifiedLoggingFilterStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx, uuid)
+	}
+	if f.Impl != nil {
+		return f.Impl.DeleteUnifiedLoggingFilter(ctx, uuid)
+	}
+	var r0 *interfaces.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteUnifiedLoggingFilterCallCount returns the number of DeleteUnifiedLoggingFilter calls
+func (f *FakeUnifiedLoggingFilterService) DeleteUnifiedLoggingFilterCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deleteUnifiedLoggingFilterCalls)
+}
+
+// DeleteUnifiedLoggingFilterArgsForCall returns the arguments of the i-th DeleteUnifiedLoggingFilter call
+func (f *FakeUnifiedLoggingFilterService) DeleteUnifiedLoggingFilterArgsForCall(i int) (context.Context, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.deleteUnifiedLoggingFilterCalls[i]
+	return call.ctx, call.uuid
+}
+
+type fakeUnifiedLoggingFilterServiceListUnifiedLoggingFiltersArgs struct {
+	ctx context.Context
+}
+
+// ListUnifiedLoggingFilters implements unifiedloggingfilter.UnifiedLoggingFilterService
+func (f *FakeUnifiedLoggingFilterService) ListUnifiedLoggingFilters(ctx context.Context) ([]unifiedloggingfilter.UnifiedLoggingFilter, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listUnifiedLoggingFiltersCalls = append(f.listUnifiedLoggingFiltersCalls, fakeUnifiedLoggingFilterServiceListUnifiedLoggingFiltersArgs{ctx})
+	stub := f.ListUnifiedLoggingFiltersStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListUnifiedLoggingFilters(ctx)
+	}
+	var r0 []unifiedloggingfilter.UnifiedLoggingFilter
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListUnifiedLoggingFiltersCallCount returns the number of ListUnifiedLoggingFilters calls
+func (f *FakeUnifiedLoggingFilterService) ListUnifiedLoggingFiltersCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listUnifiedLoggingFiltersCalls)
+}
+
+// ListUnifiedLoggingFiltersArgsForCall returns the arguments of the i-th ListUnifiedLoggingFilters call
+func (f *FakeUnifiedLoggingFilterService) ListUnifiedLoggingFiltersArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listUnifiedLoggingFiltersCalls[i]
+	return call.ctx
+}
+
+type fakeUnifiedLoggingFilterServiceListUnifiedLoggingFilterNamesArgs struct {
+	ctx context.Context
+}
+
+// ListUnifiedLoggingFilterNames implements unifiedloggingfilter.UnifiedLoggingFilterService
+func (f *FakeUnifiedLoggingFilterService) ListUnifiedLoggingFilterNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	f.mu.Lock()
+	f.listUnifiedLoggingFilterNamesCalls = append(f.listUnifiedLoggingFilterNamesCalls, fakeUnifiedLoggingFilterServiceListUnifiedLoggingFilterNamesArgs{ctx})
+	stub := f.ListUnifiedLoggingFilterNamesStub
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(ctx)
+	}
+	if f.Impl != nil {
+		return f.Impl.ListUnifiedLoggingFilterNames(ctx)
+	}
+	var r0 []string
+	var r1 *interfaces.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListUnifiedLoggingFilterNamesCallCount returns the number of ListUnifiedLoggingFilterNames calls
+func (f *FakeUnifiedLoggingFilterService) ListUnifiedLoggingFilterNamesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listUnifiedLoggingFilterNamesCalls)
+}
+
+// ListUnifiedLoggingFilterNamesArgsForCall returns the arguments of the i-th ListUnifiedLoggingFilterNames call
+func (f *FakeUnifiedLoggingFilterService) ListUnifiedLoggingFilterNamesArgsForCall(i int) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := f.listUnifiedLoggingFilterNamesCalls[i]
+	return call.ctx
+}
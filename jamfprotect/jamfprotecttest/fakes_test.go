@@ -0,0 +1,67 @@
+package jamfprotecttest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/jamfprotecttest"
+	actionconfigs "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeClient_StatefulByDefault(t *testing.T) {
+	c, fakes := jamfprotecttest.NewFakeClient()
+	ctx := context.Background()
+
+	created, _, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "Default Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+
+	got, _, err := c.ActionConfig.GetActionConfig(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Default Actions", got.Name)
+
+	_, err = c.ActionConfig.DeleteActionConfig(ctx, created.ID)
+	require.NoError(t, err)
+
+	_, _, err = c.ActionConfig.GetActionConfig(ctx, created.ID)
+	assert.True(t, client.IsNotFound(err))
+
+	assert.Equal(t, 2, fakes.ActionConfig.GetActionConfigCallCount())
+	_, id := fakes.ActionConfig.DeleteActionConfigArgsForCall(0)
+	assert.Equal(t, created.ID, id)
+	assert.Equal(t, 0, fakes.Server.Len(jamfprotecttest.KindActionConfig))
+
+	// The client has no transport, so transport-level methods are no-ops
+	assert.NoError(t, c.Probe(ctx))
+	assert.NoError(t, c.Close())
+}
+
+func TestFakeClient_StubOverridesImpl(t *testing.T) {
+	c, fakes := jamfprotecttest.NewFakeClient()
+	boom := errors.New("boom")
+	fakes.Plan.DeletePlanStub = func(ctx context.Context, id string) (*interfaces.Response, error) {
+		return nil, boom
+	}
+
+	_, err := c.Plan.DeletePlan(context.Background(), "1")
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, 1, fakes.Plan.DeletePlanCallCount())
+}
+
+func TestFakeClient_ZeroValuesWithoutImpl(t *testing.T) {
+	fake := &jamfprotecttest.FakeAnalyticService{}
+
+	analytic, resp, err := fake.GetAnalytic(context.Background(), "uuid")
+	assert.Nil(t, analytic)
+	assert.Nil(t, resp)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.GetAnalyticCallCount())
+}
@@ -0,0 +1,68 @@
+package jamfprotecttest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+	"go.uber.org/zap"
+)
+
+// inProcessClient is an interfaces.GraphQLClient that executes operations directly
+// against a Server's stores, without HTTP or authentication
+type inProcessClient struct {
+	server *Server
+}
+
+var _ interfaces.GraphQLClient = (*inProcessClient)(nil)
+
+// GraphQLPost implements interfaces.GraphQLClient
+func (c *inProcessClient) GraphQLPost(ctx context.Context, path string, query string, variables map[string]any, target any, headers map[string]string) (*interfaces.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Round-trip the variables so handlers see the same JSON types as over HTTP
+	vars, err := normalize(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	started := time.Now()
+	body, err := json.Marshal(c.server.execute(query, vars))
+	if err != nil {
+		return nil, fmt.Errorf("encoding graphql response: %w", err)
+	}
+	resp := &interfaces.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Headers:    http.Header{client.HeaderContentType: []string{client.ContentTypeJSON}},
+		Body:       body,
+		Duration:   time.Since(started),
+		ReceivedAt: time.Now(),
+		Size:       int64(len(body)),
+	}
+
+	var gqlResp client.GraphQLResponse
+	if err := json.Unmarshal(body, &gqlResp); err != nil {
+		return resp, fmt.Errorf("decoding graphql response: %w", err)
+	}
+	if err := client.MapGraphQLErrors(gqlResp.Errors); err != nil {
+		return resp, err
+	}
+	if target != nil && len(gqlResp.Data) > 0 {
+		if err := json.Unmarshal(gqlResp.Data, target); err != nil {
+			return resp, fmt.Errorf("decoding graphql response: %w", err)
+		}
+	}
+	return resp, nil
+}
+
+// GetLogger implements interfaces.GraphQLClient
+func (c *inProcessClient) GetLogger() *zap.Logger {
+	return zap.NewNop()
+}
@@ -0,0 +1,366 @@
+package jamfprotecttest
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// request is a decoded GraphQL request
+type request struct {
+	query string
+	vars  map[string]any
+}
+
+// flag returns a boolean variable, or def when it is not set
+func (r *request) flag(name string, def bool) bool {
+	if v, ok := r.vars[name].(bool); ok {
+		return v
+	}
+	return def
+}
+
+// paginated reports whether the operation accepts a pagination cursor. Operations
+// without one receive every item, matching how the SDK calls them.
+func (r *request) paginated() bool {
+	return strings.Contains(r.query, "$nextToken")
+}
+
+// operation handles one GraphQL operation. Handlers run with the server mutex held and
+// return the response data, and an error to report alongside it.
+type operation func(s *Server, r *request) (map[string]any, *graphQLError)
+
+// operations maps GraphQL operation names, as sent by the SDK services, to handlers
+var operations = map[string]operation{
+	"createPlan":                        createOp(KindPlan, "createPlan"),
+	"getPlan":                           getOp(KindPlan, "getPlan"),
+	"updatePlan":                        updateOp(KindPlan, "updatePlan"),
+	"deletePlan":                        deleteOp(KindPlan, "deletePlan"),
+	"listPlans":                         listOp(KindPlan, "listPlans"),
+	"listPlanNames":                     listOp(KindPlan, "listPlanNames"),
+	"getPlanConfigurationAndSetOptions": getPlanConfigurationAndSetOptions,
+
+	"createAnalytic":             createOp(KindAnalytic, "createAnalytic"),
+	"getAnalytic":                getOp(KindAnalytic, "getAnalytic"),
+	"updateAnalytic":             updateOp(KindAnalytic, "updateAnalytic"),
+	"deleteAnalytic":             deleteOp(KindAnalytic, "deleteAnalytic"),
+	"listAnalytics":              listOp(KindAnalytic, "listAnalytics"),
+	"listAnalyticsLite":          listOp(KindAnalytic, "listAnalytics"),
+	"listAnalyticsNames":         listOp(KindAnalytic, "listAnalyticsNames"),
+	"listAnalyticsCategories":    analyticFacetOp("categories"),
+	"listAnalyticsTags":          analyticFacetOp("tags"),
+	"listAnalyticsFilterOptions": listAnalyticsFilterOptions,
+
+	"createAnalyticSet": createOp(KindAnalyticSet, "createAnalyticSet"),
+	"getAnalyticSet":    getOp(KindAnalyticSet, "getAnalyticSet"),
+	"updateAnalyticSet": updateOp(KindAnalyticSet, "updateAnalyticSet"),
+	"deleteAnalyticSet": deleteOp(KindAnalyticSet, "deleteAnalyticSet"),
+	"listAnalyticSets":  listOp(KindAnalyticSet, "listAnalyticSets"),
+
+	"createExceptionSet":    createOp(KindExceptionSet, "createExceptionSet"),
+	"getExceptionSet":       getOp(KindExceptionSet, "getExceptionSet"),
+	"updateExceptionSet":    updateOp(KindExceptionSet, "updateExceptionSet"),
+	"deleteExceptionSet":    deleteOp(KindExceptionSet, "deleteExceptionSet"),
+	"listExceptionSets":     listOp(KindExceptionSet, "listExceptionSets"),
+	"listExceptionSetNames": listOp(KindExceptionSet, "listExceptionSetNames"),
+
+	"createPreventList":    createOp(KindPreventList, "createPreventList"),
+	"getPreventList":       getOp(KindPreventList, "getPreventList"),
+	"updatePreventList":    updateOp(KindPreventList, "updatePreventList"),
+	"deletePreventList":    deleteOp(KindPreventList, "deletePreventList"),
+	"listPreventLists":     listOp(KindPreventList, "listPreventLists"),
+	"listPreventListNames": listOp(KindPreventList, "listPreventListNames"),
+
+	"createUSBControlSet": createOp(KindUSBControlSet, "createUSBControlSet"),
+	"getUSBControlSet":    getOp(KindUSBControlSet, "getUSBControlSet"),
+	"updateUSBControlSet": updateOp(KindUSBControlSet, "updateUSBControlSet"),
+	"deleteUSBControlSet": deleteOp(KindUSBControlSet, "deleteUSBControlSet"),
+	"listUSBControlSets":  listOp(KindUSBControlSet, "listUSBControlSets"),
+	"listUsbControlNames": listOp(KindUSBControlSet, "listUsbControlNames"),
+
+	"createTelemetryV2":       createOp(KindTelemetryV2, "createTelemetryV2"),
+	"getTelemetryV2":          getOp(KindTelemetryV2, "getTelemetryV2"),
+	"updateTelemetryV2":       updateOp(KindTelemetryV2, "updateTelemetryV2"),
+	"deleteTelemetryV2":       deleteOp(KindTelemetryV2, "deleteTelemetryV2"),
+	"listTelemetriesV2":       listOp(KindTelemetryV2, "listTelemetriesV2"),
+	"listTelemetriesCombined": listTelemetriesCombined,
+
+	"createActionConfigs":   createOp(KindActionConfig, "createActionConfigs"),
+	"getActionConfigs":      getOp(KindActionConfig, "getActionConfigs"),
+	"updateActionConfigs":   updateOp(KindActionConfig, "updateActionConfigs"),
+	"deleteActionConfigs":   deleteOp(KindActionConfig, "deleteActionConfigs"),
+	"listActionConfigs":     listOp(KindActionConfig, "listActionConfigs"),
+	"listActionConfigNames": listOp(KindActionConfig, "listActionConfigNames"),
+
+	"createUnifiedLoggingFilter":    createOp(KindUnifiedLoggingFilter, "createUnifiedLoggingFilter"),
+	"getUnifiedLoggingFilter":       getOp(KindUnifiedLoggingFilter, "getUnifiedLoggingFilter"),
+	"updateUnifiedLoggingFilter":    updateOp(KindUnifiedLoggingFilter, "updateUnifiedLoggingFilter"),
+	"deleteUnifiedLoggingFilter":    deleteOp(KindUnifiedLoggingFilter, "deleteUnifiedLoggingFilter"),
+	"listUnifiedLoggingFilters":     listOp(KindUnifiedLoggingFilter, "listUnifiedLoggingFilters"),
+	"listUnifiedLoggingFilterNames": listOp(KindUnifiedLoggingFilter, "listUnifiedLoggingFilterNames"),
+}
+
+// createOp stores a new object built from the mutation variables
+func createOp(kind Kind, key string) operation {
+	return func(s *Server, r *request) (map[string]any, *graphQLError) {
+		spec := kinds[kind]
+		obj := make(map[string]any, len(spec.fields)+5)
+		for _, field := range spec.fields {
+			if v, ok := r.vars[field]; ok {
+				obj[field] = v
+			}
+		}
+		if spec.managedField != "" {
+			obj[spec.managedField] = false
+		}
+		if err := s.validate(kind, obj); err != nil {
+			return map[string]any{key: nil}, err
+		}
+
+		id := s.newID(kind)
+		now := s.timestamp()
+		obj[spec.idField] = id
+		obj["created"] = now
+		obj["updated"] = now
+		obj["hash"] = contentHash(spec, obj)
+		s.stores[kind].put(id, obj)
+
+		return map[string]any{key: s.render(kind, obj, r)}, nil
+	}
+}
+
+// getOp returns one object by identifier
+func getOp(kind Kind, key string) operation {
+	return func(s *Server, r *request) (map[string]any, *graphQLError) {
+		id, _ := r.vars[kinds[kind].idField].(string)
+		obj, ok := s.stores[kind].items[id]
+		if !ok {
+			return map[string]any{key: nil}, notFound(kind, id)
+		}
+		return map[string]any{key: s.render(kind, obj, r)}, nil
+	}
+}
+
+// updateOp replaces an existing object with one built from the mutation variables, as the
+// API's update mutations do: fields absent from the variables are cleared. Only the
+// fields the server owns, such as the ID, creation time and managed flag, are kept.
+func updateOp(kind Kind, key string) operation {
+	return func(s *Server, r *request) (map[string]any, *graphQLError) {
+		spec := kinds[kind]
+		id, _ := r.vars[spec.idField].(string)
+		existing, ok := s.stores[kind].items[id]
+		if !ok {
+			return map[string]any{key: nil}, notFound(kind, id)
+		}
+		if err := checkUnmanaged(kind, existing); err != nil {
+			return map[string]any{key: nil}, err
+		}
+
+		obj := make(map[string]any, len(existing))
+		for field, v := range existing {
+			if !slices.Contains(spec.fields, field) {
+				obj[field] = v
+			}
+		}
+		for _, field := range spec.fields {
+			if v, ok := r.vars[field]; ok {
+				obj[field] = v
+			}
+		}
+		if err := s.validate(kind, obj); err != nil {
+			return map[string]any{key: nil}, err
+		}
+
+		obj["updated"] = s.timestamp()
+		obj["hash"] = contentHash(spec, obj)
+		s.stores[kind].put(id, obj)
+
+		return map[string]any{key: s.render(kind, obj, r)}, nil
+	}
+}
+
+// deleteOp removes an object that no other object references
+func deleteOp(kind Kind, key string) operation {
+	return func(s *Server, r *request) (map[string]any, *graphQLError) {
+		spec := kinds[kind]
+		id, _ := r.vars[spec.idField].(string)
+		existing, ok := s.stores[kind].items[id]
+		if !ok {
+			return map[string]any{key: nil}, notFound(kind, id)
+		}
+		if err := checkUnmanaged(kind, existing); err != nil {
+			return map[string]any{key: nil}, err
+		}
+		if users := s.referrers(kind, id); len(users) > 0 {
+			return map[string]any{key: nil}, badRequest("%s %q is in use by %s",
+				spec.label, id, strings.Join(users, ", "))
+		}
+
+		s.stores[kind].remove(id)
+		return map[string]any{key: map[string]any{spec.idField: id}}, nil
+	}
+}
+
+// listOp returns the objects of a kind that match the filter variable, if any, one page at
+// a time when the operation accepts a cursor
+func listOp(kind Kind, key string) operation {
+	return func(s *Server, r *request) (map[string]any, *graphQLError) {
+		list, err := s.list(kind, r, r.filter())
+		return map[string]any{key: list}, err
+	}
+}
+
+// filter returns a predicate for the equals conditions of the filter variable, such as
+// {name: {equals: "Default"}}, or nil when there are none
+func (r *request) filter() func(map[string]any) bool {
+	conditions, _ := r.vars["filter"].(map[string]any)
+	equals := map[string]any{}
+	for field, cond := range conditions {
+		if c, ok := cond.(map[string]any); ok {
+			if v, ok := c["equals"]; ok {
+				equals[field] = v
+			}
+		}
+	}
+	if len(equals) == 0 {
+		return nil
+	}
+	return func(obj map[string]any) bool {
+		for field, want := range equals {
+			if obj[field] != want {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// list renders the objects of a kind that match keep in the requested order
+func (s *Server) list(kind Kind, r *request, keep func(map[string]any) bool) (map[string]any, *graphQLError) {
+	items := s.stores[kind].all()
+	if keep != nil {
+		items = slices.DeleteFunc(items, func(obj map[string]any) bool { return !keep(obj) })
+	}
+	if direction, _ := r.vars["direction"].(string); direction == "DESC" {
+		slices.Reverse(items)
+	}
+	total := len(items)
+
+	var next any
+	if r.paginated() {
+		var err *graphQLError
+		if items, next, err = page(items, r.vars["nextToken"], s.pageSize); err != nil {
+			return nil, err
+		}
+	}
+
+	rendered := make([]map[string]any, 0, len(items))
+	for _, obj := range items {
+		rendered = append(rendered, s.render(kind, obj, r))
+	}
+	return map[string]any{
+		"items":    rendered,
+		"pageInfo": map[string]any{"next": next, "total": total},
+	}, nil
+}
+
+// getPlanConfigurationAndSetOptions lists the objects a plan can reference
+func getPlanConfigurationAndSetOptions(s *Server, r *request) (map[string]any, *graphQLError) {
+	desc := &request{query: r.query, vars: map[string]any{"direction": "DESC"}}
+	managed := func(want bool) func(map[string]any) bool {
+		return func(obj map[string]any) bool { return obj["managed"] == want }
+	}
+
+	data := map[string]any{}
+	if r.flag("RBAC_ActionConfigs", true) {
+		data["actionConfigs"], _ = s.list(KindActionConfig, desc, nil)
+	}
+	if r.flag("RBAC_Telemetry", true) {
+		data["telemetries"] = emptyList()
+		data["telemetriesV2"], _ = s.list(KindTelemetryV2, desc, nil)
+	}
+	if r.flag("RBAC_USBControlSet", true) {
+		data["usbControlSets"], _ = s.list(KindUSBControlSet, desc, nil)
+	}
+	if r.flag("RBAC_ExceptionSet", true) {
+		data["exceptionSets"], _ = s.list(KindExceptionSet, desc, nil)
+	}
+	if r.flag("RBAC_AnalyticSet", true) {
+		data["analyticSets"], _ = s.list(KindAnalyticSet, desc, managed(false))
+		data["managedAnalyticSets"], _ = s.list(KindAnalyticSet, desc, managed(true))
+	}
+	return data, nil
+}
+
+// listTelemetriesCombined lists legacy telemetry, which the fake does not store, and telemetry v2
+func listTelemetriesCombined(s *Server, r *request) (map[string]any, *graphQLError) {
+	v2, err := s.list(KindTelemetryV2, r, nil)
+	return map[string]any{"listTelemetries": emptyList(), "listTelemetriesV2": v2}, err
+}
+
+// analyticFacetOp counts analytics per value of a string list field
+func analyticFacetOp(field string) operation {
+	key := "listAnalyticsCategories"
+	if field == "tags" {
+		key = "listAnalyticsTags"
+	}
+	return func(s *Server, r *request) (map[string]any, *graphQLError) {
+		return map[string]any{key: s.analyticFacet(field)}, nil
+	}
+}
+
+// listAnalyticsFilterOptions returns analytic tag and category counts together
+func listAnalyticsFilterOptions(s *Server, r *request) (map[string]any, *graphQLError) {
+	return map[string]any{
+		"listAnalyticsTags":       s.analyticFacet("tags"),
+		"listAnalyticsCategories": s.analyticFacet("categories"),
+	}, nil
+}
+
+func (s *Server) analyticFacet(field string) []map[string]any {
+	counts := map[string]int{}
+	for _, obj := range s.stores[KindAnalytic].all() {
+		for _, v := range stringList(obj[field]) {
+			counts[v]++
+		}
+	}
+	values := make([]string, 0, len(counts))
+	for v := range counts {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+
+	out := make([]map[string]any, 0, len(values))
+	for _, v := range values {
+		out = append(out, map[string]any{"value": v, "count": counts[v]})
+	}
+	return out
+}
+
+func emptyList() map[string]any {
+	return map[string]any{
+		"items":    []any{},
+		"pageInfo": map[string]any{"next": nil, "total": 0},
+	}
+}
+
+// checkUnmanaged rejects changes to Jamf-managed objects
+func checkUnmanaged(kind Kind, obj map[string]any) *graphQLError {
+	spec := kinds[kind]
+	if spec.managedField != "" && obj[spec.managedField] == true {
+		return badRequest("%s %q is managed by Jamf and cannot be modified", spec.label, obj[spec.idField])
+	}
+	return nil
+}
+
+// stringList converts a decoded JSON array to strings, skipping other values
+func stringList(v any) []string {
+	items, _ := v.([]any)
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
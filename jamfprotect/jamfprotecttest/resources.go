@@ -0,0 +1,252 @@
+package jamfprotecttest
+
+import "slices"
+
+// reference is a field of an object that holds the identifier of another object
+type reference struct {
+	field string
+	kind  Kind
+	id    string
+}
+
+// references returns the objects obj refers to. Plans refer to their action config,
+// exception sets, USB control set, telemetry and analytic sets; analytic sets and
+// exception sets refer to analytics.
+func references(kind Kind, obj map[string]any) []reference {
+	var refs []reference
+	add := func(field string, target Kind, id any) {
+		if s, ok := id.(string); ok && s != "" {
+			refs = append(refs, reference{field: field, kind: target, id: s})
+		}
+	}
+
+	switch kind {
+	case KindPlan:
+		add("actionConfigs", KindActionConfig, obj["actionConfigs"])
+		for _, id := range stringList(obj["exceptionSets"]) {
+			add("exceptionSets", KindExceptionSet, id)
+		}
+		add("usbControlSet", KindUSBControlSet, obj["usbControlSet"])
+		add("telemetryV2", KindTelemetryV2, obj["telemetryV2"])
+		for _, set := range objectList(obj["analyticSets"]) {
+			add("analyticSets", KindAnalyticSet, set["uuid"])
+		}
+	case KindAnalyticSet:
+		for _, id := range stringList(obj["analytics"]) {
+			add("analytics", KindAnalytic, id)
+		}
+	case KindExceptionSet:
+		for _, exception := range objectList(obj["exceptions"]) {
+			add("exceptions.analyticUuid", KindAnalytic, exception["analyticUuid"])
+		}
+	}
+	return refs
+}
+
+// validate checks required fields and that every reference resolves
+func (s *Server) validate(kind Kind, obj map[string]any) *graphQLError {
+	if name, _ := obj["name"].(string); name == "" {
+		return badRequest("name is required")
+	}
+	if kind == KindPlan {
+		if id, _ := obj["actionConfigs"].(string); id == "" {
+			return badRequest("actionConfigs is required")
+		}
+	}
+	for _, ref := range references(kind, obj) {
+		if _, ok := s.stores[ref.kind].items[ref.id]; !ok {
+			return badRequest("%s references unknown %s %q", ref.field, kinds[ref.kind].label, ref.id)
+		}
+	}
+	return nil
+}
+
+// referrers returns the names of the objects that refer to the given object
+func (s *Server) referrers(kind Kind, id string) []string {
+	var names []string
+	for _, referrer := range []Kind{KindPlan, KindAnalyticSet, KindExceptionSet} {
+		for _, obj := range s.stores[referrer].all() {
+			if refersTo(referrer, obj, kind, id) {
+				name, _ := obj["name"].(string)
+				names = append(names, kinds[referrer].label+" "+name)
+			}
+		}
+	}
+	return names
+}
+
+// plansReferencing returns {id, name} entries for the plans that refer to an object
+func (s *Server) plansReferencing(kind Kind, id string) []map[string]any {
+	plans := []map[string]any{}
+	for _, plan := range s.stores[KindPlan].all() {
+		if refersTo(KindPlan, plan, kind, id) {
+			plans = append(plans, map[string]any{"id": plan["id"], "name": plan["name"]})
+		}
+	}
+	return plans
+}
+
+func refersTo(referrer Kind, obj map[string]any, kind Kind, id string) bool {
+	return slices.ContainsFunc(references(referrer, obj), func(ref reference) bool {
+		return ref.kind == kind && ref.id == id
+	})
+}
+
+// render converts a stored object to its query response shape, resolving references
+// to the nested objects the API returns and honouring the include/skip variables the
+// SDK sends (RBAC_Plan, excludeAnalytics, minimal)
+func (s *Server) render(kind Kind, obj map[string]any, r *request) map[string]any {
+	out := clone(obj)
+
+	switch kind {
+	case KindPlan:
+		s.renderPlan(out)
+	case KindAnalyticSet:
+		analytics := []map[string]any{}
+		for _, id := range stringList(obj["analytics"]) {
+			if analytic, ok := s.stores[KindAnalytic].items[id]; ok {
+				analytics = append(analytics, map[string]any{
+					"uuid": id,
+					"name": analytic["name"],
+					"jamf": analytic["jamf"] == true,
+				})
+			}
+		}
+		out["analytics"] = analytics
+		if r.flag("excludeAnalytics", false) {
+			delete(out, "analytics")
+		}
+		if r.flag("RBAC_Plan", true) {
+			out["plans"] = s.plansReferencing(kind, obj["uuid"].(string))
+		}
+	case KindExceptionSet:
+		exceptions := objectList(out["exceptions"])
+		for _, exception := range exceptions {
+			if id, ok := exception["analyticUuid"].(string); ok {
+				delete(exception, "analyticUuid")
+				if analytic, ok := s.stores[KindAnalytic].items[id]; ok {
+					exception["analytic"] = map[string]any{"uuid": id, "name": analytic["name"]}
+				}
+			}
+		}
+		if exceptions != nil {
+			out["exceptions"] = exceptions
+		}
+		if r.flag("minimal", false) {
+			delete(out, "exceptions")
+			delete(out, "esExceptions")
+		}
+	case KindUSBControlSet:
+		out["rules"] = flattenUSBRules(objectList(out["rules"]))
+		out["plans"] = s.plansReferencing(kind, obj["id"].(string))
+	case KindTelemetryV2:
+		if r.flag("RBAC_Plan", true) {
+			out["plans"] = s.plansReferencing(kind, obj["id"].(string))
+		}
+	case KindPreventList:
+		out["count"] = len(stringList(obj["list"]))
+	}
+	return out
+}
+
+// renderPlan replaces plan reference identifiers with the nested objects getPlan returns
+func (s *Server) renderPlan(out map[string]any) {
+	ref := func(kind Kind, id any) any {
+		target, ok := s.stores[kind].items[stringValue(id)]
+		if !ok {
+			return nil
+		}
+		return map[string]any{"id": target["id"], "name": target["name"]}
+	}
+
+	out["actionConfigs"] = ref(KindActionConfig, out["actionConfigs"])
+	out["usbControlSet"] = ref(KindUSBControlSet, out["usbControlSet"])
+	out["telemetryV2"] = ref(KindTelemetryV2, out["telemetryV2"])
+	if id := stringValue(out["telemetry"]); id != "" {
+		// Legacy telemetry is not stored; echo the reference so it round-trips
+		out["telemetry"] = map[string]any{"id": id, "name": ""}
+	} else {
+		out["telemetry"] = nil
+	}
+
+	exceptionSets := []map[string]any{}
+	for _, id := range stringList(out["exceptionSets"]) {
+		if set, ok := s.stores[KindExceptionSet].items[id]; ok {
+			exceptionSets = append(exceptionSets, map[string]any{
+				"uuid":    id,
+				"name":    set["name"],
+				"managed": set["managed"] == true,
+			})
+		}
+	}
+	out["exceptionSets"] = exceptionSets
+
+	analyticSets := []map[string]any{}
+	for _, entry := range objectList(out["analyticSets"]) {
+		id := stringValue(entry["uuid"])
+		set, ok := s.stores[KindAnalyticSet].items[id]
+		if !ok {
+			continue
+		}
+		analytics := []map[string]any{}
+		for _, analyticID := range stringList(set["analytics"]) {
+			if analytic, ok := s.stores[KindAnalytic].items[analyticID]; ok {
+				categories := analytic["categories"]
+				if categories == nil {
+					categories = []any{}
+				}
+				analytics = append(analytics, map[string]any{"uuid": analyticID, "categories": categories})
+			}
+		}
+		analyticSets = append(analyticSets, map[string]any{
+			"type": entry["type"],
+			"analyticSet": map[string]any{
+				"uuid":      id,
+				"name":      set["name"],
+				"managed":   set["managed"] == true,
+				"analytics": analytics,
+			},
+		})
+	}
+	out["analyticSets"] = analyticSets
+}
+
+// flattenUSBRules converts rule inputs ({type, vendorRule: {...}}) to the flat rule
+// objects the API returns ({type, mountAction, vendors, ...})
+func flattenUSBRules(rules []map[string]any) []map[string]any {
+	out := make([]map[string]any, 0, len(rules))
+	for _, rule := range rules {
+		flat := map[string]any{"type": rule["type"]}
+		for _, variant := range []string{"vendorRule", "serialRule", "productRule", "encryptionRule"} {
+			details, ok := rule[variant].(map[string]any)
+			if !ok {
+				continue
+			}
+			for k, v := range details {
+				flat[k] = v
+			}
+		}
+		out = append(out, flat)
+	}
+	return out
+}
+
+// objectList converts a decoded JSON array to objects, skipping other values
+func objectList(v any) []map[string]any {
+	items, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		if obj, ok := item.(map[string]any); ok {
+			out = append(out, obj)
+		}
+	}
+	return out
+}
+
+func stringValue(v any) string {
+	s, _ := v.(string)
+	return s
+}
@@ -0,0 +1,267 @@
+// Package jamfprotecttest provides an in-process fake of the Jamf Protect API for tests.
+//
+// The fake serves /token, /app and /graphql from an httptest.Server and keeps stateful
+// in-memory stores for every resource the SDK manages. Mutations create, update and
+// delete objects, queries read them back with server-generated identifiers, timestamps
+// and hashes, references between objects are resolved the way the API resolves them,
+// and list operations paginate with opaque next tokens.
+//
+//	server := jamfprotecttest.NewServer()
+//	defer server.Close()
+//
+//	c, err := server.NewClient()
+//	plan, _, err := c.Plan.CreatePlan(ctx, req)
+package jamfprotecttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+)
+
+// Default credentials accepted by the fake token endpoint
+const (
+	DefaultClientID     = "jamfprotecttest-client"
+	DefaultClientSecret = "jamfprotecttest-secret"
+)
+
+// DefaultPageSize is the number of items returned per page by paginated list operations.
+// It is smaller than the API's page size so that tests exercise pagination with few objects.
+const DefaultPageSize = 10
+
+// tokenLifetime is the expires_in value of issued access tokens
+const tokenLifetime = time.Hour
+
+// Server is an in-process fake of the Jamf Protect API
+type Server struct {
+	// URL is the base URL of the fake, suitable for client.WithBaseURL
+	URL string
+
+	// ClientID and ClientSecret are the credentials the token endpoint accepts
+	ClientID     string
+	ClientSecret string
+
+	server   *httptest.Server
+	pageSize int
+	now      func() time.Time
+
+	mu         sync.Mutex
+	seq        int
+	stores     map[Kind]*store
+	tokens     map[string]bool
+	operations map[string]int
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithPageSize sets the number of items returned per page by paginated list operations
+func WithPageSize(size int) Option {
+	return func(s *Server) {
+		if size > 0 {
+			s.pageSize = size
+		}
+	}
+}
+
+// WithCredentials sets the client ID and secret the token endpoint accepts
+func WithCredentials(clientID, clientSecret string) Option {
+	return func(s *Server) {
+		s.ClientID = clientID
+		s.ClientSecret = clientSecret
+	}
+}
+
+// WithClock sets the time source used for created and updated timestamps
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		if now != nil {
+			s.now = now
+		}
+	}
+}
+
+// NewServer starts a fake Jamf Protect API. Callers must Close it when done.
+func NewServer(opts ...Option) *Server {
+	s := newServer(opts...)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(client.EndpointToken, s.handleToken)
+	mux.HandleFunc(client.EndpointApp, s.handleGraphQL)
+	mux.HandleFunc(client.EndpointGraphQL, s.handleGraphQL)
+
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+	return s
+}
+
+// newServer creates the stores without starting an HTTP listener
+func newServer(opts ...Option) *Server {
+	s := &Server{
+		ClientID:     DefaultClientID,
+		ClientSecret: DefaultClientSecret,
+		pageSize:     DefaultPageSize,
+		now:          time.Now,
+		stores:       make(map[Kind]*store, len(kinds)),
+		tokens:       make(map[string]bool),
+		operations:   make(map[string]int),
+	}
+	for kind := range kinds {
+		s.stores[kind] = newStore()
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	if s.server != nil {
+		s.server.Close()
+	}
+}
+
+// NewClient creates a jamfprotect.Client that talks to the fake. Options are applied
+// after the base URL, so they may override it.
+func (s *Server) NewClient(options ...client.ClientOption) (*jamfprotect.Client, error) {
+	opts := append([]client.ClientOption{client.WithBaseURL(s.URL)}, options...)
+	return jamfprotect.NewClient(s.ClientID, s.ClientSecret, opts...)
+}
+
+// RevokeTokens invalidates every access token issued so far. Subsequent GraphQL
+// requests with those tokens are rejected with 401 Unauthorized.
+func (s *Server) RevokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for token := range s.tokens {
+		s.tokens[token] = false
+	}
+}
+
+// OperationCount returns how many times the named GraphQL operation was received
+func (s *Server) OperationCount(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.operations[name]
+}
+
+// handleToken issues access tokens for the configured credentials
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req client.TokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if req.ClientID != s.ClientID || req.Password != s.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	s.mu.Lock()
+	s.seq++
+	token := fmt.Sprintf("jamfprotecttest-token-%d", s.seq)
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, client.TokenResponse{
+		AccessToken: token,
+		ExpiresIn:   int64(tokenLifetime / time.Second),
+		TokenType:   "Bearer",
+	})
+}
+
+// handleGraphQL authenticates the request and dispatches it on the operation name
+func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := strings.TrimPrefix(r.Header.Get(client.HeaderAuthorization), "Bearer ")
+	s.mu.Lock()
+	valid := s.tokens[token]
+	s.mu.Unlock()
+	if !valid {
+		writeJSON(w, http.StatusUnauthorized, graphQLErrors(&graphQLError{
+			ErrorType: "UnauthorizedException",
+			Message:   "You are not authorized to make this call.",
+		}))
+		return
+	}
+
+	var req client.GraphQLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, graphQLErrors(badRequest("invalid GraphQL request body: %v", err)))
+		return
+	}
+	if req.Variables == nil {
+		req.Variables = map[string]any{}
+	}
+	writeJSON(w, http.StatusOK, s.execute(req.Query, req.Variables))
+}
+
+// execute runs a GraphQL operation against the stores and returns the response body
+func (s *Server) execute(query string, vars map[string]any) map[string]any {
+	_, name := client.ParseOperation(query)
+	handler, ok := operations[name]
+	if !ok {
+		return graphQLErrors(badRequest("operation %q is not supported by jamfprotecttest", name))
+	}
+
+	s.mu.Lock()
+	s.operations[name]++
+	data, gqlErr := handler(s, &request{query: query, vars: vars})
+	s.mu.Unlock()
+
+	if gqlErr != nil {
+		gqlErr.Path = []any{name}
+		return map[string]any{"data": data, "errors": []*graphQLError{gqlErr}}
+	}
+	return map[string]any{"data": data}
+}
+
+// graphQLError is an error entry in a GraphQL response
+type graphQLError struct {
+	Message   string `json:"message"`
+	ErrorType string `json:"errorType,omitempty"`
+	Path      []any  `json:"path,omitempty"`
+}
+
+func graphQLErrors(errs ...*graphQLError) map[string]any {
+	return map[string]any{"data": nil, "errors": errs}
+}
+
+// notFound reports a missing object. The message matches client.IsNotFound.
+func notFound(kind Kind, id string) *graphQLError {
+	return &graphQLError{
+		ErrorType: "NotFoundException",
+		Message:   fmt.Sprintf("%s %q not found", kinds[kind].label, id),
+	}
+}
+
+// badRequest reports invalid input
+func badRequest(format string, args ...any) *graphQLError {
+	return &graphQLError{
+		ErrorType: "ArgumentValidationError",
+		Message:   fmt.Sprintf(format, args...),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set(client.HeaderContentType, client.ContentTypeJSON)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
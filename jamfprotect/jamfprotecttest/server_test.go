@@ -0,0 +1,707 @@
+package jamfprotecttest_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/jamfprotecttest"
+	actionconfigs "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	analytics "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic"
+	analyticsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic_set"
+	preventlists "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/custom_prevent_list"
+	exceptionsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/exception_set"
+	plans "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+	usbcontrolsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/removable_storage_control_set"
+	telemetryv2 "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	unifiedloggingfilters "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/unified_logging_filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestClient(t *testing.T, opts ...jamfprotecttest.Option) (*jamfprotecttest.Server, *jamfprotect.Client) {
+	t.Helper()
+	server := jamfprotecttest.NewServer(opts...)
+	t.Cleanup(server.Close)
+
+	c, err := server.NewClient(client.WithLogger(zap.NewNop()))
+	require.NoError(t, err)
+	return server, c
+}
+
+func TestServer_PlanReferencesResolve(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	actionConfig, _, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "Default Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, actionConfig.ID)
+	assert.NotEmpty(t, actionConfig.Hash)
+
+	telemetry, _, err := c.TelemetryV2.CreateTelemetryV2(ctx, &telemetryv2.CreateTelemetryV2Request{
+		Name:     "Telemetry",
+		LogFiles: []string{"/var/log/system.log"},
+		Events:   []string{"exec"},
+	})
+	require.NoError(t, err)
+
+	analytic, _, err := c.Analytic.CreateAnalytic(ctx, &analytics.CreateAnalyticRequest{
+		Name:       "Suspicious Exec",
+		InputType:  analytics.InputTypeGPProcessEvent,
+		Filter:     "$event.type == 1",
+		Categories: []string{"Execution"},
+		Level:      1,
+		Severity:   analytics.SeverityHigh,
+	})
+	require.NoError(t, err)
+
+	set, _, err := c.AnalyticSet.CreateAnalyticSet(ctx, &analyticsets.CreateAnalyticSetRequest{
+		Name:      "Custom Set",
+		Types:     []string{"Report"},
+		Analytics: []string{analytic.UUID},
+	})
+	require.NoError(t, err)
+	require.Len(t, set.Analytics, 1)
+	assert.Equal(t, "Suspicious Exec", set.Analytics[0].Name)
+
+	created, _, err := c.Plan.CreatePlan(ctx, &plans.CreatePlanRequest{
+		Name:          "Workstations",
+		ActionConfigs: actionConfig.ID,
+		TelemetryV2:   &telemetry.ID,
+		AnalyticSets:  []plans.AnalyticSetInput{{Type: "Report", UUID: set.UUID}},
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	require.NoError(t, err)
+
+	plan, _, err := c.Plan.GetPlan(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &plans.PlanRef{ID: actionConfig.ID, Name: "Default Actions"}, plan.ActionConfigs)
+	assert.Equal(t, &plans.PlanRef{ID: telemetry.ID, Name: "Telemetry"}, plan.TelemetryV2)
+	require.Len(t, plan.AnalyticSets, 1)
+	assert.Equal(t, "Custom Set", plan.AnalyticSets[0].AnalyticSet.Name)
+	assert.Equal(t, []string{"Execution"}, plan.AnalyticSets[0].AnalyticSet.Analytics[0].Categories)
+
+	gotSet, _, err := c.AnalyticSet.GetAnalyticSet(ctx, set.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, []analyticsets.AnalyticSetPlan{{ID: created.ID, Name: "Workstations"}}, gotSet.Plans)
+
+	gotTelemetry, _, err := c.TelemetryV2.GetTelemetryV2(ctx, telemetry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []telemetryv2.TelemetryV2Plan{{ID: created.ID, Name: "Workstations"}}, gotTelemetry.Plans)
+}
+
+func TestServer_UpdateAndDelete(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	actionConfig, _, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	require.NoError(t, err)
+	created, _, err := c.Plan.CreatePlan(ctx, &plans.CreatePlanRequest{
+		Name:          "Plan",
+		ActionConfigs: actionConfig.ID,
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	require.NoError(t, err)
+
+	updated, _, err := c.Plan.UpdatePlan(ctx, created.ID, &plans.UpdatePlanRequest{
+		Name:          "Plan",
+		Description:   "changed",
+		ActionConfigs: actionConfig.ID,
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "changed", updated.Description)
+	assert.Equal(t, created.Created, updated.Created)
+	assert.NotEqual(t, created.Hash, updated.Hash)
+
+	_, err = c.ActionConfig.DeleteActionConfig(ctx, actionConfig.ID)
+	require.Error(t, err, "action config in use by a plan cannot be deleted")
+
+	_, err = c.Plan.DeletePlan(ctx, created.ID)
+	require.NoError(t, err)
+	_, err = c.ActionConfig.DeleteActionConfig(ctx, actionConfig.ID)
+	require.NoError(t, err)
+
+	_, _, err = c.Plan.GetPlan(ctx, created.ID)
+	assert.True(t, client.IsNotFound(err))
+
+	_, _, err = c.Plan.UpdatePlan(ctx, created.ID, &plans.UpdatePlanRequest{
+		Name:          "Plan",
+		ActionConfigs: "missing",
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	assert.True(t, client.IsNotFound(err))
+}
+
+func TestServer_UpdateReplacesObject(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	actionConfig, _, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	require.NoError(t, err)
+	legacy := "legacy-telemetry"
+	created, _, err := c.Plan.CreatePlan(ctx, &plans.CreatePlanRequest{
+		Name:          "Plan",
+		Description:   "original",
+		ActionConfigs: actionConfig.ID,
+		Telemetry:     &legacy,
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.Telemetry)
+
+	// the update leaves out the telemetry reference, so the stored one is cleared
+	updated, _, err := c.Plan.UpdatePlan(ctx, created.ID, &plans.UpdatePlanRequest{
+		Name:          "Plan",
+		ActionConfigs: actionConfig.ID,
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, updated.Telemetry)
+	assert.Empty(t, updated.Description)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, created.Created, updated.Created)
+}
+
+func TestServer_OptimisticConcurrency(t *testing.T) {
+	server, c := newTestClient(t)
+	ctx := context.Background()
+
+	actionConfig, _, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	require.NoError(t, err)
+	read, _, err := c.Plan.CreatePlan(ctx, &plans.CreatePlanRequest{
+		Name:          "Plan",
+		ActionConfigs: actionConfig.ID,
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	require.NoError(t, err)
+
+	// another admin edits the plan after it was read
+	other, _, err := c.Plan.UpdatePlan(ctx, read.ID, &plans.UpdatePlanRequest{
+		Name:          "Plan",
+		Description:   "edited elsewhere",
+		ActionConfigs: actionConfig.ID,
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	require.NoError(t, err)
+
+	_, _, err = c.Plan.UpdatePlanIfMatch(ctx, read.ID, read.Hash, &plans.UpdatePlanRequest{
+		Name:          "Plan",
+		AutoUpdate:    true,
+		ActionConfigs: actionConfig.ID,
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	require.ErrorIs(t, err, client.ErrConflict)
+	var conflict *client.ConflictError[*plans.Plan]
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, other.Hash, conflict.CurrentHash)
+	assert.Equal(t, "edited elsewhere", conflict.Current.Description)
+	assert.Equal(t, 1, server.OperationCount("updatePlan"), "conflicting update is not sent")
+
+	updated, _, err := c.Plan.UpdatePlanIfMatch(ctx, read.ID, conflict.CurrentHash, &plans.UpdatePlanRequest{
+		Name:          "Plan",
+		Description:   conflict.Current.Description,
+		AutoUpdate:    true,
+		ActionConfigs: actionConfig.ID,
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+	require.NoError(t, err)
+	assert.True(t, updated.AutoUpdate)
+
+	// the first merge races with a concurrent edit, so it is retried against the newer plan
+	merges := 0
+	reads := server.OperationCount("getPlan")
+	modified, _, err := c.Plan.ModifyPlan(ctx, read.ID, func(current *plans.Plan, req *plans.UpdatePlanRequest) error {
+		merges++
+		if merges == 1 {
+			_, _, err := c.Plan.UpdatePlan(ctx, current.ID, &plans.UpdatePlanRequest{
+				Name:          "Plan",
+				Description:   "raced",
+				ActionConfigs: actionConfig.ID,
+				CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+			})
+			require.NoError(t, err)
+		}
+		req.Description = current.Description + " and merged"
+		return nil
+	}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, merges)
+	assert.Equal(t, 3, server.OperationCount("getPlan")-reads, "after the first read, one read per attempt")
+	assert.Equal(t, "raced and merged", modified.Description)
+	assert.Equal(t, actionConfig.ID, modified.ActionConfigs.ID)
+
+	modifiedConfig, _, err := c.ActionConfig.ModifyActionConfig(ctx, actionConfig.ID, func(_ *actionconfigs.ActionConfig, req *actionconfigs.UpdateActionConfigRequest) error {
+		req.Description = "merged"
+		return nil
+	}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "merged", modifiedConfig.Description)
+
+	_, _, err = c.ActionConfig.UpdateActionConfigIfMatch(ctx, actionConfig.ID, actionConfig.Hash, &actionconfigs.UpdateActionConfigRequest{
+		Name:        "Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	assert.True(t, client.IsConflict(err))
+}
+
+func TestServer_PatchKeepsUnsetFields(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	actionConfig, _, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	require.NoError(t, err)
+	telemetry, _, err := c.TelemetryV2.CreateTelemetryV2(ctx, &telemetryv2.CreateTelemetryV2Request{
+		Name:     "Telemetry",
+		LogFiles: []string{"/var/log/system.log"},
+		Events:   []string{"exec"},
+	})
+	require.NoError(t, err)
+	analytic, _, err := c.Analytic.CreateAnalytic(ctx, &analytics.CreateAnalyticRequest{
+		Name:      "Analytic",
+		InputType: analytics.InputTypeGPFSEvent,
+		Filter:    "$event.type == 0",
+		Tags:      []string{"fs"},
+		Severity:  analytics.SeverityLow,
+	})
+	require.NoError(t, err)
+	exceptionSet, _, err := c.ExceptionSet.CreateExceptionSet(ctx, &exceptionsets.CreateExceptionSetRequest{
+		Name: "Exceptions",
+		Exceptions: []exceptionsets.ExceptionInput{{
+			Type:           exceptionsets.ExceptionTypeUser,
+			Value:          "admin",
+			IgnoreActivity: exceptionsets.IgnoreActivityAnalytics,
+			AnalyticUuid:   analytic.UUID,
+		}},
+	})
+	require.NoError(t, err)
+	usbSet, _, err := c.USBControlSet.CreateUSBControlSet(ctx, &usbcontrolsets.CreateUSBControlSetRequest{
+		Name:               "USB",
+		DefaultMountAction: usbcontrolsets.MountActionReadOnly,
+		Rules: []usbcontrolsets.USBControlRuleInput{{
+			Type:       usbcontrolsets.RuleTypeSerial,
+			SerialRule: &usbcontrolsets.USBControlRuleDetails{MountAction: usbcontrolsets.MountActionReadWrite, Serials: []string{"SN1"}},
+		}},
+	})
+	require.NoError(t, err)
+	plan, _, err := c.Plan.CreatePlan(ctx, &plans.CreatePlanRequest{
+		Name:          "Plan",
+		ActionConfigs: actionConfig.ID,
+		ExceptionSets: []string{exceptionSet.UUID},
+		TelemetryV2:   &telemetry.ID,
+		USBControlSet: &usbSet.ID,
+		CommsConfig:   plans.CommsConfigInput{FQDN: "protect.example.com", Protocol: plans.ProtocolMQTT},
+		InfoSync:      plans.InfoSyncInput{Attrs: []string{"hostName"}, InsightsSyncInterval: 3600},
+	})
+	require.NoError(t, err)
+
+	patchedPlan, _, err := c.Plan.PatchPlan(ctx, plan.ID, func(req *plans.UpdatePlanRequest) {
+		req.Description = "patched"
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "patched", patchedPlan.Description)
+	assert.Equal(t, plan.CommsConfig, patchedPlan.CommsConfig)
+	assert.Equal(t, plan.InfoSync, patchedPlan.InfoSync)
+	assert.Equal(t, plan.ActionConfigs, patchedPlan.ActionConfigs)
+	assert.Equal(t, plan.ExceptionSets, patchedPlan.ExceptionSets)
+	assert.Equal(t, plan.TelemetryV2, patchedPlan.TelemetryV2)
+	assert.Equal(t, plan.USBControlSet, patchedPlan.USBControlSet)
+
+	patchedAnalytic, _, err := c.Analytic.PatchAnalytic(ctx, analytic.UUID, func(req *analytics.UpdateAnalyticRequest) {
+		severity := analytics.SeverityHigh
+		req.Severity = &severity
+	})
+	require.NoError(t, err)
+	assert.Equal(t, analytics.SeverityHigh, patchedAnalytic.Severity)
+	assert.Equal(t, analytic.Filter, patchedAnalytic.Filter)
+	assert.Equal(t, analytic.Tags, patchedAnalytic.Tags)
+
+	patchedExceptions, _, err := c.ExceptionSet.PatchExceptionSet(ctx, exceptionSet.UUID, func(req *exceptionsets.UpdateExceptionSetRequest) {
+		req.Description = "patched"
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "patched", patchedExceptions.Description)
+	assert.Equal(t, exceptionSet.Exceptions, patchedExceptions.Exceptions)
+
+	patchedUSB, _, err := c.USBControlSet.PatchUSBControlSet(ctx, usbSet.ID, func(req *usbcontrolsets.UpdateUSBControlSetRequest) {
+		req.DefaultMountAction = usbcontrolsets.MountActionPrevented
+	})
+	require.NoError(t, err)
+	assert.Equal(t, usbcontrolsets.MountActionPrevented, patchedUSB.DefaultMountAction)
+	assert.Equal(t, usbSet.Rules, patchedUSB.Rules)
+
+	patchedTelemetry, _, err := c.TelemetryV2.PatchTelemetryV2(ctx, telemetry.ID, func(req *telemetryv2.UpdateTelemetryV2Request) {
+		req.FileHashing = true
+	})
+	require.NoError(t, err)
+	assert.True(t, patchedTelemetry.FileHashing)
+	assert.Equal(t, telemetry.LogFiles, patchedTelemetry.LogFiles)
+	assert.Equal(t, telemetry.Events, patchedTelemetry.Events)
+
+	_, _, err = c.TelemetryV2.PatchTelemetryV2(ctx, "missing", func(*telemetryv2.UpdateTelemetryV2Request) {})
+	assert.True(t, client.IsNotFound(err))
+}
+
+func TestServer_RejectsUnknownReferences(t *testing.T) {
+	_, c := newTestClient(t)
+
+	_, _, err := c.Plan.CreatePlan(context.Background(), &plans.CreatePlanRequest{
+		Name:          "Plan",
+		ActionConfigs: "42",
+		CommsConfig:   plans.CommsConfigInput{Protocol: plans.ProtocolMQTT},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `actionConfigs references unknown action config "42"`)
+	assert.False(t, client.IsNotFound(err))
+}
+
+func TestServer_Pagination(t *testing.T) {
+	server, c := newTestClient(t, jamfprotecttest.WithPageSize(2))
+	ctx := context.Background()
+
+	for i := range 5 {
+		_, _, err := c.UnifiedLoggingFilter.CreateUnifiedLoggingFilter(ctx, &unifiedloggingfilters.CreateUnifiedLoggingFilterRequest{
+			Name:   fmt.Sprintf("filter-%d", i),
+			Filter: "subsystem == \"com.example\"",
+		})
+		require.NoError(t, err)
+	}
+
+	filters, _, err := c.UnifiedLoggingFilter.ListUnifiedLoggingFilters(ctx)
+	require.NoError(t, err)
+	require.Len(t, filters, 5)
+	for i, filter := range filters {
+		assert.Equal(t, fmt.Sprintf("filter-%d", i), filter.Name)
+	}
+	assert.Equal(t, 3, server.OperationCount("listUnifiedLoggingFilters"))
+
+	names, _, err := c.UnifiedLoggingFilter.ListUnifiedLoggingFilterNames(ctx)
+	require.NoError(t, err)
+	assert.Len(t, names, 5)
+}
+
+func TestServer_LookupByName(t *testing.T) {
+	server, c := newTestClient(t, jamfprotecttest.WithPageSize(2))
+	ctx := context.Background()
+
+	for _, name := range []string{"alpha", "beta", "gamma", "beta"} {
+		_, _, err := c.UnifiedLoggingFilter.CreateUnifiedLoggingFilter(ctx, &unifiedloggingfilters.CreateUnifiedLoggingFilterRequest{
+			Name:   name,
+			Filter: "subsystem == \"com.example\"",
+		})
+		require.NoError(t, err)
+	}
+
+	filter, _, err := c.UnifiedLoggingFilter.GetUnifiedLoggingFilterByName(ctx, "gamma")
+	require.NoError(t, err)
+	assert.Equal(t, "gamma", filter.Name)
+	assert.Equal(t, 1, server.OperationCount("listUnifiedLoggingFilters"), "name filter is applied server-side")
+
+	_, _, err = c.UnifiedLoggingFilter.GetUnifiedLoggingFilterByName(ctx, "beta")
+	assert.ErrorIs(t, err, client.ErrAmbiguousName)
+
+	_, _, err = c.UnifiedLoggingFilter.GetUnifiedLoggingFilterByName(ctx, "delta")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	assert.True(t, client.IsNotFound(err))
+
+	actionConfig, _, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "Default Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	require.NoError(t, err)
+
+	found, _, err := c.ActionConfig.GetActionConfigByName(ctx, "Default Actions")
+	require.NoError(t, err)
+	assert.Equal(t, actionConfig.ID, found.ID)
+	assert.NotNil(t, found.AlertConfig, "the full object is returned, not the list item")
+}
+
+func TestServer_UpsertIsIdempotent(t *testing.T) {
+	server, c := newTestClient(t)
+	ctx := context.Background()
+
+	// runs upserts twice and reports the actions, then the action after changing the input
+	check := func(t *testing.T, upsert func() (client.UpsertAction, error), change func()) {
+		t.Helper()
+		for _, want := range []client.UpsertAction{client.UpsertCreated, client.UpsertUnchanged} {
+			got, err := upsert()
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+		change()
+		got, err := upsert()
+		require.NoError(t, err)
+		assert.Equal(t, client.UpsertUpdated, got)
+	}
+
+	actionConfigReq := &actionconfigs.CreateActionConfigRequest{
+		Name: "Actions",
+		AlertConfig: map[string]any{"data": map[string]any{
+			"binary": map[string]any{"attrs": []string{"sha256hex"}, "related": []string{}},
+		}},
+		Clients: []map[string]any{{
+			"type":             "Http",
+			"supportedReports": []string{"AlertReport"},
+			"params": map[string]any{
+				"url":     "https://siem.example.com/ingest",
+				"method":  "POST",
+				"headers": []map[string]any{{"header": "X-Api-Key", "value": "secret"}},
+			},
+		}},
+	}
+	var actionConfig *actionconfigs.ActionConfig
+	check(t, func() (client.UpsertAction, error) {
+		var action client.UpsertAction
+		var err error
+		actionConfig, action, _, err = c.ActionConfig.UpsertActionConfig(ctx, actionConfigReq)
+		return action, err
+	}, func() { actionConfigReq.Description = "changed" })
+
+	analyticReq := &analytics.CreateAnalyticRequest{
+		Name:       "Analytic",
+		InputType:  analytics.InputTypeGPFSEvent,
+		Filter:     "$event.path BEGINSWITH \"/tmp\"",
+		Categories: []string{"Execution"},
+		Severity:   analytics.SeverityLow,
+		Context:    []analytics.AnalyticContextInput{{Name: "path", Type: "String", Exprs: []string{"$event.path"}}},
+	}
+	var analytic *analytics.Analytic
+	check(t, func() (client.UpsertAction, error) {
+		var action client.UpsertAction
+		var err error
+		analytic, action, _, err = c.Analytic.UpsertAnalytic(ctx, analyticReq)
+		return action, err
+	}, func() { analyticReq.Severity = analytics.SeverityHigh })
+
+	setReq := &analyticsets.CreateAnalyticSetRequest{Name: "Set", Types: []string{"Report"}, Analytics: []string{analytic.UUID}}
+	var set *analyticsets.AnalyticSet
+	check(t, func() (client.UpsertAction, error) {
+		var action client.UpsertAction
+		var err error
+		set, action, _, err = c.AnalyticSet.UpsertAnalyticSet(ctx, setReq)
+		return action, err
+	}, func() { setReq.Description = "changed" })
+
+	exceptionReq := &exceptionsets.CreateExceptionSetRequest{
+		Name: "Exceptions",
+		Exceptions: []exceptionsets.ExceptionInput{{
+			Type:           exceptionsets.ExceptionTypeUser,
+			Value:          "admin",
+			IgnoreActivity: exceptionsets.IgnoreActivityAnalytics,
+			AnalyticUuid:   analytic.UUID,
+		}},
+	}
+	var exceptionSet *exceptionsets.ExceptionSet
+	check(t, func() (client.UpsertAction, error) {
+		var action client.UpsertAction
+		var err error
+		exceptionSet, action, _, err = c.ExceptionSet.UpsertExceptionSet(ctx, exceptionReq)
+		return action, err
+	}, func() { exceptionReq.Exceptions[0].Value = "root" })
+
+	preventReq := &preventlists.CreatePreventListRequest{Name: "Block", Type: preventlists.PreventTypeTEAMID, List: []string{"ABCDE12345"}}
+	check(t, func() (client.UpsertAction, error) {
+		_, action, _, err := c.PreventList.UpsertPreventList(ctx, preventReq)
+		return action, err
+	}, func() { preventReq.List = append(preventReq.List, "FGHIJ67890") })
+
+	usbReq := &usbcontrolsets.CreateUSBControlSetRequest{
+		Name:               "USB",
+		DefaultMountAction: usbcontrolsets.MountActionReadOnly,
+		Rules: []usbcontrolsets.USBControlRuleInput{{
+			Type:       usbcontrolsets.RuleTypeVendor,
+			VendorRule: &usbcontrolsets.USBControlRuleDetails{MountAction: usbcontrolsets.MountActionPrevented, Vendors: []string{"0x1234"}},
+		}},
+	}
+	var usbSet *usbcontrolsets.USBControlSet
+	check(t, func() (client.UpsertAction, error) {
+		var action client.UpsertAction
+		var err error
+		usbSet, action, _, err = c.USBControlSet.UpsertUSBControlSet(ctx, usbReq)
+		return action, err
+	}, func() { usbReq.Rules[0].VendorRule.Vendors = []string{"0x5678"} })
+
+	telemetryReq := &telemetryv2.CreateTelemetryV2Request{Name: "Telemetry", LogFiles: []string{"/var/log/system.log"}, Events: []string{"exec"}}
+	var telemetry *telemetryv2.TelemetryV2
+	check(t, func() (client.UpsertAction, error) {
+		var action client.UpsertAction
+		var err error
+		telemetry, action, _, err = c.TelemetryV2.UpsertTelemetryV2(ctx, telemetryReq)
+		return action, err
+	}, func() { telemetryReq.FileHashing = true })
+
+	filterReq := &unifiedloggingfilters.CreateUnifiedLoggingFilterRequest{Name: "Filter", Filter: "subsystem == \"com.example\""}
+	check(t, func() (client.UpsertAction, error) {
+		_, action, _, err := c.UnifiedLoggingFilter.UpsertUnifiedLoggingFilter(ctx, filterReq)
+		return action, err
+	}, func() { filterReq.Enabled = true })
+
+	planReq := &plans.CreatePlanRequest{
+		Name:          "Plan",
+		ActionConfigs: actionConfig.ID,
+		ExceptionSets: []string{exceptionSet.UUID},
+		TelemetryV2:   &telemetry.ID,
+		AnalyticSets:  []plans.AnalyticSetInput{{Type: "Report", UUID: set.UUID}},
+		USBControlSet: &usbSet.ID,
+		CommsConfig:   plans.CommsConfigInput{FQDN: "protect.example.com", Protocol: plans.ProtocolMQTT},
+		InfoSync:      plans.InfoSyncInput{Attrs: []string{"hostName"}, InsightsSyncInterval: 3600},
+	}
+	check(t, func() (client.UpsertAction, error) {
+		_, action, _, err := c.Plan.UpsertPlan(ctx, planReq)
+		return action, err
+	}, func() { planReq.AutoUpdate = true })
+
+	assert.Equal(t, 1, server.OperationCount("createPlan"))
+	assert.Equal(t, 1, server.OperationCount("updatePlan"))
+
+	renamed, action, _, err := c.TelemetryV2.UpsertTelemetryV2(ctx, &telemetryv2.CreateTelemetryV2Request{
+		Name:     "Renamed Telemetry",
+		LogFiles: []string{"/var/log/system.log"},
+	}, client.UpsertByID(telemetry.ID))
+	require.NoError(t, err)
+	assert.Equal(t, client.UpsertUpdated, action)
+	assert.Equal(t, telemetry.ID, renamed.ID)
+
+	_, _, _, err = c.TelemetryV2.UpsertTelemetryV2(ctx, telemetryReq, client.UpsertByID("missing"))
+	assert.True(t, client.IsNotFound(err))
+}
+
+func TestServer_ExceptionAndUSBRuleShapes(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	analytic, _, err := c.Analytic.CreateAnalytic(ctx, &analytics.CreateAnalyticRequest{
+		Name:      "Analytic",
+		InputType: analytics.InputTypeGPFSEvent,
+		Filter:    "$event.path BEGINSWITH \"/tmp\"",
+		Severity:  analytics.SeverityLow,
+	})
+	require.NoError(t, err)
+
+	exceptionSet, _, err := c.ExceptionSet.CreateExceptionSet(ctx, &exceptionsets.CreateExceptionSetRequest{
+		Name: "Exceptions",
+		Exceptions: []exceptionsets.ExceptionInput{{
+			Type:           exceptionsets.ExceptionTypeUser,
+			Value:          "admin",
+			IgnoreActivity: exceptionsets.IgnoreActivityAnalytics,
+			AnalyticUuid:   analytic.UUID,
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, exceptionSet.Exceptions, 1)
+	assert.Equal(t, &exceptionsets.AnalyticRef{Name: "Analytic", UUID: analytic.UUID}, exceptionSet.Exceptions[0].Analytic)
+
+	usbSet, _, err := c.USBControlSet.CreateUSBControlSet(ctx, &usbcontrolsets.CreateUSBControlSetRequest{
+		Name:               "USB",
+		DefaultMountAction: usbcontrolsets.MountActionReadOnly,
+		Rules: []usbcontrolsets.USBControlRuleInput{{
+			Type:       "Vendor",
+			VendorRule: &usbcontrolsets.USBControlRuleDetails{MountAction: usbcontrolsets.MountActionPrevented, Vendors: []string{"0x1234"}},
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, usbSet.Rules, 1)
+	assert.Equal(t, "Vendor", usbSet.Rules[0].Type)
+	assert.Equal(t, usbcontrolsets.MountActionPrevented, usbSet.Rules[0].MountAction)
+	assert.Equal(t, []string{"0x1234"}, usbSet.Rules[0].Vendors)
+
+	_, err = c.Analytic.DeleteAnalytic(ctx, analytic.UUID)
+	assert.Error(t, err, "analytic referenced by an exception set cannot be deleted")
+}
+
+func TestServer_SeededManagedObjects(t *testing.T) {
+	server, c := newTestClient(t)
+	ctx := context.Background()
+
+	uuid, err := server.Put(jamfprotecttest.KindAnalytic, map[string]any{
+		"name":       "Jamf Analytic",
+		"jamf":       true,
+		"categories": []string{"Persistence"},
+		"tags":       []string{"MITRE"},
+	})
+	require.NoError(t, err)
+
+	got, _, err := c.Analytic.GetAnalytic(ctx, uuid)
+	require.NoError(t, err)
+	assert.True(t, got.Jamf)
+
+	_, _, err = c.Analytic.UpdateAnalytic(ctx, uuid, &analytics.UpdateAnalyticRequest{
+		Name:      "Renamed",
+		InputType: analytics.InputTypeGPFSEvent,
+	})
+	assert.Error(t, err, "Jamf-managed analytics are read-only")
+
+	categories, _, err := c.Analytic.ListAnalyticsCategories(ctx)
+	require.NoError(t, err)
+	require.Len(t, categories, 1)
+	assert.Equal(t, "Persistence", categories[0].Value)
+	assert.Equal(t, 1, server.Len(jamfprotecttest.KindAnalytic))
+}
+
+func TestServer_Authentication(t *testing.T) {
+	server, c := newTestClient(t)
+	ctx := context.Background()
+
+	_, _, err := c.Plan.ListPlans(ctx)
+	require.NoError(t, err)
+
+	server.RevokeTokens()
+	_, _, err = c.Plan.ListPlans(ctx)
+	require.NoError(t, err, "client re-authenticates after the token is revoked")
+
+	bad, err := jamfprotect.NewClient("wrong", "credentials", client.WithBaseURL(server.URL), client.WithLogger(zap.NewNop()))
+	require.NoError(t, err)
+	_, _, err = bad.Plan.ListPlans(ctx)
+	assert.Error(t, err)
+}
+
+func TestServer_DryRunLeavesStoresUntouched(t *testing.T) {
+	server := jamfprotecttest.NewServer()
+	t.Cleanup(server.Close)
+	c, err := server.NewClient(client.WithLogger(zap.NewNop()), client.WithDryRun())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	created, resp, err := c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{
+		Name:        "Default Actions",
+		AlertConfig: map[string]any{"data": map[string]any{}},
+	})
+	require.NoError(t, err)
+	assert.True(t, client.IsDryRun(resp))
+	assert.Equal(t, "Default Actions", created.Name)
+	assert.Equal(t, client.DryRunIDPrefix+"1", created.ID)
+
+	_, err = c.Plan.DeletePlan(ctx, "1")
+	require.NoError(t, err)
+
+	_, _, err = c.ActionConfig.CreateActionConfig(ctx, &actionconfigs.CreateActionConfigRequest{})
+	require.Error(t, err, "input is still validated")
+
+	assert.Equal(t, 0, server.Len(jamfprotecttest.KindActionConfig))
+	assert.Equal(t, 0, server.OperationCount("createActionConfigs"))
+
+	ops := c.ChangeSet().Operations()
+	require.Len(t, ops, 2)
+	assert.Equal(t, "CreateActionConfig", ops[0].Method)
+	assert.Equal(t, "DeletePlan", ops[1].Method)
+	assert.Equal(t, "1", ops[1].ID)
+}
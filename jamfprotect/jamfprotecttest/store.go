@@ -0,0 +1,244 @@
+package jamfprotecttest
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Kind identifies a resource store
+type Kind string
+
+// Resource kinds held by the fake
+const (
+	KindPlan                 Kind = "plan"
+	KindAnalytic             Kind = "analytic"
+	KindAnalyticSet          Kind = "analytic_set"
+	KindExceptionSet         Kind = "exception_set"
+	KindPreventList          Kind = "prevent_list"
+	KindUSBControlSet        Kind = "usb_control_set"
+	KindTelemetryV2          Kind = "telemetry_v2"
+	KindActionConfig         Kind = "action_config"
+	KindUnifiedLoggingFilter Kind = "unified_logging_filter"
+)
+
+// kindSpec describes how objects of a kind are identified and which fields mutations set
+type kindSpec struct {
+	label   string
+	idField string
+	fields  []string
+
+	// managedField names the flag that marks Jamf-managed objects, which cannot be
+	// modified through the API
+	managedField string
+}
+
+var kinds = map[Kind]kindSpec{
+	KindPlan: {label: "plan", idField: "id", fields: []string{
+		"name", "description", "logLevel", "actionConfigs", "exceptionSets", "telemetry",
+		"telemetryV2", "analyticSets", "usbControlSet", "commsConfig", "infoSync",
+		"autoUpdate", "signaturesFeedConfig",
+	}},
+	KindAnalytic: {label: "analytic", idField: "uuid", managedField: "jamf", fields: []string{
+		"name", "inputType", "description", "actions", "analyticActions", "tags",
+		"categories", "filter", "context", "level", "severity", "snapshotFiles",
+	}},
+	KindAnalyticSet: {label: "analytic set", idField: "uuid", managedField: "managed", fields: []string{
+		"name", "description", "types", "analytics",
+	}},
+	KindExceptionSet: {label: "exception set", idField: "uuid", managedField: "managed", fields: []string{
+		"name", "description", "exceptions", "esExceptions",
+	}},
+	KindPreventList: {label: "prevent list", idField: "id", fields: []string{
+		"name", "description", "type", "tags", "list",
+	}},
+	KindUSBControlSet: {label: "USB control set", idField: "id", fields: []string{
+		"name", "description", "defaultMountAction", "defaultMessageAction", "rules",
+	}},
+	KindTelemetryV2: {label: "telemetry", idField: "id", fields: []string{
+		"name", "description", "logFiles", "logFileCollection", "performanceMetrics",
+		"events", "fileHashing",
+	}},
+	KindActionConfig: {label: "action config", idField: "id", fields: []string{
+		"name", "description", "alertConfig", "clients",
+	}},
+	KindUnifiedLoggingFilter: {label: "unified logging filter", idField: "uuid", fields: []string{
+		"name", "description", "tags", "filter", "enabled",
+	}},
+}
+
+// store holds the objects of one kind in creation order
+type store struct {
+	items map[string]map[string]any
+	order []string
+}
+
+func newStore() *store {
+	return &store{items: make(map[string]map[string]any)}
+}
+
+func (st *store) put(id string, obj map[string]any) {
+	if _, exists := st.items[id]; !exists {
+		st.order = append(st.order, id)
+	}
+	st.items[id] = obj
+}
+
+func (st *store) remove(id string) {
+	delete(st.items, id)
+	for i, existing := range st.order {
+		if existing == id {
+			st.order = append(st.order[:i], st.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// all returns the stored objects in creation order
+func (st *store) all() []map[string]any {
+	out := make([]map[string]any, 0, len(st.order))
+	for _, id := range st.order {
+		out = append(out, st.items[id])
+	}
+	return out
+}
+
+// Put stores obj as an object of the given kind and returns its identifier. A missing
+// identifier, created or updated timestamp and hash are generated. Use Put to seed
+// objects the API cannot create, such as Jamf-managed analytics ("jamf": true) or
+// managed analytic and exception sets ("managed": true). obj is copied; JSON field
+// names match the API.
+func (s *Server) Put(kind Kind, obj map[string]any) (string, error) {
+	spec, ok := kinds[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown kind %q", kind)
+	}
+
+	stored, err := normalize(obj)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _ := stored[spec.idField].(string)
+	if id == "" {
+		id = s.newID(kind)
+		stored[spec.idField] = id
+	}
+	now := s.timestamp()
+	if _, ok := stored["created"]; !ok {
+		stored["created"] = now
+	}
+	if _, ok := stored["updated"]; !ok {
+		stored["updated"] = now
+	}
+	if _, ok := stored["hash"]; !ok {
+		stored["hash"] = contentHash(spec, stored)
+	}
+	s.stores[kind].put(id, stored)
+	return id, nil
+}
+
+// Get returns a copy of the stored object with the given identifier. References are
+// returned as stored (identifiers), not resolved as in query responses.
+func (s *Server) Get(kind Kind, id string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.stores[kind]
+	if !ok {
+		return nil, false
+	}
+	obj, ok := st.items[id]
+	if !ok {
+		return nil, false
+	}
+	return clone(obj), true
+}
+
+// Len returns the number of stored objects of a kind
+func (s *Server) Len(kind Kind) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if st, ok := s.stores[kind]; ok {
+		return len(st.items)
+	}
+	return 0
+}
+
+// newID generates an identifier for a new object. Callers must hold mu.
+func (s *Server) newID(kind Kind) string {
+	s.seq++
+	if kinds[kind].idField == "uuid" {
+		return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.seq)
+	}
+	return strconv.Itoa(s.seq)
+}
+
+// timestamp returns the current time in the API's format
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// contentHash hashes the mutable fields of an object, so the hash changes exactly when
+// an update changes the object
+func contentHash(spec kindSpec, obj map[string]any) string {
+	content := make(map[string]any, len(spec.fields))
+	for _, field := range spec.fields {
+		content[field] = obj[field]
+	}
+	data, _ := json.Marshal(content)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// page returns the page of items starting at the offset encoded in next, and the token
+// for the following page or nil on the last page
+func page(items []map[string]any, next any, size int) ([]map[string]any, any, *graphQLError) {
+	offset := 0
+	if token, ok := next.(string); ok && token != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		if err == nil {
+			offset, err = strconv.Atoi(strings.TrimPrefix(string(decoded), "offset:"))
+		}
+		if err != nil || offset < 0 || offset > len(items) {
+			return nil, nil, badRequest("invalid nextToken %q", token)
+		}
+	}
+
+	end := min(offset+size, len(items))
+	var nextToken any
+	if end < len(items) {
+		nextToken = base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(end)))
+	}
+	return items[offset:end], nextToken, nil
+}
+
+// normalize round-trips a value through JSON so stored objects only hold JSON types
+func normalize(obj map[string]any) (map[string]any, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("encoding object: %w", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("decoding object: %w", err)
+	}
+	if out == nil {
+		out = map[string]any{}
+	}
+	return out, nil
+}
+
+// clone deep-copies a JSON object
+func clone(obj map[string]any) map[string]any {
+	out, _ := normalize(obj)
+	return out
+}
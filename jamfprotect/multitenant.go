@@ -0,0 +1,371 @@
+package jamfprotect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+)
+
+// Multi-tenant defaults
+const (
+	// DefaultFanOutParallelism is the number of tenants processed concurrently by ForEachTenant
+	DefaultFanOutParallelism = 8
+
+	// defaultMaxIdleConnsPerHost sizes the shared connection pool; all tenants usually share one API host
+	defaultMaxIdleConnsPerHost = 64
+)
+
+// TenantConfig describes one Jamf Protect tenant registered with a MultiTenant registry
+type TenantConfig struct {
+	// Name uniquely identifies the tenant within the registry
+	Name string
+
+	// ClientID and ClientSecret are the tenant's API client credentials
+	ClientID     string
+	ClientSecret string
+
+	// BaseURL is the tenant's API base URL. Defaults to client.DefaultBaseURL.
+	BaseURL string
+
+	// Options are additional client options applied to this tenant only, after the
+	// registry's common options
+	Options []client.ClientOption
+}
+
+// MultiTenantOption configures a MultiTenant registry
+type MultiTenantOption func(*MultiTenant) error
+
+// MultiTenant is a registry of Jamf Protect clients, one per tenant, for MSPs managing
+// many tenants from one process. All tenants share one HTTP connection pool, one logger
+// and an optional global concurrency limit, while each keeps its own credentials,
+// base URL and token manager (and token cache file, if configured).
+type MultiTenant struct {
+	mu      sync.RWMutex
+	tenants map[string]*Client
+
+	baseTransport   http.RoundTripper
+	logger          *zap.Logger
+	maxConcurrent   int
+	parallelism     int
+	tokenCacheDir   string
+	commonOptions   []client.ClientOption
+	sharedTransport http.RoundTripper
+}
+
+// NewMultiTenant creates an empty multi-tenant registry
+//
+// Example:
+//
+//	registry, err := jamfprotect.NewMultiTenant(
+//	    jamfprotect.WithGlobalConcurrencyLimit(20),
+//	    jamfprotect.WithFanOutParallelism(5),
+//	)
+//	_, err = registry.AddTenant(jamfprotect.TenantConfig{Name: "acme", ClientID: id, ClientSecret: secret})
+func NewMultiTenant(options ...MultiTenantOption) (*MultiTenant, error) {
+	m := &MultiTenant{
+		tenants:     make(map[string]*Client),
+		parallelism: DefaultFanOutParallelism,
+	}
+
+	for _, opt := range options {
+		if err := opt(m); err != nil {
+			return nil, fmt.Errorf("applying multi-tenant option: %w", err)
+		}
+	}
+
+	if m.logger == nil {
+		m.logger = client.DefaultLogger()
+	}
+
+	base := m.baseTransport
+	if base == nil {
+		pool := http.DefaultTransport.(*http.Transport).Clone()
+		pool.MaxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+		base = pool
+	}
+	m.sharedTransport = base
+
+	if m.maxConcurrent > 0 {
+		limited, err := client.NewConcurrencyLimitTransport(base, m.maxConcurrent)
+		if err != nil {
+			return nil, err
+		}
+		m.sharedTransport = limited
+	}
+
+	return m, nil
+}
+
+// WithSharedHTTPTransport sets the round tripper shared by every tenant's HTTP client.
+// By default a clone of http.DefaultTransport with a larger idle connection pool is used.
+// Configure proxies and TLS on this transport rather than per tenant, since per-client
+// options such as client.WithProxy would modify the shared transport.
+func WithSharedHTTPTransport(rt http.RoundTripper) MultiTenantOption {
+	return func(m *MultiTenant) error {
+		m.baseTransport = rt
+		return nil
+	}
+}
+
+// WithSharedLogger sets the logger shared by all tenants. Each tenant logs with a "tenant" field.
+func WithSharedLogger(logger *zap.Logger) MultiTenantOption {
+	return func(m *MultiTenant) error {
+		if logger == nil {
+			return fmt.Errorf("logger cannot be nil")
+		}
+		m.logger = logger
+		return nil
+	}
+}
+
+// WithGlobalConcurrencyLimit bounds the number of in-flight HTTP requests across all tenants
+func WithGlobalConcurrencyLimit(max int) MultiTenantOption {
+	return func(m *MultiTenant) error {
+		if max <= 0 {
+			return fmt.Errorf("global concurrency limit must be greater than 0, got %d", max)
+		}
+		m.maxConcurrent = max
+		return nil
+	}
+}
+
+// WithFanOutParallelism sets how many tenants ForEachTenant processes concurrently
+func WithFanOutParallelism(n int) MultiTenantOption {
+	return func(m *MultiTenant) error {
+		if n <= 0 {
+			return fmt.Errorf("fan-out parallelism must be greater than 0, got %d", n)
+		}
+		m.parallelism = n
+		return nil
+	}
+}
+
+// WithTenantTokenCacheDir enables a file-backed token cache per tenant in dir.
+// Cache file names are derived from each tenant's base URL and client ID.
+func WithTenantTokenCacheDir(dir string) MultiTenantOption {
+	return func(m *MultiTenant) error {
+		m.tokenCacheDir = dir
+		return nil
+	}
+}
+
+// WithCommonClientOptions sets client options applied to every tenant
+func WithCommonClientOptions(options ...client.ClientOption) MultiTenantOption {
+	return func(m *MultiTenant) error {
+		m.commonOptions = append(m.commonOptions, options...)
+		return nil
+	}
+}
+
+// AddTenant builds a client for the tenant and registers it under cfg.Name
+func (m *MultiTenant) AddTenant(cfg TenantConfig) (*Client, error) {
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("%w: tenant name is required", client.ErrInvalidInput)
+	}
+
+	m.mu.RLock()
+	_, exists := m.tenants[cfg.Name]
+	m.mu.RUnlock()
+	if exists {
+		return nil, fmt.Errorf("%w: tenant %q is already registered", client.ErrInvalidInput, cfg.Name)
+	}
+
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = client.DefaultBaseURL
+	}
+
+	options := []client.ClientOption{
+		client.WithLogger(m.logger.With(zap.String("tenant", cfg.Name))),
+		client.WithBaseURL(baseURL),
+		client.WithTransport(m.sharedTransport),
+	}
+	if m.tokenCacheDir != "" {
+		path := filepath.Join(m.tokenCacheDir, client.TokenCacheFileName(baseURL, cfg.ClientID))
+		options = append(options, client.WithTokenCacheFile(path))
+	}
+	options = append(options, m.commonOptions...)
+	options = append(options, cfg.Options...)
+
+	c, err := NewClient(cfg.ClientID, cfg.ClientSecret, options...)
+	if err != nil {
+		return nil, fmt.Errorf("creating client for tenant %q: %w", cfg.Name, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, exists := m.tenants[cfg.Name]; exists {
+		c.Close()
+		return nil, fmt.Errorf("%w: tenant %q is already registered", client.ErrInvalidInput, cfg.Name)
+	}
+	m.tenants[cfg.Name] = c
+
+	return c, nil
+}
+
+// Tenant returns the client registered under name
+func (m *MultiTenant) Tenant(name string) (*Client, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	c, ok := m.tenants[name]
+	return c, ok
+}
+
+// Tenants returns the registered tenant names in sorted order
+func (m *MultiTenant) Tenants() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	names := make([]string, 0, len(m.tenants))
+	for name := range m.tenants {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// RemoveTenant unregisters the tenant and closes its client
+func (m *MultiTenant) RemoveTenant(name string) error {
+	m.mu.Lock()
+	c, ok := m.tenants[name]
+	delete(m.tenants, name)
+	m.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: tenant %q", client.ErrNotFound, name)
+	}
+	return c.Close()
+}
+
+// Close closes every tenant client and empties the registry
+func (m *MultiTenant) Close() error {
+	m.mu.Lock()
+	tenants := m.tenants
+	m.tenants = make(map[string]*Client)
+	m.mu.Unlock()
+
+	var errs []error
+	for name, c := range tenants {
+		if err := c.Close(); err != nil {
+			errs = append(errs, &TenantError{Tenant: name, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// TenantFunc is invoked by ForEachTenant for each registered tenant
+type TenantFunc func(ctx context.Context, tenant string, c *Client) error
+
+// ForEachTenant calls fn for every registered tenant, running at most the configured
+// fan-out parallelism concurrently. A failing tenant does not stop the others; all failures
+// are returned together as a *MultiTenantError. When ctx is cancelled, tenants that have
+// not started yet fail with the context error.
+func (m *MultiTenant) ForEachTenant(ctx context.Context, fn TenantFunc) error {
+	names := m.Tenants()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []*TenantError
+	)
+	sem := make(chan struct{}, m.parallelism)
+
+	record := func(name string, err error) {
+		mu.Lock()
+		errs = append(errs, &TenantError{Tenant: name, Err: err})
+		mu.Unlock()
+	}
+
+	for _, name := range names {
+		c, ok := m.Tenant(name)
+		if !ok {
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			record(name, ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			started := time.Now()
+			if err := fn(ctx, name, c); err != nil {
+				m.logger.Warn("Tenant operation failed",
+					zap.String("tenant", name),
+					zap.Duration("duration", time.Since(started)),
+					zap.Error(err))
+				record(name, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	slices.SortFunc(errs, func(a, b *TenantError) int { return strings.Compare(a.Tenant, b.Tenant) })
+	return &MultiTenantError{Errors: errs}
+}
+
+// TenantError is an error returned for a single tenant
+type TenantError struct {
+	Tenant string
+	Err    error
+}
+
+// Error implements the error interface
+func (e *TenantError) Error() string {
+	return fmt.Sprintf("tenant %q: %v", e.Tenant, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *TenantError) Unwrap() error {
+	return e.Err
+}
+
+// MultiTenantError aggregates per-tenant failures from ForEachTenant, sorted by tenant name
+type MultiTenantError struct {
+	Errors []*TenantError
+}
+
+// Error implements the error interface
+func (e *MultiTenantError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, te := range e.Errors {
+		msgs = append(msgs, te.Error())
+	}
+	return fmt.Sprintf("%d tenant(s) failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the per-tenant errors so errors.Is and errors.As inspect each of them
+func (e *MultiTenantError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, te := range e.Errors {
+		errs = append(errs, te)
+	}
+	return errs
+}
+
+// Failed returns the names of the tenants that failed
+func (e *MultiTenantError) Failed() []string {
+	names := make([]string, 0, len(e.Errors))
+	for _, te := range e.Errors {
+		names = append(names, te.Tenant)
+	}
+	return names
+}
@@ -0,0 +1,179 @@
+package jamfprotect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+)
+
+// tenantServer is a minimal Jamf Protect stand-in that issues a token per client ID
+// and records the peak number of concurrent /app requests
+type tenantServer struct {
+	*httptest.Server
+	inFlight atomic.Int32
+	peak     atomic.Int32
+	mu       sync.Mutex
+	seen     map[string]string // token -> client ID
+}
+
+func newTenantServer(t *testing.T) *tenantServer {
+	t.Helper()
+	s := &tenantServer{seen: make(map[string]string)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case client.EndpointToken:
+			var req client.TokenRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			json.NewEncoder(w).Encode(client.TokenResponse{AccessToken: "token-for-" + req.ClientID, ExpiresIn: 3600})
+		case client.EndpointApp:
+			n := s.inFlight.Add(1)
+			defer s.inFlight.Add(-1)
+			for {
+				p := s.peak.Load()
+				if n <= p || s.peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			w.Write([]byte(`{"data":{"listPlanNames":{"items":[{"name":"plan"}],"pageInfo":{"next":null,"total":1}}}}`))
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestRegistry(t *testing.T, server *tenantServer, names []string, options ...MultiTenantOption) *MultiTenant {
+	t.Helper()
+	options = append([]MultiTenantOption{WithSharedLogger(zap.NewNop())}, options...)
+	registry, err := NewMultiTenant(options...)
+	require.NoError(t, err)
+	t.Cleanup(func() { registry.Close() })
+
+	for _, name := range names {
+		_, err := registry.AddTenant(TenantConfig{
+			Name:         name,
+			ClientID:     name + "-id",
+			ClientSecret: name + "-secret",
+			BaseURL:      server.URL,
+		})
+		require.NoError(t, err)
+	}
+	return registry
+}
+
+func TestMultiTenant_AddAndLookup(t *testing.T) {
+	server := newTenantServer(t)
+	registry := newTestRegistry(t, server, []string{"beta", "alpha"})
+
+	assert.Equal(t, []string{"alpha", "beta"}, registry.Tenants())
+
+	alpha, ok := registry.Tenant("alpha")
+	require.True(t, ok)
+	token, err := alpha.GetTokenManager().GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-for-alpha-id", token, "each tenant authenticates with its own credentials")
+
+	_, err = registry.AddTenant(TenantConfig{Name: "alpha", ClientID: "x", ClientSecret: "y", BaseURL: server.URL})
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	require.NoError(t, registry.RemoveTenant("beta"))
+	assert.ErrorIs(t, registry.RemoveTenant("beta"), client.ErrNotFound)
+}
+
+func TestMultiTenant_ForEachTenant_AggregatesErrors(t *testing.T) {
+	server := newTenantServer(t)
+	registry := newTestRegistry(t, server, []string{"a", "b", "c", "d"})
+	errBoom := errors.New("boom")
+
+	var visited sync.Map
+	err := registry.ForEachTenant(context.Background(), func(ctx context.Context, tenant string, c *Client) error {
+		visited.Store(tenant, true)
+		if tenant == "b" || tenant == "d" {
+			return errBoom
+		}
+		_, _, err := c.Plan.ListPlanNames(ctx)
+		return err
+	})
+
+	var mtErr *MultiTenantError
+	require.ErrorAs(t, err, &mtErr)
+	assert.Equal(t, []string{"b", "d"}, mtErr.Failed())
+	assert.ErrorIs(t, err, errBoom)
+	for _, name := range []string{"a", "b", "c", "d"} {
+		_, ok := visited.Load(name)
+		assert.True(t, ok, "tenant %s should be visited despite failures", name)
+	}
+}
+
+func TestMultiTenant_ForEachTenant_BoundedParallelism(t *testing.T) {
+	server := newTenantServer(t)
+	registry := newTestRegistry(t, server, []string{"a", "b", "c", "d", "e", "f"}, WithFanOutParallelism(2))
+
+	var running, peak atomic.Int32
+	err := registry.ForEachTenant(context.Background(), func(ctx context.Context, tenant string, c *Client) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+func TestMultiTenant_GlobalConcurrencyLimit(t *testing.T) {
+	server := newTenantServer(t)
+	registry := newTestRegistry(t, server, []string{"a", "b", "c", "d"},
+		WithGlobalConcurrencyLimit(2),
+		WithFanOutParallelism(4),
+	)
+
+	err := registry.ForEachTenant(context.Background(), func(ctx context.Context, tenant string, c *Client) error {
+		var wg sync.WaitGroup
+		for range 3 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, err := c.Plan.ListPlanNames(ctx)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.LessOrEqual(t, server.peak.Load(), int32(2), "limit applies across all tenants")
+}
+
+func TestMultiTenant_ForEachTenant_CancelledContext(t *testing.T) {
+	server := newTenantServer(t)
+	registry := newTestRegistry(t, server, []string{"a", "b"})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := registry.ForEachTenant(ctx, func(ctx context.Context, tenant string, c *Client) error {
+		return ctx.Err()
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -22,20 +22,23 @@ import (
 // Client is the main entry point for the Jamf Protect API SDK.
 // It aggregates all service clients and provides a unified interface.
 // Users should interact with the API exclusively through the provided service methods.
+//
+// A Client assembled from service implementations, such as jamfprotecttest.NewFakeClient,
+// has no transport; its token, probe, cache and Close methods are then no-ops.
 type Client struct {
 	// transport is the internal HTTP transport layer (not exposed to users)
 	transport *client.Transport
 
-	// Services
-	ActionConfig          *actionconfigs.Service
-	Analytic              *analytics.Service
-	AnalyticSet           *analyticsets.Service
-	ExceptionSet          *exceptionsets.Service
-	PreventList           *preventlists.Service
-	Plan                  *plans.Service
-	TelemetryV2           *telemetryv2.Service
-	USBControlSet         *usbcontrolsets.Service
-	UnifiedLoggingFilter  *unifiedloggingfilters.Service
+	// Services. NewClient assigns the API-backed *Service of each package.
+	ActionConfig         actionconfigs.ActionConfigService
+	Analytic             analytics.AnalyticService
+	AnalyticSet          analyticsets.AnalyticSetService
+	ExceptionSet         exceptionsets.ExceptionSetService
+	PreventList          preventlists.PreventListService
+	Plan                 plans.PlanService
+	TelemetryV2          telemetryv2.TelemetryV2Service
+	USBControlSet        usbcontrolsets.USBControlSetService
+	UnifiedLoggingFilter unifiedloggingfilters.UnifiedLoggingFilterService
 }
 
 // NewClient creates a new Jamf Protect API client
@@ -58,7 +61,34 @@ func NewClient(clientID, clientSecret string, options ...client.ClientOption) (*
 		return nil, fmt.Errorf("failed to create HTTP transport: %w", err)
 	}
 
-	// Initialize service clients
+	return newClient(transport), nil
+}
+
+// NewClientWithCredentials creates a new Jamf Protect API client whose client credentials
+// come from a provider, such as client.FileCredentials or client.NewHelperCredentials.
+// The provider is asked again before every token request, so rotated secrets are used
+// without rebuilding the client.
+//
+// Parameters:
+//   - provider: The source of the OAuth2 client ID and secret
+//   - options: Optional client configuration options
+//
+// Example:
+//
+//	client, err := jamfprotect.NewClientWithCredentials(
+//	    client.FileCredentials("/run/secrets/jamfprotect.json", ""),
+//	)
+func NewClientWithCredentials(provider client.CredentialProvider, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransportWithCredentials(provider, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP transport: %w", err)
+	}
+
+	return newClient(transport), nil
+}
+
+// newClient initializes the service clients on transport
+func newClient(transport *client.Transport) *Client {
 	c := &Client{
 		transport:            transport,
 		ActionConfig:         actionconfigs.NewService(transport),
@@ -72,7 +102,7 @@ func NewClient(clientID, clientSecret string, options ...client.ClientOption) (*
 		UnifiedLoggingFilter: unifiedloggingfilters.NewService(transport),
 	}
 
-	return c, nil
+	return c
 }
 
 // NewClientFromEnv creates a new client using environment variables
@@ -112,6 +142,9 @@ func NewClientFromEnv(options ...client.ClientOption) (*Client, error) {
 // Returns:
 //   - *zap.Logger: The configured logger instance
 func (c *Client) GetLogger() *zap.Logger {
+	if c.transport == nil {
+		return zap.NewNop()
+	}
 	return c.transport.GetLogger()
 }
 
@@ -129,6 +162,9 @@ func (c *Client) GetTransport() *client.Transport {
 // Returns:
 //   - *client.TokenManager: The token manager instance
 func (c *Client) GetTokenManager() *client.TokenManager {
+	if c.transport == nil {
+		return nil
+	}
 	return c.transport.GetTokenManager()
 }
 
@@ -141,10 +177,70 @@ func (c *Client) GetTokenManager() *client.TokenManager {
 // Returns:
 //   - error: Any error encountered during token refresh
 func (c *Client) RefreshToken(ctx context.Context) error {
+	if c.transport == nil {
+		return nil
+	}
 	return c.transport.RefreshToken(ctx)
 }
 
+// Close releases background resources held by the client, such as the background
+// token refresher enabled by client.WithBackgroundTokenRefresh. It is safe to call
+// Close on a client without background work.
+//
+// Returns:
+//   - error: Any error encountered while shutting down
+func (c *Client) Close() error {
+	if c.transport == nil {
+		return nil
+	}
+	return c.transport.Close()
+}
+
 // InvalidateToken invalidates the current cached token, forcing a refresh on next use.
 func (c *Client) InvalidateToken() {
+	if c.transport == nil {
+		return
+	}
 	c.transport.InvalidateToken()
 }
+
+// Probe verifies that the tenant is reachable and accepts the client credentials.
+// Use it after construction (or enable client.WithStartupProbe) to fail fast with a
+// *client.TenantNotFoundError, *client.InvalidCredentialsError or *client.NetworkError.
+//
+// Parameters:
+//   - ctx: Request context
+//
+// Returns:
+//   - error: A typed probe error, or nil if the tenant is usable
+func (c *Client) Probe(ctx context.Context) error {
+	if c.transport == nil {
+		return nil
+	}
+	return c.transport.Probe(ctx)
+}
+
+// ChangeSet returns the mutations planned in dry-run mode (see client.WithDryRun and
+// client.WithDryRunOverride). Mutations made with a context carrying client.WithChangeSet
+// are recorded there instead.
+//
+// Returns:
+//   - *client.ChangeSet: The client's change set, or nil for a client without a transport
+func (c *Client) ChangeSet() *client.ChangeSet {
+	if c.transport == nil {
+		return nil
+	}
+	return c.transport.ChangeSet()
+}
+
+// QueryCacheStats returns hit and miss statistics for the query cache enabled with
+// client.WithQueryCache.
+//
+// Returns:
+//   - client.CacheStats: Cache hits, misses and mutation invalidations
+func (c *Client) QueryCacheStats() client.CacheStats {
+	if c.transport == nil {
+		return client.CacheStats{}
+	}
+	return c.transport.QueryCacheStats()
+}
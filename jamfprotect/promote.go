@@ -0,0 +1,452 @@
+package jamfprotect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+	actionconfigs "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	analytics "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic"
+	analyticsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic_set"
+	preventlists "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/custom_prevent_list"
+	exceptionsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/exception_set"
+	usbcontrolsets "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/removable_storage_control_set"
+	telemetryv2 "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	unifiedloggingfilters "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/unified_logging_filter"
+)
+
+// Selector chooses the objects Promote copies. An object is selected when it matches any
+// of the criteria; an empty Selector selects nothing.
+type Selector struct {
+	// Names selects objects of any kind by exact name
+	Names []string
+
+	// Tags selects analytics, prevent lists and unified logging filters carrying any of
+	// these tags
+	Tags []string
+
+	// Kinds selects every object of these kinds
+	Kinds []ResourceKind
+}
+
+// PromoteOptions configures Promote
+type PromoteOptions struct {
+	// DryRun computes and reports the changes without making them
+	DryRun bool
+}
+
+// PromoteOption configures Promote
+type PromoteOption func(*PromoteOptions)
+
+// PromoteDryRun reports what Promote would change without changing the destination
+func PromoteDryRun() PromoteOption {
+	return func(o *PromoteOptions) {
+		o.DryRun = true
+	}
+}
+
+// PromoteChange describes one object Promote copies. In a dry run Action is what would be
+// done; otherwise it is what was done, as reported by ImportBundle.
+type PromoteChange struct {
+	// Resource identifies the object; ID is its ID in the destination, when it has one
+	Resource ResourceRef
+	Action   ImportAction
+
+	// Dependency is set when the object was not selected itself but is referenced by a
+	// selected object
+	Dependency bool
+
+	// Fields lists the top-level bundle fields that differ between source and destination
+	// when Action is ImportUpdated
+	Fields []string
+
+	// Reason explains a skipped object
+	Reason string
+
+	// Err is set when Action is ImportFailed
+	Err error
+}
+
+// PromoteReport lists the objects Promote selected, in the order they are written
+type PromoteReport struct {
+	DryRun  bool
+	Changes []PromoteChange
+}
+
+// String renders the report one change per line, e.g. for review before promoting
+func (r *PromoteReport) String() string {
+	var sb strings.Builder
+	if r.DryRun {
+		sb.WriteString("dry run: no changes made\n")
+	}
+	for _, change := range r.Changes {
+		fmt.Fprintf(&sb, "%-9s %s", change.Action, change.Resource)
+		if len(change.Fields) > 0 {
+			fmt.Fprintf(&sb, " [%s]", strings.Join(change.Fields, ", "))
+		}
+		if change.Dependency {
+			sb.WriteString(" (dependency)")
+		}
+		if change.Reason != "" {
+			fmt.Fprintf(&sb, ": %s", change.Reason)
+		}
+		if change.Err != nil {
+			fmt.Fprintf(&sb, ": %v", change.Err)
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// Promote copies the objects sel selects from the source tenant to the destination,
+// together with the custom objects they reference, such as a plan's action configuration
+// and sets or an analytic set's analytics. Objects are matched by name; references are
+// remapped to the destination's IDs, and destination objects that are not selected are
+// left alone. Jamf-managed objects are never copied and must exist in the destination.
+//
+// The source is exported whole, but the destination is only listed: the objects of the
+// selection that it already has are then read one by one to work out their changes.
+//
+// The report lists every selected object with what was, or in a dry run would be, done
+// with it. Errors for individual objects are collected in the report, and the returned
+// error joins them.
+//
+// Example:
+//
+//	report, err := jamfprotect.Promote(ctx, staging, production,
+//	    jamfprotect.Selector{Names: []string{"Production"}}, jamfprotect.PromoteDryRun())
+//	fmt.Print(report)
+func Promote(ctx context.Context, src, dst *Client, sel Selector, opts ...PromoteOption) (*PromoteReport, error) {
+	var options PromoteOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+	if src == nil || dst == nil {
+		return nil, fmt.Errorf("%w: source and destination clients are required", client.ErrInvalidInput)
+	}
+
+	source, err := src.ExportTenant(ctx, ExportIncludeSecrets())
+	if err != nil {
+		return nil, fmt.Errorf("failed to export source tenant: %w", err)
+	}
+	report := &PromoteReport{DryRun: options.DryRun}
+	selected := source.selectWithDependencies(sel)
+	if len(selected) == 0 {
+		return report, nil
+	}
+
+	dest := &destination{im: &importer{c: dst}}
+	if err := dest.im.index(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list destination tenant: %w", err)
+	}
+	subset := &Bundle{Version: source.Version}
+	for _, s := range bundleSections {
+		for i, name := range s.names(source) {
+			key := documentKey{s.kind, name}
+			dependency, ok := selected[key]
+			if !ok {
+				continue
+			}
+			doc := s.get(source, i)
+			s.add(subset, doc)
+			current, found, err := dest.document(ctx, key)
+			if err != nil {
+				report.Changes = append(report.Changes, PromoteChange{
+					Resource:   ResourceRef{Kind: key.kind, Name: key.name},
+					Action:     ImportFailed,
+					Dependency: dependency,
+					Err:        err,
+				})
+				continue
+			}
+			report.Changes = append(report.Changes, planChange(key, doc, current, found, dependency))
+		}
+	}
+	if options.DryRun {
+		return report, nil
+	}
+
+	imported, err := dst.ImportBundle(ctx, subset, WithImportMode(ImportUpsert))
+	if imported == nil {
+		return report, err
+	}
+	results := make(map[documentKey]ImportResult, len(imported.Results))
+	for _, result := range imported.Results {
+		results[documentKey{result.Resource.Kind, result.Resource.Name}] = result
+	}
+	for i := range report.Changes {
+		change := &report.Changes[i]
+		result := results[documentKey{change.Resource.Kind, change.Resource.Name}]
+		change.Resource.ID = result.Resource.ID
+		change.Action = result.Action
+		change.Reason = result.Reason
+		change.Err = result.Err
+		if change.Action != ImportUpdated {
+			change.Fields = nil
+		}
+	}
+	return report, err
+}
+
+// destination looks up the destination tenant's objects by name, reading only the ones
+// Promote needs to compare
+type destination struct {
+	im *importer
+
+	// analyticNames maps analytic UUIDs to names, built on first use
+	analyticNames map[string]string
+}
+
+// document returns the destination's document for key, converted as ExportTenant would
+// convert it, and whether the destination has the object. Telemetry v1 configurations
+// are only looked up, so their document is nil.
+func (d *destination) document(ctx context.Context, key documentKey) (any, bool, error) {
+	existing, found, err := d.im.lookup(key.kind, key.name)
+	if err != nil || !found {
+		return nil, false, err
+	}
+	if existing.managed {
+		return nil, false, fmt.Errorf("%w: the name belongs to a Jamf-managed object", client.ErrConflict)
+	}
+
+	c, id := d.im.c, existing.id
+	var doc any
+	switch key.kind {
+	case ResourceTelemetry:
+		return nil, true, nil
+	case ResourceAnalytic:
+		doc, err = getDocument(ctx, c.Analytic.GetAnalytic, id, func(a *analytics.Analytic) (AnalyticDocument, error) {
+			return analyticDocument(a), nil
+		})
+	case ResourceAnalyticSet:
+		doc, err = getDocument(ctx, c.AnalyticSet.GetAnalyticSet, id, func(s *analyticsets.AnalyticSet) (AnalyticSetDocument, error) {
+			return analyticSetDocument(s, d.analytics())
+		})
+	case ResourceExceptionSet:
+		doc, err = getDocument(ctx, c.ExceptionSet.GetExceptionSet, id, func(s *exceptionsets.ExceptionSet) (ExceptionSetDocument, error) {
+			return exceptionSetDocument(s, d.analytics())
+		})
+	case ResourceActionConfig:
+		doc, err = getDocument(ctx, c.ActionConfig.GetActionConfig, id, func(config *actionconfigs.ActionConfig) (ActionConfigDocument, error) {
+			doc := actionConfigDocument(config, true)
+			doc.normalize()
+			return doc, nil
+		})
+	case ResourceTelemetryV2:
+		doc, err = getDocument(ctx, c.TelemetryV2.GetTelemetryV2, id, func(t *telemetryv2.TelemetryV2) (TelemetryV2Document, error) {
+			return telemetryV2Document(t), nil
+		})
+	case ResourceUSBControlSet:
+		doc, err = getDocument(ctx, c.USBControlSet.GetUSBControlSet, id, func(s *usbcontrolsets.USBControlSet) (USBControlSetDocument, error) {
+			return usbControlSetDocument(s), nil
+		})
+	case ResourcePreventList:
+		doc, err = getDocument(ctx, c.PreventList.GetPreventList, id, func(l *preventlists.PreventList) (PreventListDocument, error) {
+			return preventListDocument(l), nil
+		})
+	case ResourceUnifiedLoggingFilter:
+		doc, err = getDocument(ctx, c.UnifiedLoggingFilter.GetUnifiedLoggingFilter, id, func(f *unifiedloggingfilters.UnifiedLoggingFilter) (UnifiedLoggingFilterDocument, error) {
+			return unifiedLoggingFilterDocument(f), nil
+		})
+	case ResourcePlan:
+		doc, err = getDocument(ctx, c.Plan.GetPlan, id, planDocument)
+	default:
+		return nil, false, fmt.Errorf("%w: unknown kind %q", client.ErrInvalidInput, key.kind)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read the destination's copy: %w", err)
+	}
+	return doc, doc != nil, nil
+}
+
+// analytics returns the names of the destination's analytics by UUID
+func (d *destination) analytics() map[string]string {
+	if d.analyticNames == nil {
+		d.analyticNames = make(map[string]string)
+		for name, objects := range d.im.existing[ResourceAnalytic] {
+			for _, obj := range objects {
+				d.analyticNames[obj.id] = name
+			}
+		}
+	}
+	return d.analyticNames
+}
+
+// getDocument reads one object and converts it to a document, or returns nil when the
+// object no longer exists
+func getDocument[T, D any](ctx context.Context, get func(context.Context, string) (*T, *interfaces.Response, error), id string, convert func(*T) (D, error)) (any, error) {
+	obj, _, err := get(ctx, id)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+	doc, err := convert(obj)
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// documentKey identifies a document in a bundle
+type documentKey struct {
+	kind ResourceKind
+	name string
+}
+
+// documents indexes the bundle's documents by kind and name
+func (b *Bundle) documents() map[documentKey]any {
+	docs := make(map[documentKey]any)
+	for _, s := range bundleSections {
+		for i, name := range s.names(b) {
+			docs[documentKey{s.kind, name}] = s.get(b, i)
+		}
+	}
+	return docs
+}
+
+// selectWithDependencies returns the documents sel matches, mapped to false, and the
+// documents they reference directly or indirectly, mapped to true
+func (b *Bundle) selectWithDependencies(sel Selector) map[documentKey]bool {
+	docs := b.documents()
+	selected := make(map[documentKey]bool)
+	var queue []documentKey
+	for key, doc := range docs {
+		if sel.matches(key, doc) {
+			selected[key] = false
+			queue = append(queue, key)
+		}
+	}
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		for _, dep := range documentDependencies(docs[key]) {
+			if _, ok := docs[dep]; !ok {
+				continue
+			}
+			if _, ok := selected[dep]; ok {
+				continue
+			}
+			selected[dep] = true
+			queue = append(queue, dep)
+		}
+	}
+	return selected
+}
+
+// matches reports whether the selector selects a document
+func (sel Selector) matches(key documentKey, doc any) bool {
+	if slices.Contains(sel.Names, key.name) || slices.Contains(sel.Kinds, key.kind) {
+		return true
+	}
+	var tags []string
+	switch d := doc.(type) {
+	case AnalyticDocument:
+		tags = d.Tags
+	case PreventListDocument:
+		tags = d.Tags
+	case UnifiedLoggingFilterDocument:
+		tags = d.Tags
+	}
+	for _, tag := range tags {
+		if slices.Contains(sel.Tags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// documentDependencies returns the objects a document references by name
+func documentDependencies(doc any) []documentKey {
+	var deps []documentKey
+	add := func(kind ResourceKind, name string) {
+		if name != "" {
+			deps = append(deps, documentKey{kind, name})
+		}
+	}
+	switch d := doc.(type) {
+	case AnalyticSetDocument:
+		for _, name := range d.Analytics {
+			add(ResourceAnalytic, name)
+		}
+	case ExceptionSetDocument:
+		for _, e := range d.Exceptions {
+			add(ResourceAnalytic, e.Analytic)
+		}
+	case PlanDocument:
+		add(ResourceActionConfig, d.ActionConfig)
+		for _, name := range d.ExceptionSets {
+			add(ResourceExceptionSet, name)
+		}
+		for _, set := range d.AnalyticSets {
+			add(ResourceAnalyticSet, set.Name)
+		}
+		add(ResourceUSBControlSet, d.USBControlSet)
+		add(ResourceTelemetry, d.Telemetry)
+		add(ResourceTelemetryV2, d.TelemetryV2)
+	}
+	return deps
+}
+
+// planChange compares a source document with the destination's document of the same
+// name, if found
+func planChange(key documentKey, doc, current any, found, dependency bool) PromoteChange {
+	change := PromoteChange{
+		Resource:   ResourceRef{Kind: key.kind, Name: key.name},
+		Dependency: dependency,
+	}
+	switch {
+	case key.kind == ResourceTelemetry && found:
+		change.Action = ImportSkipped
+		change.Reason = "telemetry v1 configurations cannot be created"
+	case key.kind == ResourceTelemetry:
+		change.Action = ImportFailed
+		change.Err = fmt.Errorf("%w: not in the destination, and telemetry v1 configurations cannot be created", client.ErrNotFound)
+	case !found:
+		change.Action = ImportCreated
+	default:
+		change.Fields = changedFields(current, doc)
+		change.Action = ImportUnchanged
+		if len(change.Fields) > 0 {
+			change.Action = ImportUpdated
+		}
+	}
+	return change
+}
+
+// changedFields returns the sorted top-level fields whose JSON form differs between two
+// documents of the same kind
+func changedFields(before, after any) []string {
+	a, b := documentFields(before), documentFields(after)
+	var fields []string
+	for field := range a {
+		if !reflect.DeepEqual(a[field], b[field]) {
+			fields = append(fields, field)
+		}
+	}
+	for field := range b {
+		if _, ok := a[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+// documentFields returns a document's fields as decoded from its JSON encoding
+func documentFields(doc any) map[string]any {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
@@ -0,0 +1,142 @@
+package jamfprotect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/jamfprotecttest"
+	telemetryv2 "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	unifiedloggingfilters "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/unified_logging_filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProductionDestination returns an empty tenant with an outdated copy of the source's
+// telemetry and a filter of its own
+func newProductionDestination(t *testing.T) (*jamfprotecttest.Server, *jamfprotect.Client) {
+	t.Helper()
+	server, c, _ := newEmptyTenant(t)
+	ctx := context.Background()
+	_, _, err := c.TelemetryV2.CreateTelemetryV2(ctx, &telemetryv2.CreateTelemetryV2Request{
+		Name:     "Prod Telemetry",
+		LogFiles: []string{"/var/log/install.log"},
+	})
+	require.NoError(t, err)
+	_, _, err = c.UnifiedLoggingFilter.CreateUnifiedLoggingFilter(ctx, &unifiedloggingfilters.CreateUnifiedLoggingFilterRequest{
+		Name:   "Local Filter",
+		Filter: `subsystem == "com.example"`,
+	})
+	require.NoError(t, err)
+	return server, c
+}
+
+func TestPromote_DryRun(t *testing.T) {
+	source := newProductionTenant(t)
+	server, dest := newProductionDestination(t)
+
+	report, err := jamfprotect.Promote(context.Background(), source, dest,
+		jamfprotect.Selector{Names: []string{"Production"}}, jamfprotect.PromoteDryRun())
+	require.NoError(t, err)
+
+	assert.Equal(t, "dry run: no changes made\n"+
+		`created   analytic "Analytic" (dependency)`+"\n"+
+		`created   analytic set "Prod Analytics" (dependency)`+"\n"+
+		`created   exception set "Prod Exceptions" (dependency)`+"\n"+
+		`created   action configuration "Prod Actions" (dependency)`+"\n"+
+		`updated   telemetry v2 "Prod Telemetry" [logFiles] (dependency)`+"\n"+
+		`created   USB control set "Prod USB" (dependency)`+"\n"+
+		`created   plan "Production"`+"\n", report.String())
+	assert.Equal(t, 0, server.OperationCount("createAnalytic"))
+	assert.Equal(t, 0, server.OperationCount("updateTelemetryV2"))
+	assert.Equal(t, 0, server.Len(jamfprotecttest.KindPlan))
+}
+
+func TestPromote_CopiesSelectionWithDependencies(t *testing.T) {
+	source := newProductionTenant(t)
+	server, dest := newProductionDestination(t)
+	ctx := context.Background()
+
+	report, err := jamfprotect.Promote(ctx, source, dest, jamfprotect.Selector{Names: []string{"Production"}})
+	require.NoError(t, err)
+	require.Len(t, report.Changes, 7)
+	for _, change := range report.Changes {
+		assert.NotEmpty(t, change.Resource.ID, change.Resource.String())
+	}
+	telemetry := report.Changes[4]
+	assert.Equal(t, jamfprotect.ImportUpdated, telemetry.Action)
+	assert.Equal(t, []string{"logFiles"}, telemetry.Fields)
+
+	plan, _, err := dest.Plan.GetPlanByName(ctx, "Production")
+	require.NoError(t, err)
+	assert.Equal(t, telemetry.Resource.ID, plan.TelemetryV2.ID)
+	assert.Equal(t, report.Changes[3].Resource.ID, plan.ActionConfigs.ID)
+
+	assert.Equal(t, 0, server.Len(jamfprotecttest.KindPreventList), "unselected objects are not copied")
+	filters, _, err := dest.UnifiedLoggingFilter.ListUnifiedLoggingFilters(ctx)
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	assert.Equal(t, "Local Filter", filters[0].Name, "destination objects are left alone")
+
+	report, err = jamfprotect.Promote(ctx, source, dest, jamfprotect.Selector{Names: []string{"Production"}})
+	require.NoError(t, err)
+	for _, change := range report.Changes {
+		assert.Equal(t, jamfprotect.ImportUnchanged, change.Action, change.Resource.String())
+	}
+}
+
+func TestPromote_SelectsByTagAndKind(t *testing.T) {
+	source := newProductionTenant(t)
+	_, dest := newProductionDestination(t)
+	ctx := context.Background()
+
+	report, err := jamfprotect.Promote(ctx, source, dest, jamfprotect.Selector{
+		Tags:  []string{"prod"},
+		Kinds: []jamfprotect.ResourceKind{jamfprotect.ResourceAnalytic},
+	})
+	require.NoError(t, err)
+	var promoted []string
+	for _, change := range report.Changes {
+		assert.Equal(t, jamfprotect.ImportCreated, change.Action)
+		promoted = append(promoted, change.Resource.String())
+	}
+	assert.Equal(t, []string{`analytic "Analytic"`, `prevent list "Blocked Teams"`, `unified logging filter "SSH Logins"`}, promoted)
+
+	report, err = jamfprotect.Promote(ctx, source, dest, jamfprotect.Selector{})
+	require.NoError(t, err)
+	assert.Empty(t, report.Changes)
+}
+
+func TestPromote_ReadsOnlySelectedDestinationObjects(t *testing.T) {
+	source := newProductionTenant(t)
+	server, dest := newProductionDestination(t)
+	ctx := context.Background()
+
+	// a duplicate name outside the selection does not stop the promotion
+	_, _, err := dest.UnifiedLoggingFilter.CreateUnifiedLoggingFilter(ctx, &unifiedloggingfilters.CreateUnifiedLoggingFilterRequest{
+		Name:   "Local Filter",
+		Filter: `subsystem == "com.example.other"`,
+	})
+	require.NoError(t, err)
+
+	report, err := jamfprotect.Promote(ctx, source, dest,
+		jamfprotect.Selector{Names: []string{"Production"}}, jamfprotect.PromoteDryRun())
+	require.NoError(t, err)
+	assert.Len(t, report.Changes, 7)
+	assert.Equal(t, 1, server.OperationCount("getTelemetryV2"), "only the existing selected object is read")
+	assert.Equal(t, 0, server.OperationCount("getUnifiedLoggingFilter"))
+
+	_, _, err = dest.TelemetryV2.CreateTelemetryV2(ctx, &telemetryv2.CreateTelemetryV2Request{
+		Name:     "Prod Telemetry",
+		LogFiles: []string{"/var/log/system.log"},
+	})
+	require.NoError(t, err)
+
+	report, err = jamfprotect.Promote(ctx, source, dest,
+		jamfprotect.Selector{Names: []string{"Production"}}, jamfprotect.PromoteDryRun())
+	require.NoError(t, err)
+	telemetry := report.Changes[4]
+	assert.Equal(t, jamfprotect.ImportFailed, telemetry.Action)
+	assert.ErrorIs(t, telemetry.Err, client.ErrAmbiguousName, "ambiguous selected names are reported")
+}
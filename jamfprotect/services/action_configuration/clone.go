@@ -0,0 +1,32 @@
+package actionconfiguration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// CloneActionConfig creates a copy of an action configuration named newName. The copy has the same
+// contents as the original, which is left unchanged.
+func (s *Service) CloneActionConfig(ctx context.Context, id, newName string) (*ActionConfig, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if newName == "" {
+		return nil, nil, fmt.Errorf("%w: new name is required", client.ErrInvalidInput)
+	}
+
+	source, resp, err := s.GetActionConfig(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if source == nil {
+		return nil, resp, fmt.Errorf("%w: action configuration %q", client.ErrNotFound, id)
+	}
+
+	req := source.ToCreateRequest()
+	req.Name = newName
+	return s.CreateActionConfig(ctx, req)
+}
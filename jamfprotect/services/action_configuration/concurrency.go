@@ -0,0 +1,57 @@
+package actionconfiguration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpdateActionConfigIfMatch updates an action configuration only if its hash still equals
+// expectedHash, the Hash of the configuration the caller based req on. The configuration is
+// re-read first; when its hash has changed the update is refused with a
+// *client.ConflictError[*ActionConfig] carrying the current configuration. The check is
+// best-effort and client-side, not a server-side precondition: a change made between the
+// re-read and the update is not detected.
+func (s *Service) UpdateActionConfigIfMatch(ctx context.Context, id, expectedHash string, req *UpdateActionConfigRequest) (*ActionConfig, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if expectedHash == "" {
+		return nil, nil, fmt.Errorf("%w: expected hash is required", client.ErrInvalidInput)
+	}
+	if req == nil {
+		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	current, resp, err := s.GetActionConfig(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if current == nil {
+		return nil, resp, fmt.Errorf("%w: action configuration %q", client.ErrNotFound, id)
+	}
+	if err := client.CheckHash("action configuration", id, expectedHash, current.Hash, current); err != nil {
+		return nil, resp, err
+	}
+
+	return s.UpdateActionConfig(ctx, id, req)
+}
+
+// ModifyActionConfig performs a read-modify-write of an action configuration. It reads the
+// configuration, converts it to an update request, lets merge change the request and writes it
+// with UpdateActionConfigIfMatch. When the configuration changes between the read and the
+// write, merge is called again with the newer configuration, up to attempts times
+// (client.DefaultModifyAttempts when attempts < 1). A retry merges into the configuration
+// UpdateActionConfigIfMatch already read instead of reading it again. The conflict check is
+// best-effort, as described for UpdateActionConfigIfMatch.
+func (s *Service) ModifyActionConfig(ctx context.Context, id string, merge func(current *ActionConfig, req *UpdateActionConfigRequest) error, attempts int) (*ActionConfig, *interfaces.Response, error) {
+	return client.Modify(ctx, id, client.Modifier[ActionConfig, *UpdateActionConfigRequest]{
+		Kind:          "action configuration",
+		Get:           s.GetActionConfig,
+		Hash:          func(current *ActionConfig) string { return current.Hash },
+		Request:       (*ActionConfig).ToUpdateRequest,
+		UpdateIfMatch: s.UpdateActionConfigIfMatch,
+	}, merge, attempts)
+}
@@ -0,0 +1,75 @@
+package actionconfiguration
+
+import "encoding/json"
+
+// ToCreateRequest returns the create request that reproduces config. Report
+// clients are sent in the shape the API returns them, without their ID and with only the
+// parameters of their client type.
+func (config *ActionConfig) ToCreateRequest() *CreateActionConfigRequest {
+	req := &CreateActionConfigRequest{
+		Name:        config.Name,
+		Description: config.Description,
+		AlertConfig: toMap(config.AlertConfig),
+		Clients:     make([]map[string]any, 0, len(config.Clients)),
+	}
+	if req.AlertConfig == nil {
+		req.AlertConfig = map[string]any{}
+	}
+	for _, c := range config.Clients {
+		client := map[string]any{
+			"type":             c.Type,
+			"supportedReports": c.SupportedReports,
+		}
+		if c.BatchConfig != nil {
+			client["batchConfig"] = toMap(c.BatchConfig)
+		}
+		if params := withoutZeroValues(toMap(c.Params)); len(params) > 0 {
+			client["params"] = params
+		}
+		req.Clients = append(req.Clients, client)
+	}
+	return req
+}
+
+// ToUpdateRequest returns the update request that makes an existing action configuration match config
+func (config *ActionConfig) ToUpdateRequest() *UpdateActionConfigRequest {
+	req := UpdateActionConfigRequest(*config.ToCreateRequest())
+	return &req
+}
+
+// toMap converts v to a map through its JSON encoding, or returns nil
+func toMap(v any) map[string]any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+	return m
+}
+
+// withoutZeroValues drops the fields of m that hold a zero value, which are the fields of
+// other client types in ReportClientParams
+func withoutZeroValues(m map[string]any) map[string]any {
+	for k, v := range m {
+		switch t := v.(type) {
+		case nil:
+			delete(m, k)
+		case string:
+			if t == "" {
+				delete(m, k)
+			}
+		case float64:
+			if t == 0 {
+				delete(m, k)
+			}
+		case []any:
+			if len(t) == 0 {
+				delete(m, k)
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,58 @@
+package actionconfiguration_test
+
+import (
+	"testing"
+
+	actionconfiguration "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/action_configuration"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionConfig_ToCreateRequest(t *testing.T) {
+	config := &actionconfiguration.ActionConfig{
+		ID:          "ac-1",
+		Name:        "Actions",
+		Description: "desc",
+		Hash:        "abc",
+		AlertConfig: &actionconfiguration.AlertConfig{Data: &actionconfiguration.AlertData{
+			Binary: &actionconfiguration.AlertEventType{Attrs: []string{"sha256hex"}, Related: []string{"process"}},
+		}},
+		Clients: []actionconfiguration.ReportClient{{
+			ID:               "c-1",
+			Type:             "Http",
+			SupportedReports: []string{"AlertReport"},
+			BatchConfig:      &actionconfiguration.BatchConfig{Delimiter: "\n", SizeIndex: 1, WindowInSeconds: 30, SizeInBytes: 1024},
+			Params: &actionconfiguration.ReportClientParams{
+				URL:     "https://siem.example.com",
+				Method:  "POST",
+				Headers: []actionconfiguration.ReportClientHeader{{Header: "X-Api-Key", Value: "secret"}},
+			},
+		}},
+	}
+
+	req := config.ToCreateRequest()
+	assert.Equal(t, "Actions", req.Name)
+	assert.Equal(t, "desc", req.Description)
+
+	data := req.AlertConfig["data"].(map[string]any)
+	binary := data["binary"].(map[string]any)
+	assert.Equal(t, []any{"sha256hex"}, binary["attrs"])
+	assert.Equal(t, []any{"process"}, binary["related"])
+
+	assert.Len(t, req.Clients, 1)
+	c := req.Clients[0]
+	assert.NotContains(t, c, "id")
+	assert.Equal(t, "Http", c["type"])
+	assert.Equal(t, []string{"AlertReport"}, c["supportedReports"])
+	assert.Equal(t, float64(30), c["batchConfig"].(map[string]any)["windowInSeconds"])
+	assert.Equal(t, map[string]any{
+		"url":     "https://siem.example.com",
+		"method":  "POST",
+		"headers": []any{map[string]any{"header": "X-Api-Key", "value": "secret"}},
+	}, c["params"])
+
+	assert.Equal(t, actionconfiguration.UpdateActionConfigRequest(*req), *config.ToUpdateRequest())
+
+	empty := (&actionconfiguration.ActionConfig{Name: "Empty"}).ToCreateRequest()
+	assert.NotNil(t, empty.AlertConfig)
+	assert.Empty(t, empty.Clients)
+}
@@ -20,6 +20,8 @@ func NewService(client interfaces.GraphQLClient) *Service {
 
 // CreateActionConfig creates a new action configuration.
 func (s *Service) CreateActionConfig(ctx context.Context, req *CreateActionConfigRequest) (*ActionConfig, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "action_configuration", "CreateActionConfig")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
 	}
@@ -50,6 +52,12 @@ func (s *Service) CreateActionConfig(ctx context.Context, req *CreateActionConfi
 
 // GetActionConfig retrieves an action configuration by ID.
 func (s *Service) GetActionConfig(ctx context.Context, id string) (*ActionConfig, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "action_configuration", "GetActionConfig")
+	return s.getActionConfig(ctx, id)
+}
+
+// getActionConfig retrieves an action configuration under the operation recorded in ctx
+func (s *Service) getActionConfig(ctx context.Context, id string) (*ActionConfig, *interfaces.Response, error) {
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -72,8 +80,34 @@ func (s *Service) GetActionConfig(ctx context.Context, id string) (*ActionConfig
 	return result.GetActionConfigs, resp, nil
 }
 
+// GetActionConfigByName retrieves the action configuration with the given name. It returns an error wrapping
+// client.ErrNotFound when no action configuration has the name, and client.ErrAmbiguousName when several do.
+func (s *Service) GetActionConfigByName(ctx context.Context, name string) (*ActionConfig, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "action_configuration", "GetActionConfigByName")
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("%w: name is required", client.ErrInvalidInput)
+	}
+
+	items, resp, err := s.listActionConfigs(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	match, err := client.MatchName("action configuration", name, items,
+		func(item ActionConfigListItem) string { return item.Name },
+		func(item ActionConfigListItem) string { return item.ID })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return s.getActionConfig(ctx, match.ID)
+}
+
 // UpdateActionConfig updates an existing action configuration.
 func (s *Service) UpdateActionConfig(ctx context.Context, id string, req *UpdateActionConfigRequest) (*ActionConfig, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "action_configuration", "UpdateActionConfig")
+
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -108,6 +142,8 @@ func (s *Service) UpdateActionConfig(ctx context.Context, id string, req *Update
 
 // DeleteActionConfig deletes an action configuration by ID.
 func (s *Service) DeleteActionConfig(ctx context.Context, id string) (*interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "action_configuration", "DeleteActionConfig")
+
 	if id == "" {
 		return nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -129,6 +165,13 @@ func (s *Service) DeleteActionConfig(ctx context.Context, id string) (*interface
 
 // ListActionConfigs retrieves all action configurations with automatic pagination.
 func (s *Service) ListActionConfigs(ctx context.Context) ([]ActionConfigListItem, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "action_configuration", "ListActionConfigs")
+	return s.listActionConfigs(ctx)
+}
+
+// listActionConfigs retrieves all action configurations with automatic pagination under the operation
+// recorded in ctx
+func (s *Service) listActionConfigs(ctx context.Context) ([]ActionConfigListItem, *interfaces.Response, error) {
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -138,7 +181,7 @@ func (s *Service) ListActionConfigs(ctx context.Context) ([]ActionConfigListItem
 	var nextToken *string
 	var lastResp *interfaces.Response
 
-	for {
+	for page := 1; ; page++ {
 		vars := map[string]any{
 			"direction": "ASC",
 			"field":     "NAME",
@@ -151,7 +194,7 @@ func (s *Service) ListActionConfigs(ctx context.Context) ([]ActionConfigListItem
 			ListActionConfigs *ListActionConfigsResponse `json:"listActionConfigs"`
 		}
 
-		resp, err := s.client.GraphQLPost(ctx, client.EndpointApp, listActionConfigsQuery, vars, &result, headers)
+		resp, err := s.client.GraphQLPost(client.WithPage(ctx, page), client.EndpointApp, listActionConfigsQuery, vars, &result, headers)
 		lastResp = resp
 		if err != nil {
 			return nil, lastResp, fmt.Errorf("failed to list action configs: %w", err)
@@ -205,6 +248,8 @@ func buildActionConfigVariables(req any) map[string]any {
 
 // ListActionConfigNames retrieves only the names of all action configurations
 func (s *Service) ListActionConfigNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "action_configuration", "ListActionConfigNames")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -75,6 +75,35 @@ func TestActionConfigService_GetActionConfig(t *testing.T) {
 	assert.Equal(t, "Test Action Config", result.Name)
 }
 
+func TestActionConfigService_GetActionConfigByName(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewActionConfigMock(baseURL)
+	mockHandler.RegisterListActionConfigsMock()
+	mockHandler.RegisterGetActionConfigMock()
+
+	result, _, err := service.GetActionConfigByName(context.Background(), "Test Action Config")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "test-id-1234", result.ID)
+	assert.Equal(t, "Test Action Config", result.Name)
+
+	_, _, err = service.GetActionConfigByName(context.Background(), "Missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestActionConfigService_GetActionConfigByName_Ambiguous(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	httpmock.RegisterMatcherResponder("POST", baseURL+"/app", httpmock.BodyContainsString("listActionConfigs"),
+		httpmock.NewStringResponder(200, `{"data":{"listActionConfigs":{"items":[{"id":"first","name":"Duplicate"},{"id":"second","name":"Duplicate"}],"pageInfo":{"next":null,"total":2}}}}`).
+			HeaderSet(http.Header{"Content-Type": {"application/json"}}))
+
+	_, _, err := service.GetActionConfigByName(context.Background(), "Duplicate")
+
+	assert.ErrorIs(t, err, client.ErrAmbiguousName)
+	assert.ErrorContains(t, err, "first, second")
+}
+
 func TestActionConfigService_UpdateActionConfig(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewActionConfigMock(baseURL)
@@ -193,3 +222,17 @@ func TestActionConfigService_ValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestActionConfigService_CloneActionConfig_Failures(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mocks.NewActionConfigMock(baseURL).RegisterGetActionConfigNotFoundMock()
+
+	_, _, err := service.CloneActionConfig(context.Background(), "", "Copy")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneActionConfig(context.Background(), "action-config-1", "")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneActionConfig(context.Background(), "action-config-1", "Copy")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
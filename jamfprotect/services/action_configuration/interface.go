@@ -0,0 +1,48 @@
+package actionconfiguration
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// ActionConfigService is the set of operations on Jamf Protect action configurations.
+// *Service implements it against the API; depend on the interface to substitute a fake in tests.
+type ActionConfigService interface {
+	// CreateActionConfig creates a new action configuration.
+	CreateActionConfig(ctx context.Context, req *CreateActionConfigRequest) (*ActionConfig, *interfaces.Response, error)
+
+	// GetActionConfig retrieves an action configuration by ID.
+	GetActionConfig(ctx context.Context, id string) (*ActionConfig, *interfaces.Response, error)
+
+	// GetActionConfigByName retrieves an action configuration by its exact name
+	GetActionConfigByName(ctx context.Context, name string) (*ActionConfig, *interfaces.Response, error)
+
+	// UpdateActionConfig updates an existing action configuration.
+	UpdateActionConfig(ctx context.Context, id string, req *UpdateActionConfigRequest) (*ActionConfig, *interfaces.Response, error)
+
+	// UpdateActionConfigIfMatch updates only if the current hash equals expectedHash, returning a
+	// *client.ConflictError otherwise
+	UpdateActionConfigIfMatch(ctx context.Context, id, expectedHash string, req *UpdateActionConfigRequest) (*ActionConfig, *interfaces.Response, error)
+
+	// ModifyActionConfig re-reads, merges and conditionally updates, retrying on conflict
+	ModifyActionConfig(ctx context.Context, id string, merge func(current *ActionConfig, req *UpdateActionConfigRequest) error, attempts int) (*ActionConfig, *interfaces.Response, error)
+
+	// UpsertActionConfig creates or updates an action configuration to match req and reports which it did
+	UpsertActionConfig(ctx context.Context, req *CreateActionConfigRequest, opts ...client.UpsertOption) (*ActionConfig, client.UpsertAction, *interfaces.Response, error)
+
+	// CloneActionConfig creates a copy of an action configuration under a new name
+	CloneActionConfig(ctx context.Context, id, newName string) (*ActionConfig, *interfaces.Response, error)
+
+	// DeleteActionConfig deletes an action configuration by ID.
+	DeleteActionConfig(ctx context.Context, id string) (*interfaces.Response, error)
+
+	// ListActionConfigs retrieves all action configurations with automatic pagination.
+	ListActionConfigs(ctx context.Context) ([]ActionConfigListItem, *interfaces.Response, error)
+
+	// ListActionConfigNames retrieves only the names of all action configurations
+	ListActionConfigNames(ctx context.Context) ([]string, *interfaces.Response, error)
+}
+
+var _ ActionConfigService = (*Service)(nil)
@@ -64,6 +64,21 @@ func (m *ActionConfigMock) RegisterGetActionConfigMock() {
 	)
 }
 
+// RegisterGetActionConfigNotFoundMock registers a getActionConfigs mock that returns null data, as the API
+// does for an ID that does not exist
+func (m *ActionConfigMock) RegisterGetActionConfigNotFoundMock() {
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		m.baseURL+"/app",
+		httpmock.BodyContainsString("getActionConfigs"),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, m.loadMockData("get_action_config_not_found.json"))
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		},
+	)
+}
+
 // RegisterUpdateActionConfigMock registers a success mock for updateActionConfigs
 func (m *ActionConfigMock) RegisterUpdateActionConfigMock() {
 	httpmock.RegisterMatcherResponder(
@@ -0,0 +1,34 @@
+package actionconfiguration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertActionConfig makes the action configuration named req.Name, or identified by client.UpsertByID, match
+// req. It creates the action configuration when none exists, updates it when it differs from req,
+// and otherwise leaves it alone.
+func (s *Service) UpsertActionConfig(ctx context.Context, req *CreateActionConfigRequest, opts ...client.UpsertOption) (*ActionConfig, client.UpsertAction, *interfaces.Response, error) {
+	if req == nil {
+		return nil, "", nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	return client.Upsert(ctx, req.Name, client.Upserter[ActionConfig]{
+		Kind:      "action configuration",
+		Get:       s.GetActionConfig,
+		GetByName: s.GetActionConfigByName,
+		Create: func(ctx context.Context) (*ActionConfig, *interfaces.Response, error) {
+			return s.CreateActionConfig(ctx, req)
+		},
+		Update: func(ctx context.Context, existing *ActionConfig) (*ActionConfig, *interfaces.Response, error) {
+			update := UpdateActionConfigRequest(*req)
+			return s.UpdateActionConfig(ctx, existing.ID, &update)
+		},
+		Matches: func(existing *ActionConfig) bool {
+			return client.VariablesMatch(buildActionConfigVariables(existing.ToCreateRequest()), buildActionConfigVariables(req))
+		},
+	}, opts...)
+}
@@ -0,0 +1,55 @@
+package analytic
+
+// ToCreateRequest returns the create request that reproduces a
+func (a *Analytic) ToCreateRequest() *CreateAnalyticRequest {
+	req := &CreateAnalyticRequest{
+		Name:          a.Name,
+		InputType:     a.InputType,
+		Description:   a.Description,
+		Actions:       append([]string(nil), a.Actions...),
+		Tags:          append([]string(nil), a.Tags...),
+		Categories:    append([]string(nil), a.Categories...),
+		Filter:        a.Filter,
+		Level:         a.Level,
+		Severity:      a.Severity,
+		SnapshotFiles: append([]string(nil), a.SnapshotFiles...),
+	}
+	for _, action := range a.AnalyticActions {
+		req.AnalyticActions = append(req.AnalyticActions, AnalyticActionInput{
+			Name:       action.Name,
+			Parameters: append([]string(nil), action.Parameters...),
+		})
+	}
+	for _, c := range a.Context {
+		req.Context = append(req.Context, AnalyticContextInput{
+			Name:  c.Name,
+			Type:  c.Type,
+			Exprs: append([]string(nil), c.Exprs...),
+		})
+	}
+	return req
+}
+
+// ToUpdateRequest returns the update request that makes an analytic match a
+func (a *Analytic) ToUpdateRequest() *UpdateAnalyticRequest {
+	return updateAnalyticRequestFrom(a.ToCreateRequest())
+}
+
+// updateAnalyticRequestFrom converts a create request to the equivalent update request
+func updateAnalyticRequestFrom(req *CreateAnalyticRequest) *UpdateAnalyticRequest {
+	severity := req.Severity
+	return &UpdateAnalyticRequest{
+		Name:            req.Name,
+		InputType:       req.InputType,
+		Description:     req.Description,
+		Actions:         req.Actions,
+		AnalyticActions: req.AnalyticActions,
+		Tags:            req.Tags,
+		Categories:      req.Categories,
+		Filter:          req.Filter,
+		Context:         req.Context,
+		Level:           req.Level,
+		Severity:        &severity,
+		SnapshotFiles:   req.SnapshotFiles,
+	}
+}
@@ -0,0 +1,51 @@
+package analytic_test
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalytic_ToCreateRequest(t *testing.T) {
+	a := &analytic.Analytic{
+		UUID:            "a-1",
+		Name:            "Analytic",
+		InputType:       analytic.InputTypeGPFSEvent,
+		Description:     "desc",
+		Filter:          "$event.type == 0",
+		Actions:         []string{"Report"},
+		AnalyticActions: []analytic.AnalyticAction{{Name: "Log", Parameters: []string{"{}"}}},
+		Tags:            []string{"fs"},
+		Categories:      []string{"Execution"},
+		Context:         []analytic.AnalyticContext{{Name: "path", Type: "String", Exprs: []string{"$event.path"}}},
+		Level:           2,
+		Severity:        analytic.SeverityHigh,
+		SnapshotFiles:   []string{"/tmp/x"},
+		Jamf:            true,
+	}
+
+	want := &analytic.CreateAnalyticRequest{
+		Name:            "Analytic",
+		InputType:       analytic.InputTypeGPFSEvent,
+		Description:     "desc",
+		Actions:         []string{"Report"},
+		AnalyticActions: []analytic.AnalyticActionInput{{Name: "Log", Parameters: []string{"{}"}}},
+		Tags:            []string{"fs"},
+		Categories:      []string{"Execution"},
+		Filter:          "$event.type == 0",
+		Context:         []analytic.AnalyticContextInput{{Name: "path", Type: "String", Exprs: []string{"$event.path"}}},
+		Level:           2,
+		Severity:        analytic.SeverityHigh,
+		SnapshotFiles:   []string{"/tmp/x"},
+	}
+	assert.Equal(t, want, a.ToCreateRequest())
+
+	update := a.ToUpdateRequest()
+	require.NotNil(t, update.Severity)
+	assert.Equal(t, analytic.SeverityHigh, *update.Severity)
+	assert.Equal(t, want.Filter, update.Filter)
+	assert.Equal(t, want.Context, update.Context)
+	assert.Equal(t, want.AnalyticActions, update.AnalyticActions)
+}
@@ -20,6 +20,8 @@ func NewService(client interfaces.GraphQLClient) *Service {
 
 // CreateAnalytic creates a new analytic
 func (s *Service) CreateAnalytic(ctx context.Context, req *CreateAnalyticRequest) (*Analytic, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "CreateAnalytic")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
 	}
@@ -56,6 +58,8 @@ func (s *Service) CreateAnalytic(ctx context.Context, req *CreateAnalyticRequest
 
 // GetAnalytic retrieves an analytic by UUID
 func (s *Service) GetAnalytic(ctx context.Context, uuid string) (*Analytic, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "GetAnalytic")
+
 	if err := ValidateAnalyticID(uuid); err != nil {
 		return nil, nil, fmt.Errorf("%w: %v", client.ErrInvalidInput, err)
 	}
@@ -78,8 +82,35 @@ func (s *Service) GetAnalytic(ctx context.Context, uuid string) (*Analytic, *int
 	return result.GetAnalytic, resp, nil
 }
 
+// GetAnalyticByName retrieves the analytic with the given name. It returns an error wrapping
+// client.ErrNotFound when no analytic has the name, and client.ErrAmbiguousName when several do.
+// The analytic is taken from the list, without a second request.
+func (s *Service) GetAnalyticByName(ctx context.Context, name string) (*Analytic, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "GetAnalyticByName")
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("%w: name is required", client.ErrInvalidInput)
+	}
+
+	items, resp, err := s.listAnalytics(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	match, err := client.MatchName("analytic", name, items,
+		func(item Analytic) string { return item.Name },
+		func(item Analytic) string { return item.UUID })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &match, resp, nil
+}
+
 // UpdateAnalytic updates an existing analytic
 func (s *Service) UpdateAnalytic(ctx context.Context, uuid string, req *UpdateAnalyticRequest) (*Analytic, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "UpdateAnalytic")
+
 	if err := ValidateAnalyticID(uuid); err != nil {
 		return nil, nil, fmt.Errorf("%w: %v", client.ErrInvalidInput, err)
 	}
@@ -108,6 +139,8 @@ func (s *Service) UpdateAnalytic(ctx context.Context, uuid string, req *UpdateAn
 
 // DeleteAnalytic deletes an analytic by UUID
 func (s *Service) DeleteAnalytic(ctx context.Context, uuid string) (*interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "DeleteAnalytic")
+
 	if err := ValidateAnalyticID(uuid); err != nil {
 		return nil, fmt.Errorf("%w: %v", client.ErrInvalidInput, err)
 	}
@@ -129,6 +162,13 @@ func (s *Service) DeleteAnalytic(ctx context.Context, uuid string) (*interfaces.
 
 // ListAnalytics retrieves all analytics
 func (s *Service) ListAnalytics(ctx context.Context) ([]Analytic, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "ListAnalytics")
+	return s.listAnalytics(ctx)
+}
+
+// listAnalytics retrieves all analytics with automatic pagination under the operation
+// recorded in ctx
+func (s *Service) listAnalytics(ctx context.Context) ([]Analytic, *interfaces.Response, error) {
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -152,6 +192,8 @@ func (s *Service) ListAnalytics(ctx context.Context) ([]Analytic, *interfaces.Re
 
 // ListAnalyticsLite retrieves a lightweight summary of all analytics
 func (s *Service) ListAnalyticsLite(ctx context.Context) ([]AnalyticLite, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "ListAnalyticsLite")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -175,6 +217,8 @@ func (s *Service) ListAnalyticsLite(ctx context.Context) ([]AnalyticLite, *inter
 
 // ListAnalyticsNames retrieves only the names of all analytics
 func (s *Service) ListAnalyticsNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "ListAnalyticsNames")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -205,6 +249,8 @@ func (s *Service) ListAnalyticsNames(ctx context.Context) ([]string, *interfaces
 
 // ListAnalyticsCategories retrieves all analytics categories with their counts
 func (s *Service) ListAnalyticsCategories(ctx context.Context) ([]AnalyticCategory, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "ListAnalyticsCategories")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -228,6 +274,8 @@ func (s *Service) ListAnalyticsCategories(ctx context.Context) ([]AnalyticCatego
 
 // ListAnalyticsTags retrieves all analytics tags with their counts
 func (s *Service) ListAnalyticsTags(ctx context.Context) ([]AnalyticTag, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "ListAnalyticsTags")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -251,6 +299,8 @@ func (s *Service) ListAnalyticsTags(ctx context.Context) ([]AnalyticTag, *interf
 
 // ListAnalyticsFilterOptions retrieves both tags and categories for populating filter UIs
 func (s *Service) ListAnalyticsFilterOptions(ctx context.Context) (*AnalyticsFilterOptions, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic", "ListAnalyticsFilterOptions")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -78,6 +78,34 @@ func TestAnalyticService_GetAnalytic(t *testing.T) {
 	assert.Equal(t, "Test Analytic", result.Name)
 }
 
+func TestAnalyticService_GetAnalyticByName(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewAnalyticMock(baseURL)
+	mockHandler.RegisterListAnalyticsMock()
+
+	result, _, err := service.GetAnalyticByName(context.Background(), "Test Analytic")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "aaaaaaaa-bbbb-4ccc-8ddd-eeeeeeeeeeee", result.UUID)
+	assert.Equal(t, "Test Analytic", result.Name)
+
+	_, _, err = service.GetAnalyticByName(context.Background(), "Missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestAnalyticService_GetAnalyticByName_Ambiguous(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	httpmock.RegisterMatcherResponder("POST", baseURL+"/graphql", httpmock.BodyContainsString("listAnalytics"),
+		httpmock.NewStringResponder(200, `{"data":{"listAnalytics":{"items":[{"uuid":"first","name":"Duplicate"},{"uuid":"second","name":"Duplicate"}],"pageInfo":{"next":null,"total":2}}}}`).
+			HeaderSet(http.Header{"Content-Type": {"application/json"}}))
+
+	_, _, err := service.GetAnalyticByName(context.Background(), "Duplicate")
+
+	assert.ErrorIs(t, err, client.ErrAmbiguousName)
+	assert.ErrorContains(t, err, "first, second")
+}
+
 func TestAnalyticService_UpdateAnalytic(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewAnalyticMock(baseURL)
@@ -0,0 +1,54 @@
+package analytic
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// AnalyticService is the set of operations on Jamf Protect analytics.
+// *Service implements it against the API; depend on the interface to substitute a fake in tests.
+type AnalyticService interface {
+	// CreateAnalytic creates a new analytic
+	CreateAnalytic(ctx context.Context, req *CreateAnalyticRequest) (*Analytic, *interfaces.Response, error)
+
+	// GetAnalytic retrieves an analytic by UUID
+	GetAnalytic(ctx context.Context, uuid string) (*Analytic, *interfaces.Response, error)
+
+	// GetAnalyticByName retrieves an analytic by its exact name
+	GetAnalyticByName(ctx context.Context, name string) (*Analytic, *interfaces.Response, error)
+
+	// UpdateAnalytic updates an existing analytic
+	UpdateAnalytic(ctx context.Context, uuid string, req *UpdateAnalyticRequest) (*Analytic, *interfaces.Response, error)
+
+	// PatchAnalytic fetches the analytic, applies patch to an update request built from it and
+	// sends the full update
+	PatchAnalytic(ctx context.Context, uuid string, patch func(req *UpdateAnalyticRequest)) (*Analytic, *interfaces.Response, error)
+
+	// UpsertAnalytic creates or updates an analytic to match req and reports which it did
+	UpsertAnalytic(ctx context.Context, req *CreateAnalyticRequest, opts ...client.UpsertOption) (*Analytic, client.UpsertAction, *interfaces.Response, error)
+
+	// DeleteAnalytic deletes an analytic by UUID
+	DeleteAnalytic(ctx context.Context, uuid string) (*interfaces.Response, error)
+
+	// ListAnalytics retrieves all analytics
+	ListAnalytics(ctx context.Context) ([]Analytic, *interfaces.Response, error)
+
+	// ListAnalyticsLite retrieves a lightweight summary of all analytics
+	ListAnalyticsLite(ctx context.Context) ([]AnalyticLite, *interfaces.Response, error)
+
+	// ListAnalyticsNames retrieves only the names of all analytics
+	ListAnalyticsNames(ctx context.Context) ([]string, *interfaces.Response, error)
+
+	// ListAnalyticsCategories retrieves all analytics categories with their counts
+	ListAnalyticsCategories(ctx context.Context) ([]AnalyticCategory, *interfaces.Response, error)
+
+	// ListAnalyticsTags retrieves all analytics tags with their counts
+	ListAnalyticsTags(ctx context.Context) ([]AnalyticTag, *interfaces.Response, error)
+
+	// ListAnalyticsFilterOptions retrieves both tags and categories for populating filter UIs
+	ListAnalyticsFilterOptions(ctx context.Context) (*AnalyticsFilterOptions, *interfaces.Response, error)
+}
+
+var _ AnalyticService = (*Service)(nil)
@@ -0,0 +1,34 @@
+package analytic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// PatchAnalytic changes only the fields patch sets. The update mutation replaces the whole
+// analytic, so PatchAnalytic fetches the current analytic, converts it to an update request,
+// applies patch to the request and sends the full mutation.
+func (s *Service) PatchAnalytic(ctx context.Context, uuid string, patch func(req *UpdateAnalyticRequest)) (*Analytic, *interfaces.Response, error) {
+	if uuid == "" {
+		return nil, nil, fmt.Errorf("%w: uuid is required", client.ErrInvalidInput)
+	}
+	if patch == nil {
+		return nil, nil, fmt.Errorf("%w: patch function is required", client.ErrInvalidInput)
+	}
+
+	current, resp, err := s.GetAnalytic(ctx, uuid)
+	if err != nil {
+		return nil, resp, err
+	}
+	if current == nil {
+		return nil, resp, fmt.Errorf("%w: analytic %q", client.ErrNotFound, uuid)
+	}
+
+	req := current.ToUpdateRequest()
+	patch(req)
+
+	return s.UpdateAnalytic(ctx, uuid, req)
+}
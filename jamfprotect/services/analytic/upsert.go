@@ -0,0 +1,33 @@
+package analytic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertAnalytic makes the analytic named req.Name, or identified by client.UpsertByID, match
+// req. It creates the analytic when none exists, updates it when it differs from req,
+// and otherwise leaves it alone.
+func (s *Service) UpsertAnalytic(ctx context.Context, req *CreateAnalyticRequest, opts ...client.UpsertOption) (*Analytic, client.UpsertAction, *interfaces.Response, error) {
+	if req == nil {
+		return nil, "", nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	return client.Upsert(ctx, req.Name, client.Upserter[Analytic]{
+		Kind:      "analytic",
+		Get:       s.GetAnalytic,
+		GetByName: s.GetAnalyticByName,
+		Create: func(ctx context.Context) (*Analytic, *interfaces.Response, error) {
+			return s.CreateAnalytic(ctx, req)
+		},
+		Update: func(ctx context.Context, existing *Analytic) (*Analytic, *interfaces.Response, error) {
+			return s.UpdateAnalytic(ctx, existing.UUID, updateAnalyticRequestFrom(req))
+		},
+		Matches: func(existing *Analytic) bool {
+			return client.VariablesMatch(analyticMutationVariables(existing.ToCreateRequest(), false), analyticMutationVariables(req, false))
+		},
+	}, opts...)
+}
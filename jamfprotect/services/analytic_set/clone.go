@@ -0,0 +1,32 @@
+package analyticset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// CloneAnalyticSet creates a copy of an analytic set named newName. The copy has the same
+// contents as the original, which is left unchanged.
+func (s *Service) CloneAnalyticSet(ctx context.Context, uuid, newName string) (*AnalyticSet, *interfaces.Response, error) {
+	if uuid == "" {
+		return nil, nil, fmt.Errorf("%w: uuid is required", client.ErrInvalidInput)
+	}
+	if newName == "" {
+		return nil, nil, fmt.Errorf("%w: new name is required", client.ErrInvalidInput)
+	}
+
+	source, resp, err := s.GetAnalyticSet(ctx, uuid)
+	if err != nil {
+		return nil, resp, err
+	}
+	if source == nil {
+		return nil, resp, fmt.Errorf("%w: analytic set %q", client.ErrNotFound, uuid)
+	}
+
+	req := source.ToCreateRequest()
+	req.Name = newName
+	return s.CreateAnalyticSet(ctx, req)
+}
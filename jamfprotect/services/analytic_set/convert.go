@@ -0,0 +1,21 @@
+package analyticset
+
+// ToCreateRequest returns the create request that reproduces set
+func (set *AnalyticSet) ToCreateRequest() *CreateAnalyticSetRequest {
+	req := &CreateAnalyticSetRequest{
+		Name:        set.Name,
+		Description: set.Description,
+		Types:       append([]string(nil), set.Types...),
+		Analytics:   make([]string, 0, len(set.Analytics)),
+	}
+	for _, analytic := range set.Analytics {
+		req.Analytics = append(req.Analytics, analytic.UUID)
+	}
+	return req
+}
+
+// ToUpdateRequest returns the update request that makes an existing analytic set match set
+func (set *AnalyticSet) ToUpdateRequest() *UpdateAnalyticSetRequest {
+	req := UpdateAnalyticSetRequest(*set.ToCreateRequest())
+	return &req
+}
@@ -0,0 +1,28 @@
+package analyticset_test
+
+import (
+	"testing"
+
+	analyticset "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/analytic_set"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyticSet_ToCreateRequest(t *testing.T) {
+	set := &analyticset.AnalyticSet{
+		UUID:        "as-1",
+		Name:        "Set",
+		Description: "desc",
+		Types:       []string{"Report"},
+		Analytics:   []analyticset.AnalyticSetAnalytic{{UUID: "a-1", Name: "One"}, {UUID: "a-2", Name: "Two", Jamf: true}},
+		Plans:       []analyticset.AnalyticSetPlan{{ID: "p-1"}},
+	}
+
+	want := &analyticset.CreateAnalyticSetRequest{
+		Name:        "Set",
+		Description: "desc",
+		Types:       []string{"Report"},
+		Analytics:   []string{"a-1", "a-2"},
+	}
+	assert.Equal(t, want, set.ToCreateRequest())
+	assert.Equal(t, analyticset.UpdateAnalyticSetRequest(*want), *set.ToUpdateRequest())
+}
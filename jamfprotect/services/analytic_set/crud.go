@@ -20,6 +20,8 @@ func NewService(client interfaces.GraphQLClient) *Service {
 
 // CreateAnalyticSet creates a new analytic set
 func (s *Service) CreateAnalyticSet(ctx context.Context, req *CreateAnalyticSetRequest) (*AnalyticSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic_set", "CreateAnalyticSet")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
 	}
@@ -50,6 +52,8 @@ func (s *Service) CreateAnalyticSet(ctx context.Context, req *CreateAnalyticSetR
 
 // GetAnalyticSet retrieves an analytic set by UUID
 func (s *Service) GetAnalyticSet(ctx context.Context, uuid string) (*AnalyticSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic_set", "GetAnalyticSet")
+
 	if err := ValidateAnalyticSetUUID(uuid); err != nil {
 		return nil, nil, fmt.Errorf("%w: %v", client.ErrInvalidInput, err)
 	}
@@ -76,8 +80,35 @@ func (s *Service) GetAnalyticSet(ctx context.Context, uuid string) (*AnalyticSet
 	return result.GetAnalyticSet, resp, nil
 }
 
+// GetAnalyticSetByName retrieves the analytic set with the given name. It returns an error wrapping
+// client.ErrNotFound when no analytic set has the name, and client.ErrAmbiguousName when several do.
+// The analytic set is taken from the list, without a second request.
+func (s *Service) GetAnalyticSetByName(ctx context.Context, name string) (*AnalyticSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic_set", "GetAnalyticSetByName")
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("%w: name is required", client.ErrInvalidInput)
+	}
+
+	items, resp, err := s.listAnalyticSets(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	match, err := client.MatchName("analytic set", name, items,
+		func(item AnalyticSet) string { return item.Name },
+		func(item AnalyticSet) string { return item.UUID })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &match, resp, nil
+}
+
 // UpdateAnalyticSet updates an existing analytic set
 func (s *Service) UpdateAnalyticSet(ctx context.Context, uuid string, req *UpdateAnalyticSetRequest) (*AnalyticSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic_set", "UpdateAnalyticSet")
+
 	if err := ValidateAnalyticSetUUID(uuid); err != nil {
 		return nil, nil, fmt.Errorf("%w: %v", client.ErrInvalidInput, err)
 	}
@@ -111,6 +142,8 @@ func (s *Service) UpdateAnalyticSet(ctx context.Context, uuid string, req *Updat
 
 // DeleteAnalyticSet deletes an analytic set by UUID
 func (s *Service) DeleteAnalyticSet(ctx context.Context, uuid string) (*interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic_set", "DeleteAnalyticSet")
+
 	if err := ValidateAnalyticSetUUID(uuid); err != nil {
 		return nil, fmt.Errorf("%w: %v", client.ErrInvalidInput, err)
 	}
@@ -132,6 +165,13 @@ func (s *Service) DeleteAnalyticSet(ctx context.Context, uuid string) (*interfac
 
 // ListAnalyticSets retrieves all analytic sets with automatic pagination
 func (s *Service) ListAnalyticSets(ctx context.Context) ([]AnalyticSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "analytic_set", "ListAnalyticSets")
+	return s.listAnalyticSets(ctx)
+}
+
+// listAnalyticSets retrieves all analytic sets with automatic pagination under the operation
+// recorded in ctx
+func (s *Service) listAnalyticSets(ctx context.Context) ([]AnalyticSet, *interfaces.Response, error) {
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -141,7 +181,7 @@ func (s *Service) ListAnalyticSets(ctx context.Context) ([]AnalyticSet, *interfa
 	var nextToken *string
 	var lastResp *interfaces.Response
 
-	for {
+	for page := 1; ; page++ {
 		vars := map[string]any{
 			"RBAC_Plan":        true,
 			"excludeAnalytics": false,
@@ -154,7 +194,7 @@ func (s *Service) ListAnalyticSets(ctx context.Context) ([]AnalyticSet, *interfa
 			ListAnalyticSets *ListAnalyticSetsResponse `json:"listAnalyticSets"`
 		}
 
-		resp, err := s.client.GraphQLPost(ctx, client.EndpointApp, listAnalyticSetsQuery, vars, &result, headers)
+		resp, err := s.client.GraphQLPost(client.WithPage(ctx, page), client.EndpointApp, listAnalyticSetsQuery, vars, &result, headers)
 		lastResp = resp
 		if err != nil {
 			return nil, lastResp, fmt.Errorf("failed to list analytic sets: %w", err)
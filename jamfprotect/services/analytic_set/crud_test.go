@@ -75,6 +75,34 @@ func TestAnalyticSetService_GetAnalyticSet(t *testing.T) {
 	assert.Equal(t, "Test Analytic Set", result.Name)
 }
 
+func TestAnalyticSetService_GetAnalyticSetByName(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewAnalyticSetMock(baseURL)
+	mockHandler.RegisterListAnalyticSetsMock()
+
+	result, _, err := service.GetAnalyticSetByName(context.Background(), "Test Analytic Set")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "aaaaaaaa-bbbb-4ccc-8ddd-eeeeeeeeeeee", result.UUID)
+	assert.Equal(t, "Test Analytic Set", result.Name)
+
+	_, _, err = service.GetAnalyticSetByName(context.Background(), "Missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestAnalyticSetService_GetAnalyticSetByName_Ambiguous(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	httpmock.RegisterMatcherResponder("POST", baseURL+"/app", httpmock.BodyContainsString("listAnalyticSets"),
+		httpmock.NewStringResponder(200, `{"data":{"listAnalyticSets":{"items":[{"uuid":"first","name":"Duplicate"},{"uuid":"second","name":"Duplicate"}],"pageInfo":{"next":null,"total":2}}}}`).
+			HeaderSet(http.Header{"Content-Type": {"application/json"}}))
+
+	_, _, err := service.GetAnalyticSetByName(context.Background(), "Duplicate")
+
+	assert.ErrorIs(t, err, client.ErrAmbiguousName)
+	assert.ErrorContains(t, err, "first, second")
+}
+
 func TestAnalyticSetService_UpdateAnalyticSet(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewAnalyticSetMock(baseURL)
@@ -168,3 +196,17 @@ func TestAnalyticSetService_ValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalyticSetService_CloneAnalyticSet_Failures(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mocks.NewAnalyticSetMock(baseURL).RegisterGetAnalyticSetNotFoundMock()
+
+	_, _, err := service.CloneAnalyticSet(context.Background(), "", "Copy")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneAnalyticSet(context.Background(), testUUID, "")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneAnalyticSet(context.Background(), testUUID, "Copy")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
@@ -0,0 +1,38 @@
+package analyticset
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// AnalyticSetService is the set of operations on Jamf Protect analytic sets.
+// *Service implements it against the API; depend on the interface to substitute a fake in tests.
+type AnalyticSetService interface {
+	// CreateAnalyticSet creates a new analytic set
+	CreateAnalyticSet(ctx context.Context, req *CreateAnalyticSetRequest) (*AnalyticSet, *interfaces.Response, error)
+
+	// GetAnalyticSet retrieves an analytic set by UUID
+	GetAnalyticSet(ctx context.Context, uuid string) (*AnalyticSet, *interfaces.Response, error)
+
+	// GetAnalyticSetByName retrieves an analytic set by its exact name
+	GetAnalyticSetByName(ctx context.Context, name string) (*AnalyticSet, *interfaces.Response, error)
+
+	// UpdateAnalyticSet updates an existing analytic set
+	UpdateAnalyticSet(ctx context.Context, uuid string, req *UpdateAnalyticSetRequest) (*AnalyticSet, *interfaces.Response, error)
+
+	// UpsertAnalyticSet creates or updates an analytic set to match req and reports which it did
+	UpsertAnalyticSet(ctx context.Context, req *CreateAnalyticSetRequest, opts ...client.UpsertOption) (*AnalyticSet, client.UpsertAction, *interfaces.Response, error)
+
+	// CloneAnalyticSet creates a copy of an analytic set under a new name
+	CloneAnalyticSet(ctx context.Context, uuid, newName string) (*AnalyticSet, *interfaces.Response, error)
+
+	// DeleteAnalyticSet deletes an analytic set by UUID
+	DeleteAnalyticSet(ctx context.Context, uuid string) (*interfaces.Response, error)
+
+	// ListAnalyticSets retrieves all analytic sets with automatic pagination
+	ListAnalyticSets(ctx context.Context) ([]AnalyticSet, *interfaces.Response, error)
+}
+
+var _ AnalyticSetService = (*Service)(nil)
@@ -63,6 +63,21 @@ func (m *AnalyticSetMock) RegisterGetAnalyticSetMock() {
 	)
 }
 
+// RegisterGetAnalyticSetNotFoundMock registers a getAnalyticSet mock that returns null data, as the API
+// does for an ID that does not exist
+func (m *AnalyticSetMock) RegisterGetAnalyticSetNotFoundMock() {
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		m.baseURL+"/app",
+		httpmock.BodyContainsString("getAnalyticSet"),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, m.loadMockData("get_analytic_set_not_found.json"))
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		},
+	)
+}
+
 // RegisterUpdateAnalyticSetMock registers a success mock for updateAnalyticSet
 func (m *AnalyticSetMock) RegisterUpdateAnalyticSetMock() {
 	httpmock.RegisterMatcherResponder(
@@ -0,0 +1,34 @@
+package analyticset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertAnalyticSet makes the analytic set named req.Name, or identified by client.UpsertByID, match
+// req. It creates the analytic set when none exists, updates it when it differs from req,
+// and otherwise leaves it alone.
+func (s *Service) UpsertAnalyticSet(ctx context.Context, req *CreateAnalyticSetRequest, opts ...client.UpsertOption) (*AnalyticSet, client.UpsertAction, *interfaces.Response, error) {
+	if req == nil {
+		return nil, "", nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	return client.Upsert(ctx, req.Name, client.Upserter[AnalyticSet]{
+		Kind:      "analytic set",
+		Get:       s.GetAnalyticSet,
+		GetByName: s.GetAnalyticSetByName,
+		Create: func(ctx context.Context) (*AnalyticSet, *interfaces.Response, error) {
+			return s.CreateAnalyticSet(ctx, req)
+		},
+		Update: func(ctx context.Context, existing *AnalyticSet) (*AnalyticSet, *interfaces.Response, error) {
+			update := UpdateAnalyticSetRequest(*req)
+			return s.UpdateAnalyticSet(ctx, existing.UUID, &update)
+		},
+		Matches: func(existing *AnalyticSet) bool {
+			return client.VariablesMatch(analyticSetMutationVariables(existing.ToCreateRequest(), ""), analyticSetMutationVariables(req, ""))
+		},
+	}, opts...)
+}
@@ -0,0 +1,32 @@
+package custompreventlist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// ClonePreventList creates a copy of a prevent list named newName. The copy has the same
+// contents as the original, which is left unchanged.
+func (s *Service) ClonePreventList(ctx context.Context, id, newName string) (*PreventList, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if newName == "" {
+		return nil, nil, fmt.Errorf("%w: new name is required", client.ErrInvalidInput)
+	}
+
+	source, resp, err := s.GetPreventList(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if source == nil {
+		return nil, resp, fmt.Errorf("%w: prevent list %q", client.ErrNotFound, id)
+	}
+
+	req := source.ToCreateRequest()
+	req.Name = newName
+	return s.CreatePreventList(ctx, req)
+}
@@ -0,0 +1,18 @@
+package custompreventlist
+
+// ToCreateRequest returns the create request that reproduces list
+func (list *PreventList) ToCreateRequest() *CreatePreventListRequest {
+	return &CreatePreventListRequest{
+		Name:        list.Name,
+		Description: list.Description,
+		Type:        list.Type,
+		Tags:        append([]string(nil), list.Tags...),
+		List:        append([]string(nil), list.List...),
+	}
+}
+
+// ToUpdateRequest returns the update request that makes an existing prevent list match list
+func (list *PreventList) ToUpdateRequest() *UpdatePreventListRequest {
+	req := UpdatePreventListRequest(*list.ToCreateRequest())
+	return &req
+}
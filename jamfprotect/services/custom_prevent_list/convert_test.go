@@ -0,0 +1,30 @@
+package custompreventlist_test
+
+import (
+	"testing"
+
+	custompreventlist "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/custom_prevent_list"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreventList_ToCreateRequest(t *testing.T) {
+	list := &custompreventlist.PreventList{
+		ID:          "pl-1",
+		Name:        "Block",
+		Description: "desc",
+		Type:        custompreventlist.PreventTypeTEAMID,
+		Tags:        []string{"t"},
+		List:        []string{"ABCDE12345"},
+		Count:       1,
+	}
+
+	want := &custompreventlist.CreatePreventListRequest{
+		Name:        "Block",
+		Description: "desc",
+		Type:        custompreventlist.PreventTypeTEAMID,
+		Tags:        []string{"t"},
+		List:        []string{"ABCDE12345"},
+	}
+	assert.Equal(t, want, list.ToCreateRequest())
+	assert.Equal(t, custompreventlist.UpdatePreventListRequest(*want), *list.ToUpdateRequest())
+}
@@ -20,6 +20,8 @@ func NewService(client interfaces.GraphQLClient) *Service {
 
 // CreatePreventList creates a new prevent list
 func (s *Service) CreatePreventList(ctx context.Context, req *CreatePreventListRequest) (*PreventList, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "custom_prevent_list", "CreatePreventList")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
 	}
@@ -53,6 +55,8 @@ func (s *Service) CreatePreventList(ctx context.Context, req *CreatePreventListR
 
 // GetPreventList retrieves a prevent list by ID
 func (s *Service) GetPreventList(ctx context.Context, id string) (*PreventList, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "custom_prevent_list", "GetPreventList")
+
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -75,8 +79,35 @@ func (s *Service) GetPreventList(ctx context.Context, id string) (*PreventList,
 	return result.GetPreventList, resp, nil
 }
 
+// GetPreventListByName retrieves the prevent list with the given name. It returns an error wrapping
+// client.ErrNotFound when no prevent list has the name, and client.ErrAmbiguousName when several do.
+// The prevent list is taken from the list, without a second request.
+func (s *Service) GetPreventListByName(ctx context.Context, name string) (*PreventList, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "custom_prevent_list", "GetPreventListByName")
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("%w: name is required", client.ErrInvalidInput)
+	}
+
+	items, resp, err := s.listPreventLists(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	match, err := client.MatchName("prevent list", name, items,
+		func(item PreventList) string { return item.Name },
+		func(item PreventList) string { return item.ID })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &match, resp, nil
+}
+
 // UpdatePreventList updates an existing prevent list
 func (s *Service) UpdatePreventList(ctx context.Context, id string, req *UpdatePreventListRequest) (*PreventList, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "custom_prevent_list", "UpdatePreventList")
+
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -114,6 +145,8 @@ func (s *Service) UpdatePreventList(ctx context.Context, id string, req *UpdateP
 
 // DeletePreventList deletes a prevent list by ID
 func (s *Service) DeletePreventList(ctx context.Context, id string) (*interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "custom_prevent_list", "DeletePreventList")
+
 	if id == "" {
 		return nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -135,6 +168,13 @@ func (s *Service) DeletePreventList(ctx context.Context, id string) (*interfaces
 
 // ListPreventLists retrieves all prevent lists with automatic pagination
 func (s *Service) ListPreventLists(ctx context.Context) ([]PreventList, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "custom_prevent_list", "ListPreventLists")
+	return s.listPreventLists(ctx)
+}
+
+// listPreventLists retrieves all prevent lists with automatic pagination under the operation
+// recorded in ctx
+func (s *Service) listPreventLists(ctx context.Context) ([]PreventList, *interfaces.Response, error) {
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -144,7 +184,7 @@ func (s *Service) ListPreventLists(ctx context.Context) ([]PreventList, *interfa
 	var nextToken *string
 	var lastResp *interfaces.Response
 
-	for {
+	for page := 1; ; page++ {
 		vars := map[string]any{
 			"direction": "ASC",
 			"field":     "NAME",
@@ -157,7 +197,7 @@ func (s *Service) ListPreventLists(ctx context.Context) ([]PreventList, *interfa
 			ListPreventLists *ListPreventListsResponse `json:"listPreventLists"`
 		}
 
-		resp, err := s.client.GraphQLPost(ctx, client.EndpointGraphQL, listPreventListsQuery, vars, &result, headers)
+		resp, err := s.client.GraphQLPost(client.WithPage(ctx, page), client.EndpointGraphQL, listPreventListsQuery, vars, &result, headers)
 		lastResp = resp
 		if err != nil {
 			return nil, lastResp, fmt.Errorf("failed to list prevent lists: %w", err)
@@ -215,6 +255,8 @@ func preventListMutationVariables(req any) map[string]any {
 
 // ListPreventListNames retrieves only the names of all custom prevent lists
 func (s *Service) ListPreventListNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "custom_prevent_list", "ListPreventListNames")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -73,6 +73,49 @@ func TestPreventListService_GetPreventList(t *testing.T) {
 	assert.Equal(t, "Test Prevent List", result.Name)
 }
 
+func TestPreventListService_ClonePreventList(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewPreventListMock(baseURL)
+	mockHandler.RegisterGetPreventListCloneSourceMock()
+	mockHandler.RegisterCreatePreventListMock()
+
+	result, _, err := service.ClonePreventList(context.Background(), "test-id-1234", "Copy")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	_, _, err = service.ClonePreventList(context.Background(), "test-id-1234", "")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+}
+
+func TestPreventListService_GetPreventListByName(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewPreventListMock(baseURL)
+	mockHandler.RegisterListPreventListsMock()
+
+	result, _, err := service.GetPreventListByName(context.Background(), "Test Prevent List")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "test-id-1234", result.ID)
+	assert.Equal(t, "Test Prevent List", result.Name)
+
+	_, _, err = service.GetPreventListByName(context.Background(), "Missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestPreventListService_GetPreventListByName_Ambiguous(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	httpmock.RegisterMatcherResponder("POST", baseURL+"/graphql", httpmock.BodyContainsString("listPreventLists"),
+		httpmock.NewStringResponder(200, `{"data":{"listPreventLists":{"items":[{"id":"first","name":"Duplicate"},{"id":"second","name":"Duplicate"}],"pageInfo":{"next":null,"total":2}}}}`).
+			HeaderSet(http.Header{"Content-Type": {"application/json"}}))
+
+	_, _, err := service.GetPreventListByName(context.Background(), "Duplicate")
+
+	assert.ErrorIs(t, err, client.ErrAmbiguousName)
+	assert.ErrorContains(t, err, "first, second")
+}
+
 func TestPreventListService_UpdatePreventList(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewPreventListMock(baseURL)
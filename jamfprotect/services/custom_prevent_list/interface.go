@@ -0,0 +1,41 @@
+package custompreventlist
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// PreventListService is the set of operations on Jamf Protect custom prevent lists.
+// *Service implements it against the API; depend on the interface to substitute a fake in tests.
+type PreventListService interface {
+	// CreatePreventList creates a new prevent list
+	CreatePreventList(ctx context.Context, req *CreatePreventListRequest) (*PreventList, *interfaces.Response, error)
+
+	// GetPreventList retrieves a prevent list by ID
+	GetPreventList(ctx context.Context, id string) (*PreventList, *interfaces.Response, error)
+
+	// GetPreventListByName retrieves a prevent list by its exact name
+	GetPreventListByName(ctx context.Context, name string) (*PreventList, *interfaces.Response, error)
+
+	// UpdatePreventList updates an existing prevent list
+	UpdatePreventList(ctx context.Context, id string, req *UpdatePreventListRequest) (*PreventList, *interfaces.Response, error)
+
+	// UpsertPreventList creates or updates a prevent list to match req and reports which it did
+	UpsertPreventList(ctx context.Context, req *CreatePreventListRequest, opts ...client.UpsertOption) (*PreventList, client.UpsertAction, *interfaces.Response, error)
+
+	// ClonePreventList creates a copy of a prevent list under a new name
+	ClonePreventList(ctx context.Context, id, newName string) (*PreventList, *interfaces.Response, error)
+
+	// DeletePreventList deletes a prevent list by ID
+	DeletePreventList(ctx context.Context, id string) (*interfaces.Response, error)
+
+	// ListPreventLists retrieves all prevent lists with automatic pagination
+	ListPreventLists(ctx context.Context) ([]PreventList, *interfaces.Response, error)
+
+	// ListPreventListNames retrieves only the names of all custom prevent lists
+	ListPreventListNames(ctx context.Context) ([]string, *interfaces.Response, error)
+}
+
+var _ PreventListService = (*Service)(nil)
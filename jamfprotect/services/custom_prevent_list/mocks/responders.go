@@ -65,6 +65,21 @@ func (m *PreventListMock) RegisterGetPreventListMock() {
 	)
 }
 
+// RegisterGetPreventListCloneSourceMock registers a getPreventList mock returning a fully
+// populated prevent list, as cloning requires
+func (m *PreventListMock) RegisterGetPreventListCloneSourceMock() {
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		m.baseURL+"/graphql",
+		httpmock.BodyContainsString("getPreventList"),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, m.loadMockData("get_prevent_list_clone_source.json"))
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		},
+	)
+}
+
 // RegisterUpdatePreventListMock registers a success mock for updatePreventList
 func (m *PreventListMock) RegisterUpdatePreventListMock() {
 	httpmock.RegisterMatcherResponder(
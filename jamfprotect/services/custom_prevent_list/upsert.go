@@ -0,0 +1,34 @@
+package custompreventlist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertPreventList makes the prevent list named req.Name, or identified by client.UpsertByID, match
+// req. It creates the prevent list when none exists, updates it when it differs from req,
+// and otherwise leaves it alone.
+func (s *Service) UpsertPreventList(ctx context.Context, req *CreatePreventListRequest, opts ...client.UpsertOption) (*PreventList, client.UpsertAction, *interfaces.Response, error) {
+	if req == nil {
+		return nil, "", nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	return client.Upsert(ctx, req.Name, client.Upserter[PreventList]{
+		Kind:      "prevent list",
+		Get:       s.GetPreventList,
+		GetByName: s.GetPreventListByName,
+		Create: func(ctx context.Context) (*PreventList, *interfaces.Response, error) {
+			return s.CreatePreventList(ctx, req)
+		},
+		Update: func(ctx context.Context, existing *PreventList) (*PreventList, *interfaces.Response, error) {
+			update := UpdatePreventListRequest(*req)
+			return s.UpdatePreventList(ctx, existing.ID, &update)
+		},
+		Matches: func(existing *PreventList) bool {
+			return client.VariablesMatch(preventListMutationVariables(existing.ToCreateRequest()), preventListMutationVariables(req))
+		},
+	}, opts...)
+}
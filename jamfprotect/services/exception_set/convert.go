@@ -0,0 +1,60 @@
+package exceptionset
+
+// ToCreateRequest returns the create request that reproduces set
+func (set *ExceptionSet) ToCreateRequest() *CreateExceptionSetRequest {
+	req := &CreateExceptionSetRequest{
+		Name:        set.Name,
+		Description: set.Description,
+	}
+	for _, e := range set.Exceptions {
+		req.Exceptions = append(req.Exceptions, e.ToInput())
+	}
+	for _, e := range set.EsExceptions {
+		req.EsExceptions = append(req.EsExceptions, e.ToInput())
+	}
+	return req
+}
+
+// ToUpdateRequest returns the update request that makes an existing exception set match set
+func (set *ExceptionSet) ToUpdateRequest() *UpdateExceptionSetRequest {
+	req := UpdateExceptionSetRequest(*set.ToCreateRequest())
+	return &req
+}
+
+// ToInput returns the input that reproduces e. The exception's analytic is identified by
+// AnalyticUuid, or by the nested Analytic reference the API returns in its place.
+func (e Exception) ToInput() ExceptionInput {
+	input := ExceptionInput{
+		Type:           e.Type,
+		Value:          e.Value,
+		AppSigningInfo: e.AppSigningInfo.ToInput(),
+		IgnoreActivity: e.IgnoreActivity,
+		AnalyticTypes:  append([]string(nil), e.AnalyticTypes...),
+		AnalyticUuid:   e.AnalyticUuid,
+	}
+	if input.AnalyticUuid == "" && e.Analytic != nil {
+		input.AnalyticUuid = e.Analytic.UUID
+	}
+	return input
+}
+
+// ToInput returns the input that reproduces e
+func (e EsException) ToInput() EsExceptionInput {
+	return EsExceptionInput{
+		Type:              e.Type,
+		Value:             e.Value,
+		AppSigningInfo:    e.AppSigningInfo.ToInput(),
+		IgnoreActivity:    e.IgnoreActivity,
+		IgnoreListType:    e.IgnoreListType,
+		IgnoreListSubType: e.IgnoreListSubType,
+		EventType:         e.EventType,
+	}
+}
+
+// ToInput returns the input form of info, or nil when info is nil
+func (info *AppSigningInfo) ToInput() *AppSigningInfoInput {
+	if info == nil {
+		return nil
+	}
+	return &AppSigningInfoInput{AppId: info.AppId, TeamId: info.TeamId}
+}
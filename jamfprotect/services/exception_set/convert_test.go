@@ -0,0 +1,75 @@
+package exceptionset_test
+
+import (
+	"testing"
+
+	exceptionset "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/exception_set"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExceptionSet_ToCreateRequest(t *testing.T) {
+	set := &exceptionset.ExceptionSet{
+		UUID:        "es-1",
+		Name:        "Exceptions",
+		Description: "desc",
+		Exceptions: []exceptionset.Exception{
+			{
+				Type:           exceptionset.ExceptionTypeUser,
+				Value:          "admin",
+				IgnoreActivity: exceptionset.IgnoreActivityAnalytics,
+				AnalyticUuid:   "a-1",
+			},
+			{
+				Type:           "AppSigningInfo",
+				AppSigningInfo: &exceptionset.AppSigningInfo{AppId: "com.example.app", TeamId: "ABCDE12345"},
+				IgnoreActivity: exceptionset.IgnoreActivityAnalytics,
+				AnalyticTypes:  []string{"Report"},
+				Analytic:       &exceptionset.AnalyticRef{UUID: "a-2", Name: "Nested"},
+			},
+		},
+		EsExceptions: []exceptionset.EsException{{
+			Type:           "AppSigningInfo",
+			AppSigningInfo: &exceptionset.AppSigningInfo{AppId: "com.example.app", TeamId: "ABCDE12345"},
+			IgnoreActivity: "Events",
+			IgnoreListType: "ignore",
+			EventType:      "exec",
+		}},
+	}
+
+	signing := &exceptionset.AppSigningInfoInput{AppId: "com.example.app", TeamId: "ABCDE12345"}
+	want := &exceptionset.CreateExceptionSetRequest{
+		Name:        "Exceptions",
+		Description: "desc",
+		Exceptions: []exceptionset.ExceptionInput{
+			{
+				Type:           exceptionset.ExceptionTypeUser,
+				Value:          "admin",
+				IgnoreActivity: exceptionset.IgnoreActivityAnalytics,
+				AnalyticUuid:   "a-1",
+			},
+			{
+				Type:           "AppSigningInfo",
+				AppSigningInfo: signing,
+				IgnoreActivity: exceptionset.IgnoreActivityAnalytics,
+				AnalyticTypes:  []string{"Report"},
+				AnalyticUuid:   "a-2",
+			},
+		},
+		EsExceptions: []exceptionset.EsExceptionInput{{
+			Type:           "AppSigningInfo",
+			AppSigningInfo: signing,
+			IgnoreActivity: "Events",
+			IgnoreListType: "ignore",
+			EventType:      "exec",
+		}},
+	}
+	assert.Equal(t, want, set.ToCreateRequest())
+	assert.Equal(t, exceptionset.UpdateExceptionSetRequest(*want), *set.ToUpdateRequest())
+}
+
+func TestException_ToInputPrefersAnalyticUuid(t *testing.T) {
+	e := exceptionset.Exception{AnalyticUuid: "direct", Analytic: &exceptionset.AnalyticRef{UUID: "nested"}}
+	assert.Equal(t, "direct", e.ToInput().AnalyticUuid)
+
+	assert.Empty(t, exceptionset.Exception{}.ToInput().AnalyticUuid)
+}
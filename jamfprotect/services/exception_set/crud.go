@@ -20,6 +20,8 @@ func NewService(client interfaces.GraphQLClient) *Service {
 
 // CreateExceptionSet creates a new exception set
 func (s *Service) CreateExceptionSet(ctx context.Context, req *CreateExceptionSetRequest) (*ExceptionSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "exception_set", "CreateExceptionSet")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
 	}
@@ -50,6 +52,12 @@ func (s *Service) CreateExceptionSet(ctx context.Context, req *CreateExceptionSe
 
 // GetExceptionSet retrieves an exception set by UUID
 func (s *Service) GetExceptionSet(ctx context.Context, uuid string) (*ExceptionSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "exception_set", "GetExceptionSet")
+	return s.getExceptionSet(ctx, uuid)
+}
+
+// getExceptionSet retrieves an exception set under the operation recorded in ctx
+func (s *Service) getExceptionSet(ctx context.Context, uuid string) (*ExceptionSet, *interfaces.Response, error) {
 	if err := ValidateExceptionSetUUID(uuid); err != nil {
 		return nil, nil, fmt.Errorf("%w: %v", client.ErrInvalidInput, err)
 	}
@@ -76,8 +84,34 @@ func (s *Service) GetExceptionSet(ctx context.Context, uuid string) (*ExceptionS
 	return result.GetExceptionSet, resp, nil
 }
 
+// GetExceptionSetByName retrieves the exception set with the given name. It returns an error wrapping
+// client.ErrNotFound when no exception set has the name, and client.ErrAmbiguousName when several do.
+func (s *Service) GetExceptionSetByName(ctx context.Context, name string) (*ExceptionSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "exception_set", "GetExceptionSetByName")
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("%w: name is required", client.ErrInvalidInput)
+	}
+
+	items, resp, err := s.listExceptionSets(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	match, err := client.MatchName("exception set", name, items,
+		func(item ExceptionSetListItem) string { return item.Name },
+		func(item ExceptionSetListItem) string { return item.UUID })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return s.getExceptionSet(ctx, match.UUID)
+}
+
 // UpdateExceptionSet updates an existing exception set
 func (s *Service) UpdateExceptionSet(ctx context.Context, uuid string, req *UpdateExceptionSetRequest) (*ExceptionSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "exception_set", "UpdateExceptionSet")
+
 	if err := ValidateExceptionSetUUID(uuid); err != nil {
 		return nil, nil, fmt.Errorf("%w: %v", client.ErrInvalidInput, err)
 	}
@@ -111,6 +145,8 @@ func (s *Service) UpdateExceptionSet(ctx context.Context, uuid string, req *Upda
 
 // DeleteExceptionSet deletes an exception set by UUID
 func (s *Service) DeleteExceptionSet(ctx context.Context, uuid string) (*interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "exception_set", "DeleteExceptionSet")
+
 	if err := ValidateExceptionSetUUID(uuid); err != nil {
 		return nil, fmt.Errorf("%w: %v", client.ErrInvalidInput, err)
 	}
@@ -132,6 +168,13 @@ func (s *Service) DeleteExceptionSet(ctx context.Context, uuid string) (*interfa
 
 // ListExceptionSets retrieves all exception sets with automatic pagination
 func (s *Service) ListExceptionSets(ctx context.Context) ([]ExceptionSetListItem, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "exception_set", "ListExceptionSets")
+	return s.listExceptionSets(ctx)
+}
+
+// listExceptionSets retrieves all exception sets with automatic pagination under the operation
+// recorded in ctx
+func (s *Service) listExceptionSets(ctx context.Context) ([]ExceptionSetListItem, *interfaces.Response, error) {
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -141,7 +184,7 @@ func (s *Service) ListExceptionSets(ctx context.Context) ([]ExceptionSetListItem
 	var nextToken *string
 	var lastResp *interfaces.Response
 
-	for {
+	for page := 1; ; page++ {
 		vars := map[string]any{
 			"direction": "DESC",
 			"field":     "created",
@@ -154,7 +197,7 @@ func (s *Service) ListExceptionSets(ctx context.Context) ([]ExceptionSetListItem
 			ListExceptionSets *ListExceptionSetsResponse `json:"listExceptionSets"`
 		}
 
-		resp, err := s.client.GraphQLPost(ctx, client.EndpointApp, listExceptionSetsQuery, vars, &result, headers)
+		resp, err := s.client.GraphQLPost(client.WithPage(ctx, page), client.EndpointApp, listExceptionSetsQuery, vars, &result, headers)
 		lastResp = resp
 		if err != nil {
 			return nil, lastResp, fmt.Errorf("failed to list exception sets: %w", err)
@@ -267,6 +310,8 @@ func buildEsExceptionInputVars(inputs []EsExceptionInput) []map[string]any {
 
 // ListExceptionSetNames retrieves only the names of all exception sets
 func (s *Service) ListExceptionSetNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "exception_set", "ListExceptionSetNames")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
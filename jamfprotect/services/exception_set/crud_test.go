@@ -74,6 +74,35 @@ func TestExceptionSetService_GetExceptionSet(t *testing.T) {
 	assert.Equal(t, "Test Exception Set", result.Name)
 }
 
+func TestExceptionSetService_GetExceptionSetByName(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewExceptionSetMock(baseURL)
+	mockHandler.RegisterListExceptionSetsMock()
+	mockHandler.RegisterGetExceptionSetMock()
+
+	result, _, err := service.GetExceptionSetByName(context.Background(), "Test Exception Set")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "aaaaaaaa-bbbb-4ccc-8ddd-eeeeeeeeeeee", result.UUID)
+	assert.Equal(t, "Test Exception Set", result.Name)
+
+	_, _, err = service.GetExceptionSetByName(context.Background(), "Missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestExceptionSetService_GetExceptionSetByName_Ambiguous(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	httpmock.RegisterMatcherResponder("POST", baseURL+"/app", httpmock.BodyContainsString("listExceptionSets"),
+		httpmock.NewStringResponder(200, `{"data":{"listExceptionSets":{"items":[{"uuid":"first","name":"Duplicate"},{"uuid":"second","name":"Duplicate"}],"pageInfo":{"next":null,"total":2}}}}`).
+			HeaderSet(http.Header{"Content-Type": {"application/json"}}))
+
+	_, _, err := service.GetExceptionSetByName(context.Background(), "Duplicate")
+
+	assert.ErrorIs(t, err, client.ErrAmbiguousName)
+	assert.ErrorContains(t, err, "first, second")
+}
+
 func TestExceptionSetService_UpdateExceptionSet(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewExceptionSetMock(baseURL)
@@ -176,3 +205,17 @@ func TestExceptionSetService_ValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestExceptionSetService_CloneExceptionSet_Failures(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mocks.NewExceptionSetMock(baseURL).RegisterGetExceptionSetNotFoundMock()
+
+	_, _, err := service.CloneExceptionSet(context.Background(), "", "Copy")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneExceptionSet(context.Background(), testUUID, "")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneExceptionSet(context.Background(), testUUID, "Copy")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
@@ -0,0 +1,45 @@
+package exceptionset
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// ExceptionSetService is the set of operations on Jamf Protect exception sets.
+// *Service implements it against the API; depend on the interface to substitute a fake in tests.
+type ExceptionSetService interface {
+	// CreateExceptionSet creates a new exception set
+	CreateExceptionSet(ctx context.Context, req *CreateExceptionSetRequest) (*ExceptionSet, *interfaces.Response, error)
+
+	// GetExceptionSet retrieves an exception set by UUID
+	GetExceptionSet(ctx context.Context, uuid string) (*ExceptionSet, *interfaces.Response, error)
+
+	// GetExceptionSetByName retrieves an exception set by its exact name
+	GetExceptionSetByName(ctx context.Context, name string) (*ExceptionSet, *interfaces.Response, error)
+
+	// UpdateExceptionSet updates an existing exception set
+	UpdateExceptionSet(ctx context.Context, uuid string, req *UpdateExceptionSetRequest) (*ExceptionSet, *interfaces.Response, error)
+
+	// PatchExceptionSet fetches the exception set, applies patch to an update request built from it and
+	// sends the full update
+	PatchExceptionSet(ctx context.Context, uuid string, patch func(req *UpdateExceptionSetRequest)) (*ExceptionSet, *interfaces.Response, error)
+
+	// UpsertExceptionSet creates or updates an exception set to match req and reports which it did
+	UpsertExceptionSet(ctx context.Context, req *CreateExceptionSetRequest, opts ...client.UpsertOption) (*ExceptionSet, client.UpsertAction, *interfaces.Response, error)
+
+	// CloneExceptionSet creates a copy of an exception set under a new name
+	CloneExceptionSet(ctx context.Context, uuid, newName string) (*ExceptionSet, *interfaces.Response, error)
+
+	// DeleteExceptionSet deletes an exception set by UUID
+	DeleteExceptionSet(ctx context.Context, uuid string) (*interfaces.Response, error)
+
+	// ListExceptionSets retrieves all exception sets with automatic pagination
+	ListExceptionSets(ctx context.Context) ([]ExceptionSetListItem, *interfaces.Response, error)
+
+	// ListExceptionSetNames retrieves only the names of all exception sets
+	ListExceptionSetNames(ctx context.Context) ([]string, *interfaces.Response, error)
+}
+
+var _ ExceptionSetService = (*Service)(nil)
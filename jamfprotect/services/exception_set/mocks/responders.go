@@ -64,6 +64,21 @@ func (m *ExceptionSetMock) RegisterGetExceptionSetMock() {
 	)
 }
 
+// RegisterGetExceptionSetNotFoundMock registers a getExceptionSet mock that returns null data, as the API
+// does for an ID that does not exist
+func (m *ExceptionSetMock) RegisterGetExceptionSetNotFoundMock() {
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		m.baseURL+"/app",
+		httpmock.BodyContainsString("getExceptionSet"),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, m.loadMockData("get_exception_set_not_found.json"))
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		},
+	)
+}
+
 // RegisterUpdateExceptionSetMock registers a success mock for updateExceptionSet
 func (m *ExceptionSetMock) RegisterUpdateExceptionSetMock() {
 	httpmock.RegisterMatcherResponder(
@@ -0,0 +1,34 @@
+package exceptionset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// PatchExceptionSet changes only the fields patch sets. The update mutation replaces the whole
+// exception set, so PatchExceptionSet fetches the current exception set, converts it to an update request,
+// applies patch to the request and sends the full mutation.
+func (s *Service) PatchExceptionSet(ctx context.Context, uuid string, patch func(req *UpdateExceptionSetRequest)) (*ExceptionSet, *interfaces.Response, error) {
+	if uuid == "" {
+		return nil, nil, fmt.Errorf("%w: uuid is required", client.ErrInvalidInput)
+	}
+	if patch == nil {
+		return nil, nil, fmt.Errorf("%w: patch function is required", client.ErrInvalidInput)
+	}
+
+	current, resp, err := s.GetExceptionSet(ctx, uuid)
+	if err != nil {
+		return nil, resp, err
+	}
+	if current == nil {
+		return nil, resp, fmt.Errorf("%w: exception set %q", client.ErrNotFound, uuid)
+	}
+
+	req := current.ToUpdateRequest()
+	patch(req)
+
+	return s.UpdateExceptionSet(ctx, uuid, req)
+}
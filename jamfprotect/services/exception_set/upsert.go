@@ -0,0 +1,34 @@
+package exceptionset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertExceptionSet makes the exception set named req.Name, or identified by client.UpsertByID, match
+// req. It creates the exception set when none exists, updates it when it differs from req,
+// and otherwise leaves it alone.
+func (s *Service) UpsertExceptionSet(ctx context.Context, req *CreateExceptionSetRequest, opts ...client.UpsertOption) (*ExceptionSet, client.UpsertAction, *interfaces.Response, error) {
+	if req == nil {
+		return nil, "", nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	return client.Upsert(ctx, req.Name, client.Upserter[ExceptionSet]{
+		Kind:      "exception set",
+		Get:       s.GetExceptionSet,
+		GetByName: s.GetExceptionSetByName,
+		Create: func(ctx context.Context) (*ExceptionSet, *interfaces.Response, error) {
+			return s.CreateExceptionSet(ctx, req)
+		},
+		Update: func(ctx context.Context, existing *ExceptionSet) (*ExceptionSet, *interfaces.Response, error) {
+			update := UpdateExceptionSetRequest(*req)
+			return s.UpdateExceptionSet(ctx, existing.UUID, &update)
+		},
+		Matches: func(existing *ExceptionSet) bool {
+			return client.VariablesMatch(exceptionSetMutationVariables(existing.ToCreateRequest(), ""), exceptionSetMutationVariables(req, ""))
+		},
+	}, opts...)
+}
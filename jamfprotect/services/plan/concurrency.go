@@ -0,0 +1,55 @@
+package plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpdatePlanIfMatch updates a plan only if its hash still equals expectedHash, the Hash of the
+// plan the caller based req on. The plan is re-read first; when its hash has changed the update
+// is refused with a *client.ConflictError[*Plan] carrying the current plan. The check is
+// best-effort and client-side, not a server-side precondition: a change made between the
+// re-read and the update is not detected.
+func (s *Service) UpdatePlanIfMatch(ctx context.Context, id, expectedHash string, req *UpdatePlanRequest) (*Plan, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if expectedHash == "" {
+		return nil, nil, fmt.Errorf("%w: expected hash is required", client.ErrInvalidInput)
+	}
+	if req == nil {
+		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	current, resp, err := s.GetPlan(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if current == nil {
+		return nil, resp, fmt.Errorf("%w: plan %q", client.ErrNotFound, id)
+	}
+	if err := client.CheckHash("plan", id, expectedHash, current.Hash, current); err != nil {
+		return nil, resp, err
+	}
+
+	return s.UpdatePlan(ctx, id, req)
+}
+
+// ModifyPlan performs a read-modify-write of a plan. It reads the plan, converts it to an
+// update request, lets merge change the request and writes it with UpdatePlanIfMatch. When
+// the plan changes between the read and the write, merge is called again with the newer plan,
+// up to attempts times (client.DefaultModifyAttempts when attempts < 1). A retry merges into
+// the plan UpdatePlanIfMatch already read instead of reading it again. The conflict check
+// is best-effort, as described for UpdatePlanIfMatch.
+func (s *Service) ModifyPlan(ctx context.Context, id string, merge func(current *Plan, req *UpdatePlanRequest) error, attempts int) (*Plan, *interfaces.Response, error) {
+	return client.Modify(ctx, id, client.Modifier[Plan, *UpdatePlanRequest]{
+		Kind:          "plan",
+		Get:           s.GetPlan,
+		Hash:          func(current *Plan) string { return current.Hash },
+		Request:       (*Plan).ToUpdateRequest,
+		UpdateIfMatch: s.UpdatePlanIfMatch,
+	}, merge, attempts)
+}
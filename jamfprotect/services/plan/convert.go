@@ -0,0 +1,69 @@
+package plan
+
+// ToCreateRequest returns the create request that reproduces p. References become
+// their IDs, and a plan without telemetry v2 or a USB control set sets TelemetryV2Null or
+// USBControlSetNull.
+func (p *Plan) ToCreateRequest() *CreatePlanRequest {
+	req := &CreatePlanRequest{
+		Name:        p.Name,
+		Description: p.Description,
+		AutoUpdate:  p.AutoUpdate,
+	}
+	if p.LogLevel != "" {
+		logLevel := p.LogLevel
+		req.LogLevel = &logLevel
+	}
+	if p.ActionConfigs != nil {
+		req.ActionConfigs = p.ActionConfigs.ID
+	}
+
+	req.ExceptionSets = make([]string, 0, len(p.ExceptionSets))
+	for _, set := range p.ExceptionSets {
+		req.ExceptionSets = append(req.ExceptionSets, set.UUID)
+	}
+
+	if p.Telemetry != nil {
+		req.Telemetry = refID(p.Telemetry)
+	}
+	if p.TelemetryV2 != nil {
+		req.TelemetryV2 = refID(p.TelemetryV2)
+	} else {
+		req.TelemetryV2Null = true
+	}
+
+	req.AnalyticSets = make([]AnalyticSetInput, 0, len(p.AnalyticSets))
+	for _, set := range p.AnalyticSets {
+		req.AnalyticSets = append(req.AnalyticSets, AnalyticSetInput{Type: set.Type, UUID: set.AnalyticSet.UUID})
+	}
+
+	if p.USBControlSet != nil {
+		req.USBControlSet = refID(p.USBControlSet)
+	} else {
+		req.USBControlSetNull = true
+	}
+	if p.CommsConfig != nil {
+		req.CommsConfig = CommsConfigInput{FQDN: p.CommsConfig.FQDN, Protocol: p.CommsConfig.Protocol}
+	}
+	if p.InfoSync != nil {
+		req.InfoSync = InfoSyncInput{
+			Attrs:                append([]string(nil), p.InfoSync.Attrs...),
+			InsightsSyncInterval: p.InfoSync.InsightsSyncInterval,
+		}
+	}
+	if p.SignaturesFeedConfig != nil {
+		req.SignaturesFeedConfig = SignaturesFeedConfigInput{Mode: p.SignaturesFeedConfig.Mode}
+	}
+	return req
+}
+
+// ToUpdateRequest returns the update request that makes an existing plan match p
+func (p *Plan) ToUpdateRequest() *UpdatePlanRequest {
+	req := UpdatePlanRequest(*p.ToCreateRequest())
+	return &req
+}
+
+// refID returns a pointer to the ID of a plan reference
+func refID(ref *PlanRef) *string {
+	id := ref.ID
+	return &id
+}
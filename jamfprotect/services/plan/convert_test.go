@@ -0,0 +1,58 @@
+package plan_test
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/plan"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan_ToCreateRequest(t *testing.T) {
+	p := &plan.Plan{
+		ID:                   "1",
+		Hash:                 "abc",
+		Name:                 "Plan",
+		Description:          "desc",
+		LogLevel:             "ERROR",
+		AutoUpdate:           true,
+		CommsConfig:          &plan.CommsConfig{FQDN: "protect.example.com", Protocol: plan.ProtocolMQTT},
+		InfoSync:             &plan.InfoSync{Attrs: []string{"hostName"}, InsightsSyncInterval: 3600},
+		SignaturesFeedConfig: &plan.SignaturesFeedConfig{Mode: "blocking"},
+		ActionConfigs:        &plan.PlanRef{ID: "ac-1", Name: "Actions"},
+		ExceptionSets:        []plan.ExceptionSet{{UUID: "es-1", Name: "Exceptions"}},
+		USBControlSet:        &plan.PlanRef{ID: "usb-1"},
+		Telemetry:            &plan.PlanRef{ID: "tel-1"},
+		AnalyticSets: []plan.AnalyticSet{
+			{Type: "Report", AnalyticSet: plan.AnalyticSetRef{UUID: "as-1", Name: "Set"}},
+		},
+	}
+
+	logLevel, usb, telemetry := "ERROR", "usb-1", "tel-1"
+	want := &plan.CreatePlanRequest{
+		Name:                 "Plan",
+		Description:          "desc",
+		LogLevel:             &logLevel,
+		ActionConfigs:        "ac-1",
+		ExceptionSets:        []string{"es-1"},
+		Telemetry:            &telemetry,
+		TelemetryV2Null:      true,
+		AnalyticSets:         []plan.AnalyticSetInput{{Type: "Report", UUID: "as-1"}},
+		USBControlSet:        &usb,
+		CommsConfig:          plan.CommsConfigInput{FQDN: "protect.example.com", Protocol: plan.ProtocolMQTT},
+		InfoSync:             plan.InfoSyncInput{Attrs: []string{"hostName"}, InsightsSyncInterval: 3600},
+		AutoUpdate:           true,
+		SignaturesFeedConfig: plan.SignaturesFeedConfigInput{Mode: "blocking"},
+	}
+	assert.Equal(t, want, p.ToCreateRequest())
+
+	update := p.ToUpdateRequest()
+	assert.Equal(t, plan.UpdatePlanRequest(*want), *update)
+
+	p.TelemetryV2 = &plan.PlanRef{ID: "tv2-1"}
+	p.USBControlSet = nil
+	req := p.ToCreateRequest()
+	assert.False(t, req.TelemetryV2Null)
+	assert.Equal(t, "tv2-1", *req.TelemetryV2)
+	assert.True(t, req.USBControlSetNull)
+	assert.Nil(t, req.USBControlSet)
+}
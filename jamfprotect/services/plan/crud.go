@@ -20,6 +20,8 @@ func NewService(client interfaces.GraphQLClient) *Service {
 
 // CreatePlan creates a new plan
 func (s *Service) CreatePlan(ctx context.Context, req *CreatePlanRequest) (*Plan, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "plan", "CreatePlan")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
 	}
@@ -53,6 +55,8 @@ func (s *Service) CreatePlan(ctx context.Context, req *CreatePlanRequest) (*Plan
 
 // GetPlan retrieves a plan by ID
 func (s *Service) GetPlan(ctx context.Context, id string) (*Plan, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "plan", "GetPlan")
+
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -75,8 +79,35 @@ func (s *Service) GetPlan(ctx context.Context, id string) (*Plan, *interfaces.Re
 	return result.GetPlan, resp, nil
 }
 
+// GetPlanByName retrieves the plan with the given name. It returns an error wrapping
+// client.ErrNotFound when no plan has the name, and client.ErrAmbiguousName when several do.
+// The plan is taken from the list, without a second request.
+func (s *Service) GetPlanByName(ctx context.Context, name string) (*Plan, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "plan", "GetPlanByName")
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("%w: name is required", client.ErrInvalidInput)
+	}
+
+	items, resp, err := s.listPlans(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	match, err := client.MatchName("plan", name, items,
+		func(item Plan) string { return item.Name },
+		func(item Plan) string { return item.ID })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &match, resp, nil
+}
+
 // UpdatePlan updates an existing plan
 func (s *Service) UpdatePlan(ctx context.Context, id string, req *UpdatePlanRequest) (*Plan, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "plan", "UpdatePlan")
+
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -105,6 +136,8 @@ func (s *Service) UpdatePlan(ctx context.Context, id string, req *UpdatePlanRequ
 
 // DeletePlan deletes a plan by ID
 func (s *Service) DeletePlan(ctx context.Context, id string) (*interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "plan", "DeletePlan")
+
 	if id == "" {
 		return nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -126,6 +159,13 @@ func (s *Service) DeletePlan(ctx context.Context, id string) (*interfaces.Respon
 
 // ListPlans retrieves all plans with automatic pagination
 func (s *Service) ListPlans(ctx context.Context) ([]Plan, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "plan", "ListPlans")
+	return s.listPlans(ctx)
+}
+
+// listPlans retrieves all plans with automatic pagination under the operation
+// recorded in ctx
+func (s *Service) listPlans(ctx context.Context) ([]Plan, *interfaces.Response, error) {
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -135,7 +175,7 @@ func (s *Service) ListPlans(ctx context.Context) ([]Plan, *interfaces.Response,
 	var nextToken *string
 	var lastResp *interfaces.Response
 
-	for {
+	for page := 1; ; page++ {
 		vars := map[string]any{
 			"direction": "ASC",
 			"field":     "CREATED",
@@ -148,7 +188,7 @@ func (s *Service) ListPlans(ctx context.Context) ([]Plan, *interfaces.Response,
 			ListPlans *ListPlansResponse `json:"listPlans"`
 		}
 
-		resp, err := s.client.GraphQLPost(ctx, client.EndpointApp, listPlansQuery, vars, &result, headers)
+		resp, err := s.client.GraphQLPost(client.WithPage(ctx, page), client.EndpointApp, listPlansQuery, vars, &result, headers)
 		lastResp = resp
 		if err != nil {
 			return nil, lastResp, fmt.Errorf("failed to list plans: %w", err)
@@ -170,6 +210,8 @@ func (s *Service) ListPlans(ctx context.Context) ([]Plan, *interfaces.Response,
 
 // ListPlanNames retrieves only the names of all plans with automatic pagination
 func (s *Service) ListPlanNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "plan", "ListPlanNames")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -179,7 +221,7 @@ func (s *Service) ListPlanNames(ctx context.Context) ([]string, *interfaces.Resp
 	var nextToken *string
 	var lastResp *interfaces.Response
 
-	for {
+	for page := 1; ; page++ {
 		vars := map[string]any{}
 		if nextToken != nil {
 			vars["nextToken"] = *nextToken
@@ -189,7 +231,7 @@ func (s *Service) ListPlanNames(ctx context.Context) ([]string, *interfaces.Resp
 			ListPlanNames *ListPlanNamesResponse `json:"listPlanNames"`
 		}
 
-		resp, err := s.client.GraphQLPost(ctx, client.EndpointApp, listPlanNamesQuery, vars, &result, headers)
+		resp, err := s.client.GraphQLPost(client.WithPage(ctx, page), client.EndpointApp, listPlanNamesQuery, vars, &result, headers)
 		lastResp = resp
 		if err != nil {
 			return nil, lastResp, fmt.Errorf("failed to list plan names: %w", err)
@@ -215,6 +257,8 @@ func (s *Service) ListPlanNames(ctx context.Context) ([]string, *interfaces.Resp
 // gated by RBAC flags. Returns action configs, telemetries (v1 and v2), USB control sets,
 // exception sets, and both managed and unmanaged analytic sets.
 func (s *Service) GetPlanConfigurationAndSetOptions(ctx context.Context, req *GetPlanConfigurationAndSetOptionsRequest) (*PlanConfigurationAndSetOptions, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "plan", "GetPlanConfigurationAndSetOptions")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request is required", client.ErrInvalidInput)
 	}
@@ -76,6 +76,34 @@ func TestPlanService_GetPlan(t *testing.T) {
 	assert.Equal(t, "Test Plan", result.Name)
 }
 
+func TestPlanService_GetPlanByName(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewPlanMock(baseURL)
+	mockHandler.RegisterListPlansMock()
+
+	result, _, err := service.GetPlanByName(context.Background(), "Test Plan")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "test-id-1234", result.ID)
+	assert.Equal(t, "Test Plan", result.Name)
+
+	_, _, err = service.GetPlanByName(context.Background(), "Missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestPlanService_GetPlanByName_Ambiguous(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	httpmock.RegisterMatcherResponder("POST", baseURL+"/app", httpmock.BodyContainsString("listPlans"),
+		httpmock.NewStringResponder(200, `{"data":{"listPlans":{"items":[{"id":"first","name":"Duplicate"},{"id":"second","name":"Duplicate"}],"pageInfo":{"next":null,"total":2}}}}`).
+			HeaderSet(http.Header{"Content-Type": {"application/json"}}))
+
+	_, _, err := service.GetPlanByName(context.Background(), "Duplicate")
+
+	assert.ErrorIs(t, err, client.ErrAmbiguousName)
+	assert.ErrorContains(t, err, "first, second")
+}
+
 func TestPlanService_UpdatePlan(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewPlanMock(baseURL)
@@ -98,6 +126,24 @@ func TestPlanService_UpdatePlan(t *testing.T) {
 	assert.Equal(t, "Updated Plan", result.Name)
 }
 
+func TestPlanService_PatchPlan(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewPlanMock(baseURL)
+	mockHandler.RegisterGetPlanMock()
+	mockHandler.RegisterUpdatePlanMock()
+
+	result, _, err := service.PatchPlan(context.Background(), "test-id-1234", func(req *plan.UpdatePlanRequest) {
+		req.Name = "Updated Plan"
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "Updated Plan", result.Name)
+
+	_, _, err = service.PatchPlan(context.Background(), "test-id-1234", nil)
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+}
+
 func TestPlanService_DeletePlan(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewPlanMock(baseURL)
@@ -13,6 +13,7 @@ func planMutationVariables(req any) map[string]any {
 		telemetryV2Null      bool
 		analyticSets         []AnalyticSetInput
 		usbControlSet        *string
+		usbControlSetNull    bool
 		commsConfig          CommsConfigInput
 		infoSync             InfoSyncInput
 		autoUpdate           bool
@@ -31,6 +32,7 @@ func planMutationVariables(req any) map[string]any {
 		telemetryV2Null = r.TelemetryV2Null
 		analyticSets = r.AnalyticSets
 		usbControlSet = r.USBControlSet
+		usbControlSetNull = r.USBControlSetNull
 		commsConfig = r.CommsConfig
 		infoSync = r.InfoSync
 		autoUpdate = r.AutoUpdate
@@ -46,6 +48,7 @@ func planMutationVariables(req any) map[string]any {
 		telemetryV2Null = r.TelemetryV2Null
 		analyticSets = r.AnalyticSets
 		usbControlSet = r.USBControlSet
+		usbControlSetNull = r.USBControlSetNull
 		commsConfig = r.CommsConfig
 		infoSync = r.InfoSync
 		autoUpdate = r.AutoUpdate
@@ -99,7 +102,9 @@ func planMutationVariables(req any) map[string]any {
 		vars["analyticSets"] = analyticSetsVars
 	}
 
-	if usbControlSet != nil {
+	if usbControlSetNull {
+		vars["usbControlSet"] = nil
+	} else if usbControlSet != nil {
 		vars["usbControlSet"] = *usbControlSet
 	}
 
@@ -0,0 +1,54 @@
+package plan
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// PlanService is the set of operations on Jamf Protect plans.
+// *Service implements it against the API; depend on the interface to substitute a fake in tests.
+type PlanService interface {
+	// CreatePlan creates a new plan
+	CreatePlan(ctx context.Context, req *CreatePlanRequest) (*Plan, *interfaces.Response, error)
+
+	// GetPlan retrieves a plan by ID
+	GetPlan(ctx context.Context, id string) (*Plan, *interfaces.Response, error)
+
+	// GetPlanByName retrieves a plan by its exact name
+	GetPlanByName(ctx context.Context, name string) (*Plan, *interfaces.Response, error)
+
+	// UpdatePlan updates an existing plan
+	UpdatePlan(ctx context.Context, id string, req *UpdatePlanRequest) (*Plan, *interfaces.Response, error)
+
+	// UpdatePlanIfMatch updates only if the current hash equals expectedHash, returning a
+	// *client.ConflictError otherwise
+	UpdatePlanIfMatch(ctx context.Context, id, expectedHash string, req *UpdatePlanRequest) (*Plan, *interfaces.Response, error)
+
+	// ModifyPlan re-reads, merges and conditionally updates, retrying on conflict
+	ModifyPlan(ctx context.Context, id string, merge func(current *Plan, req *UpdatePlanRequest) error, attempts int) (*Plan, *interfaces.Response, error)
+
+	// PatchPlan fetches the plan, applies patch to an update request built from it and
+	// sends the full update
+	PatchPlan(ctx context.Context, id string, patch func(req *UpdatePlanRequest)) (*Plan, *interfaces.Response, error)
+
+	// UpsertPlan creates or updates a plan to match req and reports which it did
+	UpsertPlan(ctx context.Context, req *CreatePlanRequest, opts ...client.UpsertOption) (*Plan, client.UpsertAction, *interfaces.Response, error)
+
+	// DeletePlan deletes a plan by ID
+	DeletePlan(ctx context.Context, id string) (*interfaces.Response, error)
+
+	// ListPlans retrieves all plans with automatic pagination
+	ListPlans(ctx context.Context) ([]Plan, *interfaces.Response, error)
+
+	// ListPlanNames retrieves only the names of all plans with automatic pagination
+	ListPlanNames(ctx context.Context) ([]string, *interfaces.Response, error)
+
+	// GetPlanConfigurationAndSetOptions retrieves all resources available for plan configuration,
+	// gated by RBAC flags. Returns action configs, telemetries (v1 and v2), USB control sets,
+	// exception sets, and both managed and unmanaged analytic sets.
+	GetPlanConfigurationAndSetOptions(ctx context.Context, req *GetPlanConfigurationAndSetOptionsRequest) (*PlanConfigurationAndSetOptions, *interfaces.Response, error)
+}
+
+var _ PlanService = (*Service)(nil)
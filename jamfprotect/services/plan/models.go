@@ -83,6 +83,7 @@ type CreatePlanRequest struct {
 	TelemetryV2Null      bool
 	AnalyticSets         []AnalyticSetInput
 	USBControlSet        *string
+	USBControlSetNull    bool
 	CommsConfig          CommsConfigInput
 	InfoSync             InfoSyncInput
 	AutoUpdate           bool
@@ -101,6 +102,7 @@ type UpdatePlanRequest struct {
 	TelemetryV2Null      bool
 	AnalyticSets         []AnalyticSetInput
 	USBControlSet        *string
+	USBControlSetNull    bool
 	CommsConfig          CommsConfigInput
 	InfoSync             InfoSyncInput
 	AutoUpdate           bool
@@ -0,0 +1,34 @@
+package plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// PatchPlan changes only the fields patch sets. The update mutation replaces the whole
+// plan, so PatchPlan fetches the current plan, converts it to an update request,
+// applies patch to the request and sends the full mutation.
+func (s *Service) PatchPlan(ctx context.Context, id string, patch func(req *UpdatePlanRequest)) (*Plan, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if patch == nil {
+		return nil, nil, fmt.Errorf("%w: patch function is required", client.ErrInvalidInput)
+	}
+
+	current, resp, err := s.GetPlan(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if current == nil {
+		return nil, resp, fmt.Errorf("%w: plan %q", client.ErrNotFound, id)
+	}
+
+	req := current.ToUpdateRequest()
+	patch(req)
+
+	return s.UpdatePlan(ctx, id, req)
+}
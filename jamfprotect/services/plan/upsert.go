@@ -0,0 +1,34 @@
+package plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertPlan makes the plan named req.Name, or identified by client.UpsertByID, match req.
+// It creates the plan when none exists, updates it when it differs from req, and otherwise
+// leaves it alone. Optional inputs left nil in req are not compared.
+func (s *Service) UpsertPlan(ctx context.Context, req *CreatePlanRequest, opts ...client.UpsertOption) (*Plan, client.UpsertAction, *interfaces.Response, error) {
+	if req == nil {
+		return nil, "", nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	return client.Upsert(ctx, req.Name, client.Upserter[Plan]{
+		Kind:      "plan",
+		Get:       s.GetPlan,
+		GetByName: s.GetPlanByName,
+		Create: func(ctx context.Context) (*Plan, *interfaces.Response, error) {
+			return s.CreatePlan(ctx, req)
+		},
+		Update: func(ctx context.Context, existing *Plan) (*Plan, *interfaces.Response, error) {
+			update := UpdatePlanRequest(*req)
+			return s.UpdatePlan(ctx, existing.ID, &update)
+		},
+		Matches: func(existing *Plan) bool {
+			return client.VariablesMatch(planMutationVariables(existing.ToCreateRequest()), planMutationVariables(req))
+		},
+	}, opts...)
+}
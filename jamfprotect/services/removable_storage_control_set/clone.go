@@ -0,0 +1,35 @@
+package removablestoragecontrolset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// CloneUSBControlSet creates a copy of a USB control set named newName. The copy has the same
+// contents as the original, which is left unchanged.
+func (s *Service) CloneUSBControlSet(ctx context.Context, id, newName string) (*USBControlSet, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if newName == "" {
+		return nil, nil, fmt.Errorf("%w: new name is required", client.ErrInvalidInput)
+	}
+
+	source, resp, err := s.GetUSBControlSet(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if source == nil {
+		return nil, resp, fmt.Errorf("%w: USB control set %q", client.ErrNotFound, id)
+	}
+
+	req, err := source.ToCreateRequest()
+	if err != nil {
+		return nil, resp, err
+	}
+	req.Name = newName
+	return s.CreateUSBControlSet(ctx, req)
+}
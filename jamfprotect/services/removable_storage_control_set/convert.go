@@ -0,0 +1,95 @@
+package removablestoragecontrolset
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+)
+
+// Rule types, which select the input variant a rule is sent as
+const (
+	RuleTypeVendor     = "Vendor"
+	RuleTypeSerial     = "Serial"
+	RuleTypeProduct    = "Product"
+	RuleTypeEncryption = "Encryption"
+)
+
+// ToCreateRequest returns the create request that reproduces set. It fails when a rule has
+// a type ToInput cannot convert.
+func (set *USBControlSet) ToCreateRequest() (*CreateUSBControlSetRequest, error) {
+	req := &CreateUSBControlSetRequest{
+		Name:                 set.Name,
+		Description:          set.Description,
+		DefaultMountAction:   set.DefaultMountAction,
+		DefaultMessageAction: set.DefaultMessageAction,
+	}
+	for _, rule := range set.Rules {
+		input, err := rule.ToInput()
+		if err != nil {
+			return nil, err
+		}
+		req.Rules = append(req.Rules, input)
+	}
+	return req, nil
+}
+
+// ToUpdateRequest returns the update request that makes an existing USB control set match set
+func (set *USBControlSet) ToUpdateRequest() (*UpdateUSBControlSetRequest, error) {
+	create, err := set.ToCreateRequest()
+	if err != nil {
+		return nil, err
+	}
+	req := UpdateUSBControlSetRequest(*create)
+	return &req, nil
+}
+
+// ToInput converts the flat response rule to the input variant selected by its type:
+// VendorRule, SerialRule, ProductRule or EncryptionRule. A rule of any other type cannot be
+// sent without losing its details, so it is reported as an error wrapping
+// client.ErrInvalidInput.
+func (rule USBControlRule) ToInput() (USBControlRuleInput, error) {
+	input := USBControlRuleInput{Type: rule.Type}
+	messageAction := optionalString(rule.MessageAction)
+	applyTo := optionalString(rule.ApplyTo)
+
+	switch rule.Type {
+	case RuleTypeVendor, RuleTypeSerial, RuleTypeProduct, RuleTypeEncryption:
+	default:
+		return USBControlRuleInput{}, fmt.Errorf("%w: unknown USB control rule type %q", client.ErrInvalidInput, rule.Type)
+	}
+
+	switch rule.Type {
+	case RuleTypeProduct:
+		input.ProductRule = &USBControlProductRuleDetails{
+			MountAction:   rule.MountAction,
+			MessageAction: messageAction,
+			ApplyTo:       applyTo,
+			Products:      append([]USBControlProductPair(nil), rule.Products...),
+		}
+	default:
+		details := &USBControlRuleDetails{
+			MountAction:   rule.MountAction,
+			MessageAction: messageAction,
+			ApplyTo:       applyTo,
+			Vendors:       append([]string(nil), rule.Vendors...),
+			Serials:       append([]string(nil), rule.Serials...),
+		}
+		switch rule.Type {
+		case RuleTypeVendor:
+			input.VendorRule = details
+		case RuleTypeSerial:
+			input.SerialRule = details
+		case RuleTypeEncryption:
+			input.EncryptionRule = details
+		}
+	}
+	return input, nil
+}
+
+// optionalString returns a pointer to s, or nil when s is empty
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,102 @@
+package removablestoragecontrolset_test
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	removablestoragecontrolset "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/removable_storage_control_set"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUSBControlRule_ToInput(t *testing.T) {
+	message, applyTo := "Blocked", "all"
+
+	tests := []struct {
+		name string
+		rule removablestoragecontrolset.USBControlRule
+		want removablestoragecontrolset.USBControlRuleInput
+	}{
+		{
+			name: "vendor",
+			rule: removablestoragecontrolset.USBControlRule{Type: removablestoragecontrolset.RuleTypeVendor, MountAction: removablestoragecontrolset.MountActionReadOnly, MessageAction: message, ApplyTo: applyTo, Vendors: []string{"0x1234"}},
+			want: removablestoragecontrolset.USBControlRuleInput{
+				Type:       removablestoragecontrolset.RuleTypeVendor,
+				VendorRule: &removablestoragecontrolset.USBControlRuleDetails{MountAction: removablestoragecontrolset.MountActionReadOnly, MessageAction: &message, ApplyTo: &applyTo, Vendors: []string{"0x1234"}},
+			},
+		},
+		{
+			name: "serial",
+			rule: removablestoragecontrolset.USBControlRule{Type: removablestoragecontrolset.RuleTypeSerial, MountAction: removablestoragecontrolset.MountActionReadWrite, Serials: []string{"SN1"}},
+			want: removablestoragecontrolset.USBControlRuleInput{
+				Type:       removablestoragecontrolset.RuleTypeSerial,
+				SerialRule: &removablestoragecontrolset.USBControlRuleDetails{MountAction: removablestoragecontrolset.MountActionReadWrite, Serials: []string{"SN1"}},
+			},
+		},
+		{
+			name: "product",
+			rule: removablestoragecontrolset.USBControlRule{Type: removablestoragecontrolset.RuleTypeProduct, MountAction: removablestoragecontrolset.MountActionPrevented, Products: []removablestoragecontrolset.USBControlProductPair{{Vendor: "0x1", Product: "0x2"}}},
+			want: removablestoragecontrolset.USBControlRuleInput{
+				Type:        removablestoragecontrolset.RuleTypeProduct,
+				ProductRule: &removablestoragecontrolset.USBControlProductRuleDetails{MountAction: removablestoragecontrolset.MountActionPrevented, Products: []removablestoragecontrolset.USBControlProductPair{{Vendor: "0x1", Product: "0x2"}}},
+			},
+		},
+		{
+			name: "encryption",
+			rule: removablestoragecontrolset.USBControlRule{Type: removablestoragecontrolset.RuleTypeEncryption, MountAction: removablestoragecontrolset.MountActionReadWrite, MessageAction: message},
+			want: removablestoragecontrolset.USBControlRuleInput{
+				Type:           removablestoragecontrolset.RuleTypeEncryption,
+				EncryptionRule: &removablestoragecontrolset.USBControlRuleDetails{MountAction: removablestoragecontrolset.MountActionReadWrite, MessageAction: &message},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rule.ToInput()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := removablestoragecontrolset.USBControlRule{Type: "Bluetooth", MountAction: removablestoragecontrolset.MountActionReadOnly}.ToInput()
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+	assert.ErrorContains(t, err, `"Bluetooth"`)
+}
+
+func TestUSBControlSet_ToCreateRequest(t *testing.T) {
+	set := &removablestoragecontrolset.USBControlSet{
+		ID:                   "usb-1",
+		Name:                 "USB",
+		Description:          "desc",
+		DefaultMountAction:   removablestoragecontrolset.MountActionReadOnly,
+		DefaultMessageAction: "Read only",
+		Rules: []removablestoragecontrolset.USBControlRule{
+			{Type: removablestoragecontrolset.RuleTypeSerial, MountAction: removablestoragecontrolset.MountActionReadWrite, Serials: []string{"SN1"}},
+		},
+		Plans: []removablestoragecontrolset.USBControlSetPlan{{ID: "p-1"}},
+	}
+
+	want := &removablestoragecontrolset.CreateUSBControlSetRequest{
+		Name:                 "USB",
+		Description:          "desc",
+		DefaultMountAction:   removablestoragecontrolset.MountActionReadOnly,
+		DefaultMessageAction: "Read only",
+		Rules: []removablestoragecontrolset.USBControlRuleInput{{
+			Type:       removablestoragecontrolset.RuleTypeSerial,
+			SerialRule: &removablestoragecontrolset.USBControlRuleDetails{MountAction: removablestoragecontrolset.MountActionReadWrite, Serials: []string{"SN1"}},
+		}},
+	}
+	create, err := set.ToCreateRequest()
+	require.NoError(t, err)
+	assert.Equal(t, want, create)
+	update, err := set.ToUpdateRequest()
+	require.NoError(t, err)
+	assert.Equal(t, removablestoragecontrolset.UpdateUSBControlSetRequest(*want), *update)
+
+	set.Rules = append(set.Rules, removablestoragecontrolset.USBControlRule{Type: "Bluetooth"})
+	_, err = set.ToCreateRequest()
+	assert.ErrorIs(t, err, client.ErrInvalidInput, "a rule that cannot be converted is not dropped")
+	_, err = set.ToUpdateRequest()
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+}
@@ -20,6 +20,8 @@ func NewService(client interfaces.GraphQLClient) *Service {
 
 // CreateUSBControlSet creates a new USB control set
 func (s *Service) CreateUSBControlSet(ctx context.Context, req *CreateUSBControlSetRequest) (*USBControlSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "removable_storage_control_set", "CreateUSBControlSet")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
 	}
@@ -56,6 +58,8 @@ func (s *Service) CreateUSBControlSet(ctx context.Context, req *CreateUSBControl
 
 // GetUSBControlSet retrieves a USB control set by ID
 func (s *Service) GetUSBControlSet(ctx context.Context, id string) (*USBControlSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "removable_storage_control_set", "GetUSBControlSet")
+
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -78,8 +82,35 @@ func (s *Service) GetUSBControlSet(ctx context.Context, id string) (*USBControlS
 	return result.GetUSBControlSet, resp, nil
 }
 
+// GetUSBControlSetByName retrieves the USB control set with the given name. It returns an error wrapping
+// client.ErrNotFound when no USB control set has the name, and client.ErrAmbiguousName when several do.
+// The USB control set is taken from the list, without a second request.
+func (s *Service) GetUSBControlSetByName(ctx context.Context, name string) (*USBControlSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "removable_storage_control_set", "GetUSBControlSetByName")
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("%w: name is required", client.ErrInvalidInput)
+	}
+
+	items, resp, err := s.listUSBControlSets(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	match, err := client.MatchName("USB control set", name, items,
+		func(item USBControlSet) string { return item.Name },
+		func(item USBControlSet) string { return item.ID })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &match, resp, nil
+}
+
 // UpdateUSBControlSet updates an existing USB control set
 func (s *Service) UpdateUSBControlSet(ctx context.Context, id string, req *UpdateUSBControlSetRequest) (*USBControlSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "removable_storage_control_set", "UpdateUSBControlSet")
+
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -119,6 +150,8 @@ func (s *Service) UpdateUSBControlSet(ctx context.Context, id string, req *Updat
 
 // DeleteUSBControlSet deletes a USB control set by ID
 func (s *Service) DeleteUSBControlSet(ctx context.Context, id string) (*interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "removable_storage_control_set", "DeleteUSBControlSet")
+
 	if id == "" {
 		return nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -140,6 +173,13 @@ func (s *Service) DeleteUSBControlSet(ctx context.Context, id string) (*interfac
 
 // ListUSBControlSets retrieves all USB control sets with automatic pagination
 func (s *Service) ListUSBControlSets(ctx context.Context) ([]USBControlSet, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "removable_storage_control_set", "ListUSBControlSets")
+	return s.listUSBControlSets(ctx)
+}
+
+// listUSBControlSets retrieves all USB control sets with automatic pagination under the operation
+// recorded in ctx
+func (s *Service) listUSBControlSets(ctx context.Context) ([]USBControlSet, *interfaces.Response, error) {
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -149,7 +189,7 @@ func (s *Service) ListUSBControlSets(ctx context.Context) ([]USBControlSet, *int
 	var nextToken *string
 	var lastResp *interfaces.Response
 
-	for {
+	for page := 1; ; page++ {
 		vars := map[string]any{
 			"direction": "ASC",
 			"field":     "created",
@@ -162,7 +202,7 @@ func (s *Service) ListUSBControlSets(ctx context.Context) ([]USBControlSet, *int
 			ListUSBControlSets *ListUSBControlSetsResponse `json:"listUSBControlSets"`
 		}
 
-		resp, err := s.client.GraphQLPost(ctx, client.EndpointApp, listUSBControlSetsQuery, vars, &result, headers)
+		resp, err := s.client.GraphQLPost(client.WithPage(ctx, page), client.EndpointApp, listUSBControlSetsQuery, vars, &result, headers)
 		lastResp = resp
 		if err != nil {
 			return nil, lastResp, fmt.Errorf("failed to list USB control sets: %w", err)
@@ -184,6 +224,8 @@ func (s *Service) ListUSBControlSets(ctx context.Context) ([]USBControlSet, *int
 
 // ListUSBControlSetNames retrieves only the names of all USB control sets
 func (s *Service) ListUSBControlSetNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "removable_storage_control_set", "ListUSBControlSetNames")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
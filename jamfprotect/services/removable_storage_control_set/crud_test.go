@@ -75,6 +75,34 @@ func TestUSBControlSetService_GetUSBControlSet(t *testing.T) {
 	assert.Equal(t, "Test USB Control Set", result.Name)
 }
 
+func TestUSBControlSetService_GetUSBControlSetByName(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewUSBControlSetMock(baseURL)
+	mockHandler.RegisterListUSBControlSetsMock()
+
+	result, _, err := service.GetUSBControlSetByName(context.Background(), "Test USB Control Set")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "test-id-1234", result.ID)
+	assert.Equal(t, "Test USB Control Set", result.Name)
+
+	_, _, err = service.GetUSBControlSetByName(context.Background(), "Missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestUSBControlSetService_GetUSBControlSetByName_Ambiguous(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	httpmock.RegisterMatcherResponder("POST", baseURL+"/app", httpmock.BodyContainsString("listUSBControlSets"),
+		httpmock.NewStringResponder(200, `{"data":{"listUSBControlSets":{"items":[{"id":"first","name":"Duplicate"},{"id":"second","name":"Duplicate"}],"pageInfo":{"next":null,"total":2}}}}`).
+			HeaderSet(http.Header{"Content-Type": {"application/json"}}))
+
+	_, _, err := service.GetUSBControlSetByName(context.Background(), "Duplicate")
+
+	assert.ErrorIs(t, err, client.ErrAmbiguousName)
+	assert.ErrorContains(t, err, "first, second")
+}
+
 func TestUSBControlSetService_UpdateUSBControlSet(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewUSBControlSetMock(baseURL)
@@ -180,3 +208,17 @@ func TestUSBControlSetService_ValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestUSBControlSetService_CloneUSBControlSet_Failures(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mocks.NewUSBControlSetMock(baseURL).RegisterGetUSBControlSetNotFoundMock()
+
+	_, _, err := service.CloneUSBControlSet(context.Background(), "", "Copy")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneUSBControlSet(context.Background(), "usb-1", "")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneUSBControlSet(context.Background(), "usb-1", "Copy")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
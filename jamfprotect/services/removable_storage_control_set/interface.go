@@ -0,0 +1,45 @@
+package removablestoragecontrolset
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// USBControlSetService is the set of operations on Jamf Protect USB control sets.
+// *Service implements it against the API; depend on the interface to substitute a fake in tests.
+type USBControlSetService interface {
+	// CreateUSBControlSet creates a new USB control set
+	CreateUSBControlSet(ctx context.Context, req *CreateUSBControlSetRequest) (*USBControlSet, *interfaces.Response, error)
+
+	// GetUSBControlSet retrieves a USB control set by ID
+	GetUSBControlSet(ctx context.Context, id string) (*USBControlSet, *interfaces.Response, error)
+
+	// GetUSBControlSetByName retrieves a USB control set by its exact name
+	GetUSBControlSetByName(ctx context.Context, name string) (*USBControlSet, *interfaces.Response, error)
+
+	// UpdateUSBControlSet updates an existing USB control set
+	UpdateUSBControlSet(ctx context.Context, id string, req *UpdateUSBControlSetRequest) (*USBControlSet, *interfaces.Response, error)
+
+	// PatchUSBControlSet fetches the USB control set, applies patch to an update request built from it and
+	// sends the full update
+	PatchUSBControlSet(ctx context.Context, id string, patch func(req *UpdateUSBControlSetRequest)) (*USBControlSet, *interfaces.Response, error)
+
+	// UpsertUSBControlSet creates or updates a USB control set to match req and reports which it did
+	UpsertUSBControlSet(ctx context.Context, req *CreateUSBControlSetRequest, opts ...client.UpsertOption) (*USBControlSet, client.UpsertAction, *interfaces.Response, error)
+
+	// CloneUSBControlSet creates a copy of a USB control set under a new name
+	CloneUSBControlSet(ctx context.Context, id, newName string) (*USBControlSet, *interfaces.Response, error)
+
+	// DeleteUSBControlSet deletes a USB control set by ID
+	DeleteUSBControlSet(ctx context.Context, id string) (*interfaces.Response, error)
+
+	// ListUSBControlSets retrieves all USB control sets with automatic pagination
+	ListUSBControlSets(ctx context.Context) ([]USBControlSet, *interfaces.Response, error)
+
+	// ListUSBControlSetNames retrieves only the names of all USB control sets
+	ListUSBControlSetNames(ctx context.Context) ([]string, *interfaces.Response, error)
+}
+
+var _ USBControlSetService = (*Service)(nil)
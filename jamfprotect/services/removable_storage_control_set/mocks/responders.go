@@ -80,6 +80,24 @@ func (m *USBControlSetMock) RegisterGetUSBControlSetMock() {
 	)
 }
 
+// RegisterGetUSBControlSetNotFoundMock registers a getUSBControlSet mock that returns null data, as the API
+// does for an ID that does not exist
+func (m *USBControlSetMock) RegisterGetUSBControlSetNotFoundMock() {
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		m.baseURL+"/app",
+		httpmock.BodyContainsString("getUSBControlSet"),
+		func(req *http.Request) (*http.Response, error) {
+			resp, _ := httpmock.NewJsonResponse(200, map[string]any{
+				"data": map[string]any{
+					"getUSBControlSet": nil,
+				},
+			})
+			return resp, nil
+		},
+	)
+}
+
 // RegisterUpdateUSBControlSetMock registers a success mock for updateUSBControlSet
 func (m *USBControlSetMock) RegisterUpdateUSBControlSetMock() {
 	httpmock.RegisterMatcherResponder(
@@ -0,0 +1,37 @@
+package removablestoragecontrolset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// PatchUSBControlSet changes only the fields patch sets. The update mutation replaces the whole
+// USB control set, so PatchUSBControlSet fetches the current USB control set, converts it to an update request,
+// applies patch to the request and sends the full mutation.
+func (s *Service) PatchUSBControlSet(ctx context.Context, id string, patch func(req *UpdateUSBControlSetRequest)) (*USBControlSet, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if patch == nil {
+		return nil, nil, fmt.Errorf("%w: patch function is required", client.ErrInvalidInput)
+	}
+
+	current, resp, err := s.GetUSBControlSet(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if current == nil {
+		return nil, resp, fmt.Errorf("%w: USB control set %q", client.ErrNotFound, id)
+	}
+
+	req, err := current.ToUpdateRequest()
+	if err != nil {
+		return nil, resp, err
+	}
+	patch(req)
+
+	return s.UpdateUSBControlSet(ctx, id, req)
+}
@@ -0,0 +1,35 @@
+package removablestoragecontrolset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertUSBControlSet makes the USB control set named req.Name, or identified by client.UpsertByID, match
+// req. It creates the USB control set when none exists, updates it when it differs from req,
+// and otherwise leaves it alone.
+func (s *Service) UpsertUSBControlSet(ctx context.Context, req *CreateUSBControlSetRequest, opts ...client.UpsertOption) (*USBControlSet, client.UpsertAction, *interfaces.Response, error) {
+	if req == nil {
+		return nil, "", nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	return client.Upsert(ctx, req.Name, client.Upserter[USBControlSet]{
+		Kind:      "USB control set",
+		Get:       s.GetUSBControlSet,
+		GetByName: s.GetUSBControlSetByName,
+		Create: func(ctx context.Context) (*USBControlSet, *interfaces.Response, error) {
+			return s.CreateUSBControlSet(ctx, req)
+		},
+		Update: func(ctx context.Context, existing *USBControlSet) (*USBControlSet, *interfaces.Response, error) {
+			update := UpdateUSBControlSetRequest(*req)
+			return s.UpdateUSBControlSet(ctx, existing.ID, &update)
+		},
+		Matches: func(existing *USBControlSet) bool {
+			current, err := existing.ToCreateRequest()
+			return err == nil && client.VariablesMatch(usbControlSetMutationVariables(current, ""), usbControlSetMutationVariables(req, ""))
+		},
+	}, opts...)
+}
@@ -0,0 +1,32 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// CloneTelemetryV2 creates a copy of a telemetry v2 configuration named newName. The copy has the same
+// contents as the original, which is left unchanged.
+func (s *Service) CloneTelemetryV2(ctx context.Context, id, newName string) (*TelemetryV2, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if newName == "" {
+		return nil, nil, fmt.Errorf("%w: new name is required", client.ErrInvalidInput)
+	}
+
+	source, resp, err := s.GetTelemetryV2(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if source == nil {
+		return nil, resp, fmt.Errorf("%w: telemetry v2 configuration %q", client.ErrNotFound, id)
+	}
+
+	req := source.ToCreateRequest()
+	req.Name = newName
+	return s.CreateTelemetryV2(ctx, req)
+}
@@ -0,0 +1,20 @@
+package telemetry
+
+// ToCreateRequest returns the create request that reproduces t
+func (t *TelemetryV2) ToCreateRequest() *CreateTelemetryV2Request {
+	return &CreateTelemetryV2Request{
+		Name:               t.Name,
+		Description:        t.Description,
+		LogFiles:           append([]string(nil), t.LogFiles...),
+		LogFileCollection:  t.LogFileCollection,
+		PerformanceMetrics: t.PerformanceMetrics,
+		Events:             append([]string(nil), t.Events...),
+		FileHashing:        t.FileHashing,
+	}
+}
+
+// ToUpdateRequest returns the update request that makes an existing telemetry v2 configuration match t
+func (t *TelemetryV2) ToUpdateRequest() *UpdateTelemetryV2Request {
+	req := UpdateTelemetryV2Request(*t.ToCreateRequest())
+	return &req
+}
@@ -0,0 +1,34 @@
+package telemetry_test
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/telemetry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTelemetryV2_ToCreateRequest(t *testing.T) {
+	tv2 := &telemetry.TelemetryV2{
+		ID:                 "t-1",
+		Name:               "Telemetry",
+		Description:        "desc",
+		LogFiles:           []string{"/var/log/system.log"},
+		LogFileCollection:  true,
+		PerformanceMetrics: true,
+		Plans:              []telemetry.TelemetryV2Plan{{ID: "p-1"}},
+		Events:             []string{"exec"},
+		FileHashing:        true,
+	}
+
+	want := &telemetry.CreateTelemetryV2Request{
+		Name:               "Telemetry",
+		Description:        "desc",
+		LogFiles:           []string{"/var/log/system.log"},
+		LogFileCollection:  true,
+		PerformanceMetrics: true,
+		Events:             []string{"exec"},
+		FileHashing:        true,
+	}
+	assert.Equal(t, want, tv2.ToCreateRequest())
+	assert.Equal(t, telemetry.UpdateTelemetryV2Request(*want), *tv2.ToUpdateRequest())
+}
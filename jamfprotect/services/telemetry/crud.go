@@ -20,6 +20,8 @@ func NewService(client interfaces.GraphQLClient) *Service {
 
 // CreateTelemetryV2 creates a new telemetry v2 configuration
 func (s *Service) CreateTelemetryV2(ctx context.Context, req *CreateTelemetryV2Request) (*TelemetryV2, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "telemetry", "CreateTelemetryV2")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
 	}
@@ -51,6 +53,8 @@ func (s *Service) CreateTelemetryV2(ctx context.Context, req *CreateTelemetryV2R
 
 // GetTelemetryV2 retrieves telemetry v2 by ID
 func (s *Service) GetTelemetryV2(ctx context.Context, id string) (*TelemetryV2, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "telemetry", "GetTelemetryV2")
+
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -76,8 +80,35 @@ func (s *Service) GetTelemetryV2(ctx context.Context, id string) (*TelemetryV2,
 	return result.GetTelemetryV2, resp, nil
 }
 
+// GetTelemetryV2ByName retrieves the telemetry v2 with the given name. It returns an error wrapping
+// client.ErrNotFound when no telemetry v2 has the name, and client.ErrAmbiguousName when several do.
+// The telemetry v2 is taken from the list, without a second request.
+func (s *Service) GetTelemetryV2ByName(ctx context.Context, name string) (*TelemetryV2, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "telemetry", "GetTelemetryV2ByName")
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("%w: name is required", client.ErrInvalidInput)
+	}
+
+	items, resp, err := s.listTelemetriesV2(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	match, err := client.MatchName("telemetry v2", name, items,
+		func(item TelemetryV2) string { return item.Name },
+		func(item TelemetryV2) string { return item.ID })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &match, resp, nil
+}
+
 // UpdateTelemetryV2 updates telemetry v2 by ID
 func (s *Service) UpdateTelemetryV2(ctx context.Context, id string, req *UpdateTelemetryV2Request) (*TelemetryV2, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "telemetry", "UpdateTelemetryV2")
+
 	if id == "" {
 		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -113,6 +144,8 @@ func (s *Service) UpdateTelemetryV2(ctx context.Context, id string, req *UpdateT
 
 // DeleteTelemetryV2 deletes telemetry v2 by ID
 func (s *Service) DeleteTelemetryV2(ctx context.Context, id string) (*interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "telemetry", "DeleteTelemetryV2")
+
 	if id == "" {
 		return nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
 	}
@@ -134,6 +167,13 @@ func (s *Service) DeleteTelemetryV2(ctx context.Context, id string) (*interfaces
 
 // ListTelemetriesV2 retrieves all telemetry v2 configurations with automatic pagination
 func (s *Service) ListTelemetriesV2(ctx context.Context) ([]TelemetryV2, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "telemetry", "ListTelemetriesV2")
+	return s.listTelemetriesV2(ctx)
+}
+
+// listTelemetriesV2 retrieves all telemetry v2 configurations with automatic pagination under the operation
+// recorded in ctx
+func (s *Service) listTelemetriesV2(ctx context.Context) ([]TelemetryV2, *interfaces.Response, error) {
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -143,7 +183,7 @@ func (s *Service) ListTelemetriesV2(ctx context.Context) ([]TelemetryV2, *interf
 	var nextToken *string
 	var lastResp *interfaces.Response
 
-	for {
+	for page := 1; ; page++ {
 		vars := map[string]any{
 			"direction": "DESC",
 			"field":     "created",
@@ -157,7 +197,7 @@ func (s *Service) ListTelemetriesV2(ctx context.Context) ([]TelemetryV2, *interf
 			ListTelemetriesV2 *ListTelemetriesV2Response `json:"listTelemetriesV2"`
 		}
 
-		resp, err := s.client.GraphQLPost(ctx, client.EndpointApp, listTelemetriesV2Query, vars, &result, headers)
+		resp, err := s.client.GraphQLPost(client.WithPage(ctx, page), client.EndpointApp, listTelemetriesV2Query, vars, &result, headers)
 		lastResp = resp
 		if err != nil {
 			return nil, lastResp, fmt.Errorf("failed to list telemetries v2: %w", err)
@@ -180,6 +220,8 @@ func (s *Service) ListTelemetriesV2(ctx context.Context) ([]TelemetryV2, *interf
 // ListTelemetriesCombined retrieves both v1 and v2 telemetries in a single query.
 // The RBAC_Plan flag controls whether plan associations are included in the response.
 func (s *Service) ListTelemetriesCombined(ctx context.Context, includePlans bool) (*TelemetriesCombinedResponse, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "telemetry", "ListTelemetriesCombined")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
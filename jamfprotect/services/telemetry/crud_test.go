@@ -73,6 +73,34 @@ func TestTelemetryService_GetTelemetryV2(t *testing.T) {
 	assert.Equal(t, "Test Telemetry V2", result.Name)
 }
 
+func TestTelemetryService_GetTelemetryV2ByName(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewTelemetryMock(baseURL)
+	mockHandler.RegisterListTelemetriesV2Mock()
+
+	result, _, err := service.GetTelemetryV2ByName(context.Background(), "Test Telemetry V2")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "test-id-1234", result.ID)
+	assert.Equal(t, "Test Telemetry V2", result.Name)
+
+	_, _, err = service.GetTelemetryV2ByName(context.Background(), "Missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestTelemetryService_GetTelemetryV2ByName_Ambiguous(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	httpmock.RegisterMatcherResponder("POST", baseURL+"/app", httpmock.BodyContainsString("listTelemetriesV2"),
+		httpmock.NewStringResponder(200, `{"data":{"listTelemetriesV2":{"items":[{"id":"first","name":"Duplicate"},{"id":"second","name":"Duplicate"}],"pageInfo":{"next":null,"total":2}}}}`).
+			HeaderSet(http.Header{"Content-Type": {"application/json"}}))
+
+	_, _, err := service.GetTelemetryV2ByName(context.Background(), "Duplicate")
+
+	assert.ErrorIs(t, err, client.ErrAmbiguousName)
+	assert.ErrorContains(t, err, "first, second")
+}
+
 func TestTelemetryService_UpdateTelemetryV2(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewTelemetryMock(baseURL)
@@ -213,3 +241,17 @@ func TestTelemetryService_ValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestTelemetryService_CloneTelemetryV2_Failures(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mocks.NewTelemetryMock(baseURL).RegisterGetTelemetryV2NotFoundMock()
+
+	_, _, err := service.CloneTelemetryV2(context.Background(), "", "Copy")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneTelemetryV2(context.Background(), "telemetry-1", "")
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, _, err = service.CloneTelemetryV2(context.Background(), "telemetry-1", "Copy")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
@@ -0,0 +1,46 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// TelemetryV2Service is the set of operations on Jamf Protect telemetry v2 configurations.
+// *Service implements it against the API; depend on the interface to substitute a fake in tests.
+type TelemetryV2Service interface {
+	// CreateTelemetryV2 creates a new telemetry v2 configuration
+	CreateTelemetryV2(ctx context.Context, req *CreateTelemetryV2Request) (*TelemetryV2, *interfaces.Response, error)
+
+	// GetTelemetryV2 retrieves telemetry v2 by ID
+	GetTelemetryV2(ctx context.Context, id string) (*TelemetryV2, *interfaces.Response, error)
+
+	// GetTelemetryV2ByName retrieves a telemetry v2 by its exact name
+	GetTelemetryV2ByName(ctx context.Context, name string) (*TelemetryV2, *interfaces.Response, error)
+
+	// UpdateTelemetryV2 updates telemetry v2 by ID
+	UpdateTelemetryV2(ctx context.Context, id string, req *UpdateTelemetryV2Request) (*TelemetryV2, *interfaces.Response, error)
+
+	// PatchTelemetryV2 fetches the telemetry v2, applies patch to an update request built from it and
+	// sends the full update
+	PatchTelemetryV2(ctx context.Context, id string, patch func(req *UpdateTelemetryV2Request)) (*TelemetryV2, *interfaces.Response, error)
+
+	// UpsertTelemetryV2 creates or updates a telemetry v2 to match req and reports which it did
+	UpsertTelemetryV2(ctx context.Context, req *CreateTelemetryV2Request, opts ...client.UpsertOption) (*TelemetryV2, client.UpsertAction, *interfaces.Response, error)
+
+	// CloneTelemetryV2 creates a copy of a telemetry v2 configuration under a new name
+	CloneTelemetryV2(ctx context.Context, id, newName string) (*TelemetryV2, *interfaces.Response, error)
+
+	// DeleteTelemetryV2 deletes telemetry v2 by ID
+	DeleteTelemetryV2(ctx context.Context, id string) (*interfaces.Response, error)
+
+	// ListTelemetriesV2 retrieves all telemetry v2 configurations with automatic pagination
+	ListTelemetriesV2(ctx context.Context) ([]TelemetryV2, *interfaces.Response, error)
+
+	// ListTelemetriesCombined retrieves both v1 and v2 telemetries in a single query.
+	// The RBAC_Plan flag controls whether plan associations are included in the response.
+	ListTelemetriesCombined(ctx context.Context, includePlans bool) (*TelemetriesCombinedResponse, *interfaces.Response, error)
+}
+
+var _ TelemetryV2Service = (*Service)(nil)
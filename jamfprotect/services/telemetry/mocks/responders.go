@@ -65,6 +65,21 @@ func (m *TelemetryMock) RegisterGetTelemetryV2Mock() {
 	)
 }
 
+// RegisterGetTelemetryV2NotFoundMock registers a getTelemetryV2 mock that returns null data, as the API
+// does for an ID that does not exist
+func (m *TelemetryMock) RegisterGetTelemetryV2NotFoundMock() {
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		m.baseURL+"/app",
+		httpmock.BodyContainsString("getTelemetryV2"),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, m.loadMockData("get_telemetry_v2_not_found.json"))
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		},
+	)
+}
+
 // RegisterUpdateTelemetryV2Mock registers a success mock for updateTelemetryV2
 func (m *TelemetryMock) RegisterUpdateTelemetryV2Mock() {
 	httpmock.RegisterMatcherResponder(
@@ -0,0 +1,34 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// PatchTelemetryV2 changes only the fields patch sets. The update mutation replaces the whole
+// telemetry v2, so PatchTelemetryV2 fetches the current telemetry v2, converts it to an update request,
+// applies patch to the request and sends the full mutation.
+func (s *Service) PatchTelemetryV2(ctx context.Context, id string, patch func(req *UpdateTelemetryV2Request)) (*TelemetryV2, *interfaces.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("%w: id is required", client.ErrInvalidInput)
+	}
+	if patch == nil {
+		return nil, nil, fmt.Errorf("%w: patch function is required", client.ErrInvalidInput)
+	}
+
+	current, resp, err := s.GetTelemetryV2(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if current == nil {
+		return nil, resp, fmt.Errorf("%w: telemetry v2 %q", client.ErrNotFound, id)
+	}
+
+	req := current.ToUpdateRequest()
+	patch(req)
+
+	return s.UpdateTelemetryV2(ctx, id, req)
+}
@@ -0,0 +1,34 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertTelemetryV2 makes the telemetry v2 named req.Name, or identified by client.UpsertByID, match
+// req. It creates the telemetry v2 when none exists, updates it when it differs from req,
+// and otherwise leaves it alone.
+func (s *Service) UpsertTelemetryV2(ctx context.Context, req *CreateTelemetryV2Request, opts ...client.UpsertOption) (*TelemetryV2, client.UpsertAction, *interfaces.Response, error) {
+	if req == nil {
+		return nil, "", nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	return client.Upsert(ctx, req.Name, client.Upserter[TelemetryV2]{
+		Kind:      "telemetry v2",
+		Get:       s.GetTelemetryV2,
+		GetByName: s.GetTelemetryV2ByName,
+		Create: func(ctx context.Context) (*TelemetryV2, *interfaces.Response, error) {
+			return s.CreateTelemetryV2(ctx, req)
+		},
+		Update: func(ctx context.Context, existing *TelemetryV2) (*TelemetryV2, *interfaces.Response, error) {
+			update := UpdateTelemetryV2Request(*req)
+			return s.UpdateTelemetryV2(ctx, existing.ID, &update)
+		},
+		Matches: func(existing *TelemetryV2) bool {
+			return client.VariablesMatch(telemetryMutationVariables(existing.ToCreateRequest()), telemetryMutationVariables(req))
+		},
+	}, opts...)
+}
@@ -0,0 +1,18 @@
+package unifiedloggingfilter
+
+// ToCreateRequest returns the create request that reproduces f
+func (f *UnifiedLoggingFilter) ToCreateRequest() *CreateUnifiedLoggingFilterRequest {
+	return &CreateUnifiedLoggingFilterRequest{
+		Name:        f.Name,
+		Description: f.Description,
+		Tags:        append([]string(nil), f.Tags...),
+		Filter:      f.Filter,
+		Enabled:     f.Enabled,
+	}
+}
+
+// ToUpdateRequest returns the update request that makes an existing filter match f
+func (f *UnifiedLoggingFilter) ToUpdateRequest() *UpdateUnifiedLoggingFilterRequest {
+	req := UpdateUnifiedLoggingFilterRequest(*f.ToCreateRequest())
+	return &req
+}
@@ -0,0 +1,29 @@
+package unifiedloggingfilter_test
+
+import (
+	"testing"
+
+	unifiedloggingfilter "github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/services/unified_logging_filter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedLoggingFilter_ToCreateRequest(t *testing.T) {
+	f := &unifiedloggingfilter.UnifiedLoggingFilter{
+		UUID:        "f-1",
+		Name:        "Filter",
+		Description: "desc",
+		Filter:      "subsystem == \"com.example\"",
+		Tags:        []string{"t"},
+		Enabled:     true,
+	}
+
+	want := &unifiedloggingfilter.CreateUnifiedLoggingFilterRequest{
+		Name:        "Filter",
+		Description: "desc",
+		Tags:        []string{"t"},
+		Filter:      "subsystem == \"com.example\"",
+		Enabled:     true,
+	}
+	assert.Equal(t, want, f.ToCreateRequest())
+	assert.Equal(t, unifiedloggingfilter.UpdateUnifiedLoggingFilterRequest(*want), *f.ToUpdateRequest())
+}
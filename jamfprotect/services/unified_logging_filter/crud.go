@@ -20,6 +20,8 @@ func NewService(client interfaces.GraphQLClient) *Service {
 
 // CreateUnifiedLoggingFilter creates a new unified logging filter
 func (s *Service) CreateUnifiedLoggingFilter(ctx context.Context, req *CreateUnifiedLoggingFilterRequest) (*UnifiedLoggingFilter, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "unified_logging_filter", "CreateUnifiedLoggingFilter")
+
 	if req == nil {
 		return nil, nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
 	}
@@ -50,6 +52,8 @@ func (s *Service) CreateUnifiedLoggingFilter(ctx context.Context, req *CreateUni
 
 // GetUnifiedLoggingFilter retrieves a unified logging filter by UUID
 func (s *Service) GetUnifiedLoggingFilter(ctx context.Context, uuid string) (*UnifiedLoggingFilter, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "unified_logging_filter", "GetUnifiedLoggingFilter")
+
 	if err := ValidateUnifiedLoggingFilterUUID(uuid); err != nil {
 		return nil, nil, err
 	}
@@ -72,8 +76,37 @@ func (s *Service) GetUnifiedLoggingFilter(ctx context.Context, uuid string) (*Un
 	return result.GetUnifiedLoggingFilter, resp, nil
 }
 
+// GetUnifiedLoggingFilterByName retrieves the unified logging filter with the given name. The
+// name is filtered server-side. It returns an error wrapping client.ErrNotFound when no
+// filter has the name, and client.ErrAmbiguousName when several do.
+func (s *Service) GetUnifiedLoggingFilterByName(ctx context.Context, name string) (*UnifiedLoggingFilter, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "unified_logging_filter", "GetUnifiedLoggingFilterByName")
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("%w: name is required", client.ErrInvalidInput)
+	}
+
+	items, resp, err := s.listUnifiedLoggingFilters(ctx, map[string]any{
+		"name": map[string]any{"equals": name},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	match, err := client.MatchName("unified logging filter", name, items,
+		func(item UnifiedLoggingFilter) string { return item.Name },
+		func(item UnifiedLoggingFilter) string { return item.UUID })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &match, resp, nil
+}
+
 // UpdateUnifiedLoggingFilter updates an existing unified logging filter
 func (s *Service) UpdateUnifiedLoggingFilter(ctx context.Context, uuid string, req *UpdateUnifiedLoggingFilterRequest) (*UnifiedLoggingFilter, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "unified_logging_filter", "UpdateUnifiedLoggingFilter")
+
 	if err := ValidateUnifiedLoggingFilterUUID(uuid); err != nil {
 		return nil, nil, err
 	}
@@ -108,6 +141,8 @@ func (s *Service) UpdateUnifiedLoggingFilter(ctx context.Context, uuid string, r
 
 // DeleteUnifiedLoggingFilter deletes a unified logging filter by UUID
 func (s *Service) DeleteUnifiedLoggingFilter(ctx context.Context, uuid string) (*interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "unified_logging_filter", "DeleteUnifiedLoggingFilter")
+
 	if err := ValidateUnifiedLoggingFilterUUID(uuid); err != nil {
 		return nil, err
 	}
@@ -129,6 +164,13 @@ func (s *Service) DeleteUnifiedLoggingFilter(ctx context.Context, uuid string) (
 
 // ListUnifiedLoggingFilters retrieves all unified logging filters with automatic pagination
 func (s *Service) ListUnifiedLoggingFilters(ctx context.Context) ([]UnifiedLoggingFilter, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "unified_logging_filter", "ListUnifiedLoggingFilters")
+	return s.listUnifiedLoggingFilters(ctx, map[string]any{})
+}
+
+// listUnifiedLoggingFilters retrieves the unified logging filters matching filter, a
+// UnifiedLoggingFiltersFilterInput, with automatic pagination
+func (s *Service) listUnifiedLoggingFilters(ctx context.Context, filter map[string]any) ([]UnifiedLoggingFilter, *interfaces.Response, error) {
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
@@ -138,11 +180,11 @@ func (s *Service) ListUnifiedLoggingFilters(ctx context.Context) ([]UnifiedLoggi
 	var nextToken *string
 	var lastResp *interfaces.Response
 
-	for {
+	for page := 1; ; page++ {
 		vars := map[string]any{
 			"direction": "ASC",
 			"field":     "NAME",
-			"filter":    map[string]any{},
+			"filter":    filter,
 		}
 		if nextToken != nil {
 			vars["nextToken"] = *nextToken
@@ -152,7 +194,7 @@ func (s *Service) ListUnifiedLoggingFilters(ctx context.Context) ([]UnifiedLoggi
 			ListUnifiedLoggingFilters *ListUnifiedLoggingFiltersResponse `json:"listUnifiedLoggingFilters"`
 		}
 
-		resp, err := s.client.GraphQLPost(ctx, client.EndpointGraphQL, listUnifiedLoggingFiltersQuery, vars, &result, headers)
+		resp, err := s.client.GraphQLPost(client.WithPage(ctx, page), client.EndpointGraphQL, listUnifiedLoggingFiltersQuery, vars, &result, headers)
 		lastResp = resp
 		if err != nil {
 			return nil, lastResp, fmt.Errorf("failed to list unified logging filters: %w", err)
@@ -174,6 +216,8 @@ func (s *Service) ListUnifiedLoggingFilters(ctx context.Context) ([]UnifiedLoggi
 
 // ListUnifiedLoggingFilterNames retrieves only the names of all unified logging filters
 func (s *Service) ListUnifiedLoggingFilterNames(ctx context.Context) ([]string, *interfaces.Response, error) {
+	ctx = client.WithOperation(ctx, "unified_logging_filter", "ListUnifiedLoggingFilterNames")
+
 	headers := map[string]string{
 		"Accept":       client.AcceptJSON,
 		"Content-Type": client.ContentTypeJSON,
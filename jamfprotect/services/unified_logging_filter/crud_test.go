@@ -76,6 +76,22 @@ func TestUnifiedLoggingFilterService_GetUnifiedLoggingFilter(t *testing.T) {
 	assert.Equal(t, "Test Unified Logging Filter", result.Name)
 }
 
+func TestUnifiedLoggingFilterService_GetUnifiedLoggingFilterByName(t *testing.T) {
+	service, baseURL := setupMockClient(t)
+	mockHandler := mocks.NewUnifiedLoggingFilterMock(baseURL)
+	mockHandler.RegisterListUnifiedLoggingFiltersMock()
+
+	result, _, err := service.GetUnifiedLoggingFilterByName(context.Background(), "Test Unified Logging Filter")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "aaaaaaaa-bbbb-4ccc-8ddd-eeeeeeeeeeee", result.UUID)
+	assert.Equal(t, "Test Unified Logging Filter", result.Name)
+
+	_, _, err = service.GetUnifiedLoggingFilterByName(context.Background(), "Missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
 func TestUnifiedLoggingFilterService_UpdateUnifiedLoggingFilter(t *testing.T) {
 	service, baseURL := setupMockClient(t)
 	mockHandler := mocks.NewUnifiedLoggingFilterMock(baseURL)
@@ -0,0 +1,38 @@
+package unifiedloggingfilter
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UnifiedLoggingFilterService is the set of operations on Jamf Protect unified logging filters.
+// *Service implements it against the API; depend on the interface to substitute a fake in tests.
+type UnifiedLoggingFilterService interface {
+	// CreateUnifiedLoggingFilter creates a new unified logging filter
+	CreateUnifiedLoggingFilter(ctx context.Context, req *CreateUnifiedLoggingFilterRequest) (*UnifiedLoggingFilter, *interfaces.Response, error)
+
+	// GetUnifiedLoggingFilter retrieves a unified logging filter by UUID
+	GetUnifiedLoggingFilter(ctx context.Context, uuid string) (*UnifiedLoggingFilter, *interfaces.Response, error)
+
+	// GetUnifiedLoggingFilterByName retrieves a unified logging filter by its exact name
+	GetUnifiedLoggingFilterByName(ctx context.Context, name string) (*UnifiedLoggingFilter, *interfaces.Response, error)
+
+	// UpdateUnifiedLoggingFilter updates an existing unified logging filter
+	UpdateUnifiedLoggingFilter(ctx context.Context, uuid string, req *UpdateUnifiedLoggingFilterRequest) (*UnifiedLoggingFilter, *interfaces.Response, error)
+
+	// UpsertUnifiedLoggingFilter creates or updates an unified logging filter to match req and reports which it did
+	UpsertUnifiedLoggingFilter(ctx context.Context, req *CreateUnifiedLoggingFilterRequest, opts ...client.UpsertOption) (*UnifiedLoggingFilter, client.UpsertAction, *interfaces.Response, error)
+
+	// DeleteUnifiedLoggingFilter deletes a unified logging filter by UUID
+	DeleteUnifiedLoggingFilter(ctx context.Context, uuid string) (*interfaces.Response, error)
+
+	// ListUnifiedLoggingFilters retrieves all unified logging filters with automatic pagination
+	ListUnifiedLoggingFilters(ctx context.Context) ([]UnifiedLoggingFilter, *interfaces.Response, error)
+
+	// ListUnifiedLoggingFilterNames retrieves only the names of all unified logging filters
+	ListUnifiedLoggingFilterNames(ctx context.Context) ([]string, *interfaces.Response, error)
+}
+
+var _ UnifiedLoggingFilterService = (*Service)(nil)
@@ -0,0 +1,34 @@
+package unifiedloggingfilter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/client"
+	"github.com/deploymenttheory/go-api-sdk-jamfprotect/jamfprotect/interfaces"
+)
+
+// UpsertUnifiedLoggingFilter makes the unified logging filter named req.Name, or identified by client.UpsertByID, match
+// req. It creates the unified logging filter when none exists, updates it when it differs from req,
+// and otherwise leaves it alone.
+func (s *Service) UpsertUnifiedLoggingFilter(ctx context.Context, req *CreateUnifiedLoggingFilterRequest, opts ...client.UpsertOption) (*UnifiedLoggingFilter, client.UpsertAction, *interfaces.Response, error) {
+	if req == nil {
+		return nil, "", nil, fmt.Errorf("%w: request cannot be nil", client.ErrInvalidInput)
+	}
+
+	return client.Upsert(ctx, req.Name, client.Upserter[UnifiedLoggingFilter]{
+		Kind:      "unified logging filter",
+		Get:       s.GetUnifiedLoggingFilter,
+		GetByName: s.GetUnifiedLoggingFilterByName,
+		Create: func(ctx context.Context) (*UnifiedLoggingFilter, *interfaces.Response, error) {
+			return s.CreateUnifiedLoggingFilter(ctx, req)
+		},
+		Update: func(ctx context.Context, existing *UnifiedLoggingFilter) (*UnifiedLoggingFilter, *interfaces.Response, error) {
+			update := UpdateUnifiedLoggingFilterRequest(*req)
+			return s.UpdateUnifiedLoggingFilter(ctx, existing.UUID, &update)
+		},
+		Matches: func(existing *UnifiedLoggingFilter) bool {
+			return client.VariablesMatch(unifiedLoggingFilterMutationVariables(existing.ToCreateRequest()), unifiedLoggingFilterMutationVariables(req))
+		},
+	}, opts...)
+}